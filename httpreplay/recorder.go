@@ -14,6 +14,7 @@ import (
 	"net/http/httputil"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -68,8 +69,9 @@ type Recorder struct {
 	// transformer is used to adjust responses to match changes in requests
 	transformer Transformer
 
-	// count is for debug logging -- how many requests have been matched
-	count int
+	// count is for debug logging -- how many requests have been matched, it is updated atomically since the
+	// ReplayServer matches the requests it receives concurrently
+	count int64
 }
 
 // HookTransport makes a new transport and chains the one passed in with it, returning the new one
@@ -121,6 +123,8 @@ func (r *Recorder) invokeTransformer(req *http.Request) (*Interaction, *Response
 		URL:        req.URL.String(),
 		Method:     req.Method,
 	}
+	// The secrets were replaced by placeholders in the recorded requests
+	r.scenario.Redaction.redactRequest(&request)
 
 	i, err := r.scenario.GetInteraction(request)
 	if err != nil {
@@ -131,12 +135,14 @@ func (r *Recorder) invokeTransformer(req *http.Request) (*Interaction, *Response
 				debugLogf("\t-> Returning error from invokeTransformer: %v", err)
 				return nil, nil, err
 			}
+		} else {
+			debugLogf("\t-> Returning error from invokeTransformer: %v", err)
+			return nil, nil, err
 		}
 	}
 	i.Request.BodyParsed, _ = unmarshal([]byte(i.Request.Body))
 	i.Response.BodyParsed, _ = unmarshal([]byte(i.Response.Body))
-	debugLogf("\t=> => Request %d matched interaction %d", r.count, i.Index)
-	r.count++
+	debugLogf("\t=> => Request %d matched interaction %d", atomic.AddInt64(&r.count, 1)-1, i.Index)
 
 	res := i.Response
 	response := Response{
@@ -392,6 +398,13 @@ func (r *Recorder) CancelRequest(req *http.Request, realTransport http.RoundTrip
 	}
 }
 
+// SetRedaction sets the secrets removed from the recorded interactions and from the requests being replayed
+func (r *Recorder) SetRedaction(redaction *Redaction) {
+	if r.scenario != nil {
+		r.scenario.Redaction = redaction
+	}
+}
+
 // SetMatcher sets a function to match requests against recorded HTTP interactions.
 func (r *Recorder) SetMatcher(matcher Matcher) {
 	if r.scenario != nil {
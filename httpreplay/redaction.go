@@ -0,0 +1,234 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// RedactedPlaceholder replaces the secrets in the recorded interactions. The same placeholder is put in the requests
+// on replay so that they still match the recorded ones.
+const RedactedPlaceholder = "<redacted>"
+
+// Redaction describes the secrets removed from the interactions before the scenario is saved
+type Redaction struct {
+	// Headers are the names of the request and response headers whose values are redacted
+	Headers []string
+
+	// BodyPaths are dot separated paths of the JSON body values that are redacted. A path matches the end of the
+	// path of a value, so "adminPassword" matches the key at any depth and "credentials.password" only matches
+	// "password" inside "credentials". "*" matches any key, arrays are skipped.
+	BodyPaths []string
+
+	// Patterns are matched against the bodies and the header values. The first group of the pattern is replaced
+	// when the pattern has one, otherwise the whole match is replaced.
+	Patterns []*regexp.Regexp
+
+	// ResponseBodyURLs are the URLs whose whole response body is redacted, for bodies that are not JSON like wallets
+	ResponseBodyURLs []*regexp.Regexp
+}
+
+// DefaultRedaction is used by the new scenarios, it can be extended before calling SetScenario
+var DefaultRedaction = NewRedaction()
+
+// NewRedaction returns a Redaction of the secrets sent to and returned by the OCI services
+func NewRedaction() *Redaction {
+	return &Redaction{
+		Headers: []string{
+			"Authorization",
+			"Opc-Obo-Token",
+			"Cookie",
+			"Set-Cookie",
+		},
+		BodyPaths: []string{
+			"adminPassword",
+			"password",
+			"newPassword",
+			"oldPassword",
+			"walletPassword",
+			"tdeWalletPassword",
+			"privateKey",
+			"passphrase",
+			"token",
+			"secretContent.content",
+		},
+		Patterns: []*regexp.Regexp{
+			regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+		},
+		ResponseBodyURLs: []*regexp.Regexp{
+			regexp.MustCompile(`/actions/generateWallet`),
+		},
+	}
+}
+
+// AddHeaders adds headers whose values are redacted
+func (r *Redaction) AddHeaders(names ...string) {
+	r.Headers = append(r.Headers, names...)
+}
+
+// AddBodyPaths adds JSON body paths whose values are redacted
+func (r *Redaction) AddBodyPaths(paths ...string) {
+	r.BodyPaths = append(r.BodyPaths, paths...)
+}
+
+// AddPatterns compiles and adds patterns that are redacted from the bodies and the header values
+func (r *Redaction) AddPatterns(patterns ...string) error {
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		r.Patterns = append(r.Patterns, compiled)
+	}
+	return nil
+}
+
+// redactInteraction removes the secrets from a recorded interaction
+func (r *Redaction) redactInteraction(i *Interaction) {
+	if r == nil {
+		return
+	}
+	i.Request.Headers = r.redactHeaders(i.Request.Headers)
+	i.Request.Body = r.redactBody(i.Request.Body)
+	i.Request.BodyParsed = nil
+
+	i.Response.Headers = r.redactHeaders(i.Response.Headers)
+	if r.isResponseBodyRedacted(i.Request.URL) && i.Response.Body != "" {
+		i.Response.Body = RedactedPlaceholder
+	} else {
+		i.Response.Body = r.redactBody(i.Response.Body)
+	}
+	i.Response.BodyParsed = nil
+}
+
+// redactRequest puts the placeholders in a request being replayed, so that it matches the recorded request
+func (r *Redaction) redactRequest(req *Request) {
+	if r == nil || req.Body == "" {
+		return
+	}
+	req.Body = r.redactBody(req.Body)
+	req.BodyParsed, _ = unmarshal([]byte(req.Body))
+}
+
+// redactHeaders returns a copy of the headers without the secrets, the original headers are left untouched
+func (r *Redaction) redactHeaders(headers http.Header) http.Header {
+	if headers == nil {
+		return nil
+	}
+	redacted := headers.Clone()
+	for _, name := range r.Headers {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted.Set(name, RedactedPlaceholder)
+		}
+	}
+	for name, values := range redacted {
+		for index, value := range values {
+			values[index] = r.redactPatterns(value)
+		}
+		redacted[name] = values
+	}
+	return redacted
+}
+
+func (r *Redaction) redactBody(body string) string {
+	if body == "" {
+		return body
+	}
+	if len(r.BodyPaths) > 0 {
+		if bodyParsed, err := unmarshal([]byte(body)); err == nil && r.redactValue(bodyParsed, nil) {
+			redacted := &bytes.Buffer{}
+			encoder := json.NewEncoder(redacted)
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(bodyParsed); err == nil {
+				body = strings.TrimSuffix(redacted.String(), "\n")
+			} else {
+				debugLogf("Unable to marshal the redacted body: %v", err)
+			}
+		}
+	}
+	return r.redactPatterns(body)
+}
+
+// redactValue replaces the values of the body paths in place and returns true when anything was redacted
+func (r *Redaction) redactValue(value interface{}, path []string) bool {
+	redacted := false
+	redactMap := func(obj map[string]interface{}) {
+		for key, child := range obj {
+			childPath := append(path[:len(path):len(path)], key)
+			if r.isBodyPathRedacted(childPath) {
+				obj[key] = RedactedPlaceholder
+				redacted = true
+			} else if r.redactValue(child, childPath) {
+				redacted = true
+			}
+		}
+	}
+
+	switch v := value.(type) {
+	case jsonObj:
+		redactMap(v)
+	case map[string]interface{}:
+		redactMap(v)
+	case jsonArr:
+		for _, child := range v {
+			if r.redactValue(child, path) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if r.redactValue(child, path) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func (r *Redaction) isBodyPathRedacted(path []string) bool {
+	for _, bodyPath := range r.BodyPaths {
+		segments := strings.Split(bodyPath, ".")
+		if len(segments) > len(path) {
+			continue
+		}
+		matched := true
+		offset := len(path) - len(segments)
+		for index, segment := range segments {
+			if segment != "*" && !strings.EqualFold(segment, path[offset+index]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redaction) isResponseBodyRedacted(url string) bool {
+	for _, pattern := range r.ResponseBodyURLs {
+		if pattern.MatchString(url) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redaction) redactPatterns(value string) string {
+	for _, pattern := range r.Patterns {
+		value = pattern.ReplaceAllStringFunc(value, func(match string) string {
+			groups := pattern.FindStringSubmatchIndex(match)
+			if len(groups) < 4 || groups[2] < 0 {
+				return RedactedPlaceholder
+			}
+			return match[:groups[2]] + RedactedPlaceholder + match[groups[3]:]
+		})
+	}
+	return value
+}
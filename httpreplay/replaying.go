@@ -27,10 +27,14 @@ func SetScenario(name string) error {
 	return err
 }
 
-// SaveScenario does nothing when recording
+// SaveScenario saves how many times the interactions were used when SaveUsageEnv is set, so that the unused ones can be
+// pruned. It does nothing if the scenario was not loaded by SetScenario.
 func SaveScenario() error {
-	recorder = nil
-	return nil
+	defer func() { recorder = nil }()
+	if recorder == nil || recorder.scenario == nil || !ShouldSaveUsage() {
+		return nil
+	}
+	return recorder.scenario.SaveUsage()
 }
 
 // InstallRecorder puts the recording transport into the http client, then returns a type that is compatible with the SDK's HTTPRequestDispatcher
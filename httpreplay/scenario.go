@@ -131,6 +131,9 @@ type Scenario struct {
 	// Matches actual request with interaction requests.
 	Matcher `yaml:"-"`
 
+	// Redaction removes the secrets from the interactions before they are saved
+	Redaction *Redaction `yaml:"-"`
+
 	// Fields keeps track between old values(in recorded yaml file) and new values(in replay request)
 	Fields map[string]string
 }
@@ -172,6 +175,7 @@ func NewScenario(name string) *Scenario {
 		Version:            scenarioFormatV1,
 		Interactions:       make(Interactions, 0),
 		sortedInteractions: make(Interactions, 0),
+		Redaction:          DefaultRedaction,
 		Fields:             make(map[string]string),
 	}
 
@@ -203,6 +207,16 @@ func Load(name string) (*Scenario, error) {
 var calls = 0
 
 func (s *Scenario) transformer(req *Request, i Interaction, res *Response) {
+	s.updateFields(req, i, res)
+	saveOrLog(req, fmt.Sprintf("/tmp/%d-request.yaml", calls))
+	saveOrLog(i, fmt.Sprintf("/tmp/%d-interaction.yaml", calls))
+	saveOrLog(res, fmt.Sprintf("/tmp/%d-response.yaml", calls))
+	saveOrLog(s.Fields, fmt.Sprintf("/tmp/%d-fields-map.yaml", calls))
+	calls++
+}
+
+// updateFields replaces the values of the recorded response with the values of the request that differ from the recorded request
+func (s *Scenario) updateFields(req *Request, i Interaction, res *Response) {
 	if req.BodyParsed != nil {
 		s.updateFieldMap(req, &i)
 	}
@@ -210,11 +224,6 @@ func (s *Scenario) transformer(req *Request, i Interaction, res *Response) {
 	if res.BodyParsed != nil && len(s.Fields) > 0 {
 		s.updateResFromFieldMap(res)
 	}
-	saveOrLog(req, fmt.Sprintf("/tmp/%d-request.yaml", calls))
-	saveOrLog(i, fmt.Sprintf("/tmp/%d-interaction.yaml", calls))
-	saveOrLog(res, fmt.Sprintf("/tmp/%d-response.yaml", calls))
-	saveOrLog(s.Fields, fmt.Sprintf("/tmp/%d-fields-map.yaml", calls))
-	calls++
 }
 
 // AddInteraction appends a new interaction to the scenario
@@ -436,9 +445,12 @@ func (s *Scenario) Save() error {
 		}
 	}
 
-	// Marshal to YAML and save interactions
+	// Marshal to YAML and save interactions, without the secrets
 	s.Reset()
+	interactions := s.Interactions
+	s.Interactions = s.redactedInteractions()
 	data, err := yaml.Marshal(s)
+	s.Interactions = interactions
 	if err != nil {
 		return err
 	}
@@ -479,6 +491,16 @@ func (s *Scenario) Save() error {
 	return nil
 }
 
+// redactedInteractions returns a copy of the interactions without the secrets of the Redaction
+func (s *Scenario) redactedInteractions() Interactions {
+	redacted := make(Interactions, len(s.Interactions))
+	copy(redacted, s.Interactions)
+	for index := range redacted {
+		s.Redaction.redactInteraction(&redacted[index])
+	}
+	return redacted
+}
+
 func (s *Scenario) ConverRequestWithFullPath(r Request) (Request, error) {
 	for key, value := range s.Fields {
 		if strings.Contains(r.URL, value) {
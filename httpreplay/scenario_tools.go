@@ -0,0 +1,312 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	ocidRegex      = regexp.MustCompile(`ocid1\.[a-z0-9_]+\.[a-z0-9_-]*\.[a-z0-9_-]*\.[a-z0-9]+`)
+	timestampRegex = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`)
+
+	// rekeyEpoch is the time of the earliest timestamp of a rekeyed scenario
+	rekeyEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ScenarioDiff lists the request signatures that are only in one of two scenarios
+type ScenarioDiff struct {
+	OnlyInFirst  []string
+	OnlyInSecond []string
+}
+
+// SaveUsageEnv enables saving the usage of the interactions at the end of a replay run
+const SaveUsageEnv = "TF_VAR_SAVE_REPLAY_USAGE"
+
+// ShouldSaveUsage returns true if the usage of the interactions is saved at the end of a replay run
+func ShouldSaveUsage() bool {
+	_, ok := os.LookupEnv(SaveUsageEnv)
+	return ok
+}
+
+func (s *Scenario) usageFileName() string {
+	return fmt.Sprintf("record/%s.usage.yaml", s.Name)
+}
+
+// SaveUsage writes how many times each interaction was used in a replay run, so that the unused interactions can be
+// found and pruned afterwards
+func (s *Scenario) SaveUsage() error {
+	s.Mu.RLock()
+	defer s.Mu.RUnlock()
+
+	uses := make([]int, len(s.Interactions))
+	for _, i := range s.Interactions {
+		uses[i.Index] = i.Uses
+	}
+	return save(uses, s.usageFileName())
+}
+
+// LoadUsage reads the uses of the interactions saved by the last replay run
+func (s *Scenario) LoadUsage() error {
+	data, err := ioutil.ReadFile(s.usageFileName())
+	if err != nil {
+		return fmt.Errorf("unable to read the usage of scenario '%s', replay it first: %v", s.Name, err)
+	}
+
+	var uses []int
+	if err := yaml.Unmarshal(data, &uses); err != nil {
+		return err
+	}
+	if len(uses) != len(s.Interactions) {
+		return fmt.Errorf("the usage of scenario '%s' has %d interactions instead of %d, replay it again", s.Name, len(uses), len(s.Interactions))
+	}
+	for index := range s.Interactions {
+		s.Interactions[index].Uses = uses[index]
+	}
+	return nil
+}
+
+// UnusedInteractions returns the interactions that were never matched
+func (s *Scenario) UnusedInteractions() Interactions {
+	var unused Interactions
+	for _, i := range s.Interactions {
+		if i.Uses == 0 {
+			unused = append(unused, i)
+		}
+	}
+	return unused
+}
+
+// Prune removes the interactions that were never matched and returns how many were removed
+func (s *Scenario) Prune() int {
+	s.Mu.Lock()
+	defer s.Mu.Unlock()
+
+	used := make(Interactions, 0, len(s.Interactions))
+	for _, i := range s.Interactions {
+		if i.Uses > 0 {
+			i.Index = len(used)
+			used = append(used, i)
+		}
+	}
+	pruned := len(s.Interactions) - len(used)
+	s.Interactions = used
+	s.sortedInteractions = make(Interactions, len(used))
+	copy(s.sortedInteractions, used)
+	return pruned
+}
+
+// requestSignature identifies a request by its method, URL and body
+func requestSignature(r Request) string {
+	signature := fmt.Sprintf("%s %s", r.Method, r.URL)
+	if r.Body == "" {
+		return signature
+	}
+	body := r.Body
+	// The keys are sorted when the body is marshalled again, so that the same bodies have the same signature
+	if bodyParsed, err := unmarshal([]byte(r.Body)); err == nil {
+		if normalized, err := json.Marshal(bodyParsed); err == nil {
+			body = string(normalized)
+		}
+	}
+	hash := sha256.Sum256([]byte(body))
+	return fmt.Sprintf("%s body:%x", signature, hash[:4])
+}
+
+// DiffScenarios compares the requests of two scenarios by their signature
+func DiffScenarios(first *Scenario, second *Scenario) ScenarioDiff {
+	counts := map[string]int{}
+	for _, i := range first.Interactions {
+		counts[requestSignature(i.Request)]++
+	}
+	for _, i := range second.Interactions {
+		counts[requestSignature(i.Request)]--
+	}
+
+	diff := ScenarioDiff{}
+	for signature, count := range counts {
+		for ; count > 0; count-- {
+			diff.OnlyInFirst = append(diff.OnlyInFirst, signature)
+		}
+		for ; count < 0; count++ {
+			diff.OnlyInSecond = append(diff.OnlyInSecond, signature)
+		}
+	}
+	sort.Strings(diff.OnlyInFirst)
+	sort.Strings(diff.OnlyInSecond)
+	return diff
+}
+
+// Rekey rewrites the OCIDs and the timestamps of the interactions, so that recording the scenario again gives the same
+// values. The OCIDs are numbered in the order they appear and the timestamps are shifted to start at rekeyEpoch. The
+// new values are merged into the Fields map of the scenario, which is returned; the values already in the map are
+// reused and are not rekeyed again. The Fields map is not saved with the scenario.
+func (s *Scenario) Rekey() map[string]string {
+	s.Mu.Lock()
+	defer s.Mu.Unlock()
+
+	if s.Fields == nil {
+		s.Fields = make(map[string]string)
+	}
+	rekeyed := map[string]bool{}
+	for _, newValue := range s.Fields {
+		rekeyed[newValue] = true
+	}
+
+	var ocids []string
+	var earliest time.Time
+	timestamps := map[string]time.Time{}
+
+	s.forEachValue(func(value string) string {
+		for _, ocid := range ocidRegex.FindAllString(value, -1) {
+			if _, ok := s.Fields[ocid]; !ok && !rekeyed[ocid] {
+				s.Fields[ocid] = ""
+				ocids = append(ocids, ocid)
+			}
+		}
+		for _, timestamp := range timestampRegex.FindAllString(value, -1) {
+			if _, ok := s.Fields[timestamp]; ok || rekeyed[timestamp] {
+				continue
+			}
+			if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+				timestamps[timestamp] = t
+				if earliest.IsZero() || t.Before(earliest) {
+					earliest = t
+				}
+			}
+		}
+		return value
+	})
+
+	next := 1
+	for _, ocid := range ocids {
+		prefix := ocid[:strings.LastIndex(ocid, ".")+1]
+		for rekeyed[fmt.Sprintf("%sreplay%06d", prefix, next)] {
+			next++
+		}
+		s.Fields[ocid] = fmt.Sprintf("%sreplay%06d", prefix, next)
+		next++
+	}
+	for timestamp, t := range timestamps {
+		layout := time.RFC3339
+		if fraction := timestampRegex.FindStringSubmatch(timestamp)[1]; fraction != "" {
+			layout = "2006-01-02T15:04:05." + strings.Repeat("0", len(fraction)-1) + "Z07:00"
+		}
+		s.Fields[timestamp] = rekeyEpoch.Add(t.Sub(earliest)).In(t.Location()).Format(layout)
+	}
+
+	// The Fields map may also hold the values of a replay run, only the OCIDs and the timestamps are rewritten
+	pairs := make([]string, 0, 2*len(s.Fields))
+	for oldValue, newValue := range s.Fields {
+		if ocidRegex.FindString(oldValue) == oldValue || timestampRegex.FindString(oldValue) == oldValue {
+			pairs = append(pairs, oldValue, newValue)
+		}
+	}
+	replacer := strings.NewReplacer(pairs...)
+	s.forEachValue(replacer.Replace)
+
+	copy(s.sortedInteractions, s.Interactions)
+	return s.Fields
+}
+
+// forEachValue replaces the URL, the bodies, the form and the header values of the interactions with fn
+func (s *Scenario) forEachValue(fn func(string) string) {
+	replaceValues := func(values map[string][]string) {
+		for _, list := range values {
+			for index := range list {
+				list[index] = fn(list[index])
+			}
+		}
+	}
+	for index := range s.Interactions {
+		i := &s.Interactions[index]
+		i.Request.URL = fn(i.Request.URL)
+		i.Request.Body = fn(i.Request.Body)
+		i.Request.BodyParsed = nil
+		replaceValues(i.Request.Form)
+		replaceValues(i.Request.Headers)
+		i.Response.Body = fn(i.Response.Body)
+		i.Response.BodyParsed = nil
+		replaceValues(i.Response.Headers)
+	}
+}
+
+// RunScenarioCommand runs one of the maintenance commands of the recorded scenarios:
+//   - unused: lists the interactions that were not used by the last replay run
+//   - prune: removes the interactions that were not used by the last replay run
+//   - diff: lists the requests that are only in one of the scenario and the other scenario
+//   - rekey: rewrites the OCIDs and the timestamps of the scenario
+func RunScenarioCommand(command string, scenarioName string, otherScenarioName string, out io.Writer) error {
+	if scenarioName == "" {
+		return fmt.Errorf("[ERROR]: scenario is required by the scenario commands")
+	}
+	s, err := Load(scenarioName)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "unused":
+		if err := s.LoadUsage(); err != nil {
+			return err
+		}
+		unused := s.UnusedInteractions()
+		fmt.Fprintf(out, "%d of %d interactions of scenario '%s' are unused\n", len(unused), len(s.Interactions), s.Name)
+		for _, i := range unused {
+			fmt.Fprintf(out, "  %d: %s\n", i.Index, requestSignature(i.Request))
+		}
+		return nil
+	case "prune":
+		if err := s.LoadUsage(); err != nil {
+			return err
+		}
+		pruned := s.Prune()
+		fmt.Fprintf(out, "Pruned %d interactions of scenario '%s', %d are left\n", pruned, s.Name, len(s.Interactions))
+		return s.saveWithoutFields()
+	case "diff":
+		if otherScenarioName == "" {
+			return fmt.Errorf("[ERROR]: other_scenario is required by the diff command")
+		}
+		other, err := Load(otherScenarioName)
+		if err != nil {
+			return err
+		}
+		diff := DiffScenarios(s, other)
+		fmt.Fprintf(out, "%d requests only in '%s'\n", len(diff.OnlyInFirst), s.Name)
+		for _, signature := range diff.OnlyInFirst {
+			fmt.Fprintf(out, "  - %s\n", signature)
+		}
+		fmt.Fprintf(out, "%d requests only in '%s'\n", len(diff.OnlyInSecond), other.Name)
+		for _, signature := range diff.OnlyInSecond {
+			fmt.Fprintf(out, "  + %s\n", signature)
+		}
+		return nil
+	case "rekey":
+		fields := s.Rekey()
+		fmt.Fprintf(out, "Rewrote %d OCIDs and timestamps of scenario '%s'\n", len(fields), s.Name)
+		return s.saveWithoutFields()
+	default:
+		return fmt.Errorf("[ERROR]: No scenario command '%s' supported", command)
+	}
+}
+
+// saveWithoutFields saves the scenario without its Fields map, which maps the values of the recorded requests, e.g. the
+// OCIDs replaced by Rekey, to their new values
+func (s *Scenario) saveWithoutFields() error {
+	fields := s.Fields
+	s.Fields = make(map[string]string)
+	defer func() { s.Fields = fields }()
+	return s.Save()
+}
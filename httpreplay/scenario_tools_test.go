@@ -0,0 +1,159 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestScenarioTools
+
+package httpreplay
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestScenarioTools(t *testing.T) {
+	newTestScenario := func() *Scenario {
+		s := NewScenario("TestScenarioTools")
+		s.AddInteraction(&Interaction{
+			Request: Request{
+				URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns",
+				Method: "POST",
+				Body:   `{"compartmentId":"ocid1.compartment.oc1..aaaaaaaabbbbb","displayName":"vcn"}`,
+			},
+			Response: Response{
+				Body:    `{"id":"ocid1.vcn.oc1.phx.amaaaaaaccccc","compartmentId":"ocid1.compartment.oc1..aaaaaaaabbbbb","timeCreated":"2021-06-01T10:00:00.123Z"}`,
+				Headers: http.Header{"Etag": {"ocid1.vcn.oc1.phx.amaaaaaaccccc"}},
+			},
+		})
+		s.AddInteraction(&Interaction{
+			Request:  Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1.phx.amaaaaaaccccc", Method: "GET"},
+			Response: Response{Body: `{"id":"ocid1.vcn.oc1.phx.amaaaaaaccccc","timeCreated":"2021-06-01T10:00:00.123Z","timeUpdated":"2021-06-01T10:05:00.123Z"}`},
+		})
+		s.AddInteraction(&Interaction{
+			Request: Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1.phx.amaaaaaaccccc", Method: "DELETE"},
+		})
+		return s
+	}
+
+	t.Run("Prune", func(t *testing.T) {
+		s := newTestScenario()
+		s.Interactions[0].Uses = 1
+		s.Interactions[2].Uses = 2
+
+		if unused := s.UnusedInteractions(); len(unused) != 1 || unused[0].Index != 1 {
+			t.Errorf("Unexpected unused interactions: %v", unused)
+		}
+		if pruned := s.Prune(); pruned != 1 {
+			t.Errorf("Expected 1 pruned interaction, got %v", pruned)
+		}
+		if len(s.Interactions) != 2 || s.Interactions[1].Method != "DELETE" || s.Interactions[1].Index != 1 {
+			t.Errorf("Unexpected interactions after prune: %v", s.Interactions)
+		}
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		first := newTestScenario()
+		second := newTestScenario()
+		second.Interactions = second.Interactions[:2]
+		// The same body with the keys in another order has the same signature
+		second.Interactions[0].Request.Body = `{"displayName":"vcn","compartmentId":"ocid1.compartment.oc1..aaaaaaaabbbbb"}`
+		second.AddInteraction(&Interaction{
+			Request: Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/subnets", Method: "GET"},
+		})
+
+		diff := DiffScenarios(first, second)
+		if len(diff.OnlyInFirst) != 1 || !strings.HasPrefix(diff.OnlyInFirst[0], "DELETE ") {
+			t.Errorf("Unexpected requests only in the first scenario: %v", diff.OnlyInFirst)
+		}
+		if len(diff.OnlyInSecond) != 1 || !strings.HasSuffix(diff.OnlyInSecond[0], "/subnets") {
+			t.Errorf("Unexpected requests only in the second scenario: %v", diff.OnlyInSecond)
+		}
+	})
+
+	t.Run("Rekey", func(t *testing.T) {
+		s := newTestScenario()
+		fields := s.Rekey()
+
+		vcnId := fields["ocid1.vcn.oc1.phx.amaaaaaaccccc"]
+		if vcnId != "ocid1.vcn.oc1.phx.replay000002" {
+			t.Errorf("Unexpected rekeyed OCID: %v", vcnId)
+		}
+		if !strings.HasSuffix(s.Interactions[1].Request.URL, "/vcns/"+vcnId) || s.Interactions[0].Response.Headers.Get("Etag") != vcnId {
+			t.Errorf("The OCID was not rewritten consistently: %v", s.Interactions[1].Request.URL)
+		}
+		for _, i := range s.Interactions {
+			if strings.Contains(i.Request.URL+i.Request.Body+i.Response.Body, "aaaaaaaa") {
+				t.Errorf("The OCIDs were not rewritten: %v", i)
+			}
+		}
+		if !strings.Contains(s.Interactions[1].Response.Body, `"timeCreated":"2020-01-01T00:00:00.000Z","timeUpdated":"2020-01-01T00:05:00.000Z"`) {
+			t.Errorf("The timestamps were not shifted: %v", s.Interactions[1].Response.Body)
+		}
+
+		// Rekeying again does not change the values
+		again := newTestScenario()
+		again.Rekey()
+		if DiffScenarios(s, again).OnlyInFirst != nil {
+			t.Errorf("Rekeying the same scenario gave different requests")
+		}
+	})
+
+	t.Run("Rekey With Fields", func(t *testing.T) {
+		s := newTestScenario()
+		s.Fields = map[string]string{
+			"ocid1.compartment.oc1..aaaaaaaabbbbb": "ocid1.compartment.oc1..mycompartment",
+			"vcn":                                  "replayed vcn",
+		}
+		fields := s.Rekey()
+
+		// The values of the Fields map are reused, the values that are not OCIDs or timestamps are left as is
+		if fields["ocid1.compartment.oc1..aaaaaaaabbbbb"] != "ocid1.compartment.oc1..mycompartment" || fields["vcn"] != "replayed vcn" {
+			t.Errorf("The existing fields were not kept: %v", fields)
+		}
+		if !strings.Contains(s.Interactions[0].Request.Body, `"compartmentId":"ocid1.compartment.oc1..mycompartment","displayName":"vcn"`) {
+			t.Errorf("The existing fields were not reused: %v", s.Interactions[0].Request.Body)
+		}
+		vcnId := fields["ocid1.vcn.oc1.phx.amaaaaaaccccc"]
+		if vcnId != "ocid1.vcn.oc1.phx.replay000001" {
+			t.Errorf("Unexpected rekeyed OCID: %v", vcnId)
+		}
+
+		// Rekeying a rekeyed scenario with its fields does not change it
+		body := s.Interactions[1].Response.Body
+		if again := s.Rekey(); len(again) != len(fields) || s.Interactions[1].Response.Body != body {
+			t.Errorf("Rekeying again changed the scenario: %v", s.Interactions[1].Response.Body)
+		}
+	})
+	t.Run("Save Without Fields", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "scenarioTools")
+		if err != nil {
+			t.Fatalf("Unable to create the record directory: %v", err)
+		}
+		defer os.RemoveAll(dir)
+		wd, _ := os.Getwd()
+		if err := os.Chdir(dir); err != nil {
+			t.Fatalf("Unable to change the directory: %v", err)
+		}
+		defer os.Chdir(wd)
+
+		s := newTestScenario()
+		fields := s.Rekey()
+		if err := s.saveWithoutFields(); err != nil {
+			t.Fatalf("Unable to save the scenario: %v", err)
+		}
+		data, err := ioutil.ReadFile("record/" + s.File)
+		if err != nil {
+			t.Fatalf("Unable to read the scenario: %v", err)
+		}
+		// The OCIDs that were rewritten are only kept in memory
+		if strings.Contains(string(data), "aaaaaaaa") || !strings.Contains(string(data), "replay000001") {
+			t.Errorf("The scenario was saved with the rewritten OCIDs: %v", string(data))
+		}
+		if len(s.Fields) != len(fields) {
+			t.Errorf("The Fields map was not kept: %v", s.Fields)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// ReplayReportPath is served by the ReplayServer with the report of the interactions
+const ReplayReportPath = "/httpreplay/report"
+
+// ReplayReport lists the recorded interactions that were never matched and the requests that were not recorded
+type ReplayReport struct {
+	Unmatched  []string `json:"unmatched"`
+	Unrecorded []string `json:"unrecorded"`
+}
+
+// ReplayServer serves a recorded scenario as a local HTTP server. The provider is pointed at it with the
+// CLIENT_HOST_OVERRIDES environment variable, so that any provider binary can replay the scenario.
+type ReplayServer struct {
+	recorder *Recorder
+
+	mu         sync.Mutex
+	unrecorded []string
+}
+
+// NewReplayServer loads the scenario from the record directory and returns a server replaying it
+func NewReplayServer(scenarioName string) (*ReplayServer, error) {
+	s, err := Load(scenarioName)
+	if err != nil {
+		return nil, err
+	}
+	return newReplayServer(s), nil
+}
+
+func newReplayServer(s *Scenario) *ReplayServer {
+	// The requests are sent to the host of the server instead of the host of the service, the host is removed from the
+	// recorded requests so that they are matched on their path, query string and body
+	for index := range s.Interactions {
+		s.Interactions[index].Request.URL = stripHost(s.Interactions[index].Request.URL)
+	}
+	copy(s.sortedInteractions, s.Interactions)
+
+	recorder := &Recorder{
+		mode:     ModeReplaying,
+		scenario: s,
+	}
+	recorder.SetMatcher(matcher)
+	recorder.SetTransformer(s.updateFields)
+	return &ReplayServer{recorder: recorder}
+}
+
+func stripHost(requestUrl string) string {
+	u, err := url.Parse(requestUrl)
+	if err != nil {
+		return requestUrl
+	}
+	return u.RequestURI()
+}
+
+// ServeHTTP responds with the recorded interaction matching the request
+func (rs *ReplayServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == ReplayReportPath {
+		rs.serveReport(w)
+		return
+	}
+
+	// invokeTransformer reads ContentLength bytes of the body
+	if req.ContentLength < 0 {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	_, response, err := rs.invokeTransformer(req)
+	if err != nil {
+		rs.mu.Lock()
+		rs.unrecorded = append(rs.unrecorded, fmt.Sprintf("%s %s", req.Method, req.URL.String()))
+		rs.mu.Unlock()
+		debugLogf("Request %s %s was not recorded: %v", req.Method, req.URL.String(), err)
+
+		// 400 is not retried and does not look like a deleted resource to the provider
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		body, _ := json.Marshal(map[string]string{
+			"code":    "InteractionNotRecorded",
+			"message": fmt.Sprintf("%s %s is not recorded in the scenario: %v", req.Method, req.URL.String(), err),
+		})
+		w.Write(body)
+		return
+	}
+
+	for name, values := range response.Headers {
+		if name == "Content-Length" {
+			continue
+		}
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(response.Code)
+	w.Write([]byte(response.Body))
+}
+
+// invokeTransformer recovers from the panics of the matchers, so that a request does not stop the server
+func (rs *ReplayServer) invokeTransformer(req *http.Request) (i *Interaction, response *Response, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to match the request: %v", r)
+		}
+	}()
+	return rs.recorder.invokeTransformer(req)
+}
+
+func (rs *ReplayServer) serveReport(w http.ResponseWriter) {
+	body, err := json.MarshalIndent(rs.Report(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// Report returns the interactions that were never matched and the requests that were not recorded
+func (rs *ReplayServer) Report() ReplayReport {
+	report := ReplayReport{
+		Unmatched:  []string{},
+		Unrecorded: []string{},
+	}
+
+	s := rs.recorder.scenario
+	s.Mu.RLock()
+	for _, i := range s.Interactions {
+		if i.Uses == 0 {
+			report.Unmatched = append(report.Unmatched, fmt.Sprintf("%d: %s %s", i.Index, i.Request.Method, i.Request.URL))
+		}
+	}
+	s.Mu.RUnlock()
+
+	rs.mu.Lock()
+	report.Unrecorded = append(report.Unrecorded, rs.unrecorded...)
+	rs.mu.Unlock()
+	return report
+}
+
+// SaveUsage saves how many times the interactions were used when SaveUsageEnv is set, so that the unused ones can be pruned
+func (rs *ReplayServer) SaveUsage() error {
+	if !ShouldSaveUsage() {
+		return nil
+	}
+	return rs.recorder.scenario.SaveUsage()
+}
+
+// LogReport logs the interactions that were never matched and the requests that were not recorded
+func (rs *ReplayServer) LogReport() {
+	report := rs.Report()
+	debugLogf("%d recorded interactions were never matched", len(report.Unmatched))
+	for _, interaction := range report.Unmatched {
+		debugLogf("\t%s", interaction)
+	}
+	debugLogf("%d requests were not recorded", len(report.Unrecorded))
+	for _, request := range report.Unrecorded {
+		debugLogf("\t%s", request)
+	}
+}
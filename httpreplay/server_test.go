@@ -0,0 +1,125 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestReplayServer
+
+package httpreplay
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReplayServer(t *testing.T) {
+	s := NewScenario("TestReplayServer")
+	s.AddInteraction(&Interaction{
+		Request:  Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn", Method: "GET"},
+		Response: Response{Body: `{"id":"ocid1.vcn","lifecycleState":"AVAILABLE"}`, Code: 200, Headers: http.Header{"Opc-Request-Id": {"vcn"}}},
+	})
+	s.AddInteraction(&Interaction{
+		Request:  Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", Method: "POST", Body: `{"displayName":"first"}`},
+		Response: Response{Body: `{"id":"ocid1.first"}`, Code: 200},
+	})
+	s.AddInteraction(&Interaction{
+		Request:  Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", Method: "POST", Body: `{"displayName":"second"}`},
+		Response: Response{Body: `{"id":"ocid1.second"}`, Code: 200},
+	})
+	s.AddInteraction(&Interaction{
+		Request:  Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/subnets?vcnId=ocid1.first", Method: "GET"},
+		Response: Response{Body: `[{"id":"ocid1.first.subnet"}]`, Code: 200},
+	})
+	s.AddInteraction(&Interaction{
+		Request:  Request{URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/subnets?vcnId=ocid1.second", Method: "GET"},
+		Response: Response{Body: `[{"id":"ocid1.second.subnet"}]`, Code: 200},
+	})
+	s.AddInteraction(&Interaction{
+		Request:  Request{URL: "https://identity.us-phoenix-1.oraclecloud.com/20160918/users", Method: "GET"},
+		Response: Response{Body: `[]`, Code: 200},
+	})
+
+	server := httptest.NewServer(newReplayServer(s))
+	defer server.Close()
+
+	get := func(path string) (int, string, http.Header) {
+		response, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("Unable to get %v: %v", path, err)
+		}
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+		return response.StatusCode, string(body), response.Header
+	}
+
+	t.Run("Recorded Interaction", func(t *testing.T) {
+		code, body, headers := get("/20160918/vcns/ocid1.vcn")
+		if code != 200 || !strings.Contains(body, `"lifecycleState":"AVAILABLE"`) {
+			t.Errorf("Unexpected response %v: %v", code, body)
+		}
+		if headers.Get("Opc-Request-Id") != "vcn" {
+			t.Errorf("The recorded headers were not sent: %v", headers)
+		}
+	})
+
+	t.Run("Interaction With Body", func(t *testing.T) {
+		response, err := http.Post(server.URL+"/20160918/vcns", "application/json", strings.NewReader(`{"displayName":"second"}`))
+		if err != nil {
+			t.Fatalf("Unable to post: %v", err)
+		}
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+		if !strings.Contains(string(body), "ocid1.second") {
+			t.Errorf("Request matched the wrong interaction: %v", string(body))
+		}
+	})
+
+	t.Run("Interaction With Query String", func(t *testing.T) {
+		code, body, _ := get("/20160918/subnets?vcnId=ocid1.second")
+		if code != 200 || !strings.Contains(body, "ocid1.second.subnet") {
+			t.Errorf("Request matched the wrong interaction %v: %v", code, body)
+		}
+	})
+
+	t.Run("Unrecorded Request", func(t *testing.T) {
+		code, body, _ := get("/20160918/subnets/ocid1.subnet")
+		if code != http.StatusBadRequest || !strings.Contains(body, "InteractionNotRecorded") {
+			t.Errorf("Unexpected response %v: %v", code, body)
+		}
+	})
+
+	t.Run("Concurrent Requests", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for n := 0; n < 10; n++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if code, body, _ := get("/20160918/vcns/ocid1.vcn"); code != 200 {
+					t.Errorf("Unexpected response %v: %v", code, body)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("Report", func(t *testing.T) {
+		code, body, _ := get(ReplayReportPath)
+		if code != 200 {
+			t.Fatalf("Unexpected response %v: %v", code, body)
+		}
+		var report ReplayReport
+		if err := json.Unmarshal([]byte(body), &report); err != nil {
+			t.Fatalf("Unable to unmarshal the report: %v", err)
+		}
+		if len(report.Unmatched) != 3 || !strings.Contains(strings.Join(report.Unmatched, ","), "/users") {
+			t.Errorf("Unexpected unmatched interactions: %v", report.Unmatched)
+		}
+		if len(report.Unrecorded) != 1 || report.Unrecorded[0] != "GET /20160918/subnets/ocid1.subnet" {
+			t.Errorf("Unexpected unrecorded requests: %v", report.Unrecorded)
+		}
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+/*
+The stale sweeper deletes the resources leaked by crashed runs. Unlike the other sweepers, which only delete the ocids
+collected in SweeperResourceCompartmentIdMap, it lists the resources of the test compartment and deletes the ones that
+have the freeform tag and are older than the age threshold. For example:
+
+	TF_VAR_sweep_stale_tag=created_by=terraform-tests TF_VAR_sweep_stale_age=48h TF_VAR_sweep_stale_dry_run=false \
+	make sweep sweep=<compartment_ocid> sweep-run=StaleResources
+
+The resources are deleted in the reverse order of the DependencyGraph, so children are deleted before their parents.
+The ocids in SweeperDefaultResourceId are skipped as they are deleted with their parent. By default the sweeper runs
+as a dry run and only reports what it would delete.
+*/
+const (
+	StaleResourcesSweeperName = "StaleResources"
+
+	StaleResourceWouldDelete = "WOULD_DELETE"
+	StaleResourceDeleted     = "DELETED"
+	StaleResourceSkipped     = "SKIPPED"
+	StaleResourceFailed      = "FAILED"
+
+	defaultStaleResourceAge = 24 * time.Hour
+)
+
+type StaleResource struct {
+	SweeperName  string            `json:"sweeperName"`
+	Id           string            `json:"id"`
+	DisplayName  string            `json:"displayName,omitempty"`
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+	TimeCreated  time.Time         `json:"timeCreated"`
+	Status       string            `json:"status"`
+	Reason       string            `json:"reason,omitempty"`
+}
+
+// StaleResourceSweeper lists the resources of one type in a compartment and deletes them. DependencyKey is the key of
+// the resource in the DependencyGraph, e.g. vcn for CoreVcn.
+type StaleResourceSweeper struct {
+	Name          string
+	DependencyKey string
+	List          func(compartment string) ([]StaleResource, error)
+	Delete        func(id string) error
+}
+
+type StaleResourceFilter struct {
+	TagKey   string
+	TagValue string
+	MinAge   time.Duration
+}
+
+type StaleSweepReport struct {
+	CompartmentId string          `json:"compartmentId"`
+	Tag           string          `json:"tag"`
+	CreatedBefore time.Time       `json:"createdBefore"`
+	DryRun        bool            `json:"dryRun"`
+	Resources     []StaleResource `json:"resources"`
+}
+
+var staleResourceSweepers = map[string]*StaleResourceSweeper{}
+
+func init() {
+	resource.AddTestSweepers(StaleResourcesSweeperName, &resource.Sweeper{
+		Name: StaleResourcesSweeperName,
+		F:    sweepStaleResources,
+	})
+}
+
+func AddStaleResourceSweeper(sweeper *StaleResourceSweeper) {
+	if InSweeperExcludeList(sweeper.Name) {
+		return
+	}
+	staleResourceSweepers[sweeper.Name] = sweeper
+}
+
+// GetStaleResourceFilter reads the freeform tag, as key=value, and the age threshold of the stale sweeper
+func GetStaleResourceFilter() (StaleResourceFilter, error) {
+	filter := StaleResourceFilter{MinAge: defaultStaleResourceAge}
+
+	tag := utils.GetEnvSettingWithBlankDefault("sweep_stale_tag")
+	if tag == "" {
+		return filter, fmt.Errorf("sweep_stale_tag is not set")
+	}
+	keyValue := strings.SplitN(tag, "=", 2)
+	if len(keyValue) != 2 || keyValue[0] == "" {
+		return filter, fmt.Errorf("sweep_stale_tag %s should be in the key=value format", tag)
+	}
+	filter.TagKey, filter.TagValue = keyValue[0], keyValue[1]
+
+	if age := utils.GetEnvSettingWithBlankDefault("sweep_stale_age"); age != "" {
+		minAge, err := time.ParseDuration(age)
+		if err != nil {
+			return filter, fmt.Errorf("sweep_stale_age %s is not a valid duration: %v", age, err)
+		}
+		filter.MinAge = minAge
+	}
+	return filter, nil
+}
+
+func (f StaleResourceFilter) matches(staleResource StaleResource, createdBefore time.Time) bool {
+	if value, ok := staleResource.FreeformTags[f.TagKey]; !ok || value != f.TagValue {
+		return false
+	}
+	return staleResource.TimeCreated.Before(createdBefore)
+}
+
+func sweepStaleResources(compartment string) error {
+	filter, err := GetStaleResourceFilter()
+	if err != nil {
+		log.Printf("[INFO] Skip sweeper for %s: %v", StaleResourcesSweeperName, err)
+		return nil
+	}
+	dryRun, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("sweep_stale_dry_run", "true"))
+
+	report, err := SweepStaleResources(staleResourceSweepers, compartment, filter, dryRun, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, staleResource := range report.Resources {
+		log.Printf("[INFO] %s %s %s %s %s", staleResource.Status, staleResource.SweeperName, staleResource.Id, staleResource.DisplayName, staleResource.Reason)
+	}
+	if reportPath := utils.GetEnvSettingWithBlankDefault("sweep_stale_report_path"); reportPath != "" {
+		return report.Save(reportPath)
+	}
+	return nil
+}
+
+// SweepStaleResources lists the resources of every sweeper before deleting the matching ones, children first. The
+// listing is done first as the parents, like the VCNs, register their default resources in SweeperDefaultResourceId.
+func SweepStaleResources(sweepers map[string]*StaleResourceSweeper, compartment string, filter StaleResourceFilter, dryRun bool, now time.Time) (*StaleSweepReport, error) {
+	report := &StaleSweepReport{
+		CompartmentId: compartment,
+		Tag:           fmt.Sprintf("%s=%s", filter.TagKey, filter.TagValue),
+		CreatedBefore: now.Add(-filter.MinAge),
+		DryRun:        dryRun,
+	}
+
+	order := StaleSweeperOrder(sweepers)
+	listed := map[string][]StaleResource{}
+	for _, sweeper := range order {
+		staleResources, err := sweeper.List(compartment)
+		if err != nil {
+			return report, fmt.Errorf("Error listing %s resources for compartment id : %s , %s \n", sweeper.Name, compartment, err)
+		}
+		listed[sweeper.Name] = staleResources
+	}
+
+	for _, sweeper := range order {
+		for _, staleResource := range listed[sweeper.Name] {
+			if !filter.matches(staleResource, report.CreatedBefore) {
+				continue
+			}
+			staleResource.SweeperName = sweeper.Name
+			switch {
+			case SweeperDefaultResourceId[staleResource.Id]:
+				staleResource.Status = StaleResourceSkipped
+				staleResource.Reason = "default resource, deleted with its parent"
+			case dryRun:
+				staleResource.Status = StaleResourceWouldDelete
+			default:
+				if err := sweeper.Delete(staleResource.Id); err != nil {
+					staleResource.Status = StaleResourceFailed
+					staleResource.Reason = err.Error()
+				} else {
+					staleResource.Status = StaleResourceDeleted
+				}
+			}
+			report.Resources = append(report.Resources, staleResource)
+		}
+	}
+	return report, nil
+}
+
+// StaleSweeperOrder sorts the sweepers in the reverse order of the DependencyGraph, the sweepers of the children come
+// before the sweeper of their parent.
+func StaleSweeperOrder(sweepers map[string]*StaleResourceSweeper) []*StaleResourceSweeper {
+	if DependencyGraph == nil {
+		InitDependencyGraph()
+	}
+
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var order []*StaleResourceSweeper
+	visited := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		children := append([]string{}, DependencyGraph[sweepers[name].DependencyKey]...)
+		sort.Strings(children)
+		for _, child := range children {
+			if _, ok := sweepers[child]; ok {
+				visit(child)
+			}
+		}
+		order = append(order, sweepers[name])
+	}
+	for _, name := range names {
+		visit(name)
+	}
+	return order
+}
+
+func (r *StaleSweepReport) Save(path string) error {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0644)
+}
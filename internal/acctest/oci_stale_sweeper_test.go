@@ -0,0 +1,86 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitSweepStaleResources(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	tagged := map[string]string{"created_by": "terraform-tests"}
+	var deleted []string
+
+	newSweeper := func(name string, dependencyKey string, staleResources ...StaleResource) *StaleResourceSweeper {
+		return &StaleResourceSweeper{
+			Name:          name,
+			DependencyKey: dependencyKey,
+			List: func(compartment string) ([]StaleResource, error) {
+				return staleResources, nil
+			},
+			Delete: func(id string) error {
+				if id == "ocid1.subnet.failed" {
+					return fmt.Errorf("conflict")
+				}
+				deleted = append(deleted, id)
+				return nil
+			},
+		}
+	}
+	sweepers := map[string]*StaleResourceSweeper{
+		"CoreVcn": newSweeper("CoreVcn", "vcn",
+			StaleResource{Id: "ocid1.vcn.old", FreeformTags: tagged, TimeCreated: now.Add(-48 * time.Hour)},
+			StaleResource{Id: "ocid1.vcn.recent", FreeformTags: tagged, TimeCreated: now.Add(-time.Hour)},
+			StaleResource{Id: "ocid1.vcn.untagged", TimeCreated: now.Add(-48 * time.Hour)}),
+		"CoreRouteTable": newSweeper("CoreRouteTable", "routeTable",
+			StaleResource{Id: "ocid1.routetable.default", FreeformTags: tagged, TimeCreated: now.Add(-48 * time.Hour)}),
+		"CoreSubnet": newSweeper("CoreSubnet", "subnet",
+			StaleResource{Id: "ocid1.subnet.old", FreeformTags: tagged, TimeCreated: now.Add(-48 * time.Hour)},
+			StaleResource{Id: "ocid1.subnet.failed", FreeformTags: tagged, TimeCreated: now.Add(-48 * time.Hour)}),
+		"CoreInternetGateway": newSweeper("CoreInternetGateway", "internetGateway"),
+	}
+
+	var order []string
+	for _, sweeper := range StaleSweeperOrder(sweepers) {
+		order = append(order, sweeper.Name)
+	}
+	// The subnets use the route tables, which use the internet gateways, which are all in the VCNs
+	assert.Equal(t, []string{"CoreSubnet", "CoreRouteTable", "CoreInternetGateway", "CoreVcn"}, order)
+
+	SweeperDefaultResourceId["ocid1.routetable.default"] = true
+	defer delete(SweeperDefaultResourceId, "ocid1.routetable.default")
+	filter := StaleResourceFilter{TagKey: "created_by", TagValue: "terraform-tests", MinAge: 24 * time.Hour}
+
+	report, err := SweepStaleResources(sweepers, "ocid1.compartment.test", filter, true, now)
+	assert.NoError(t, err)
+	assert.Empty(t, deleted)
+	assert.Equal(t, "created_by=terraform-tests", report.Tag)
+	assert.Len(t, report.Resources, 4)
+	for _, staleResource := range report.Resources {
+		if staleResource.Id == "ocid1.routetable.default" {
+			assert.Equal(t, StaleResourceSkipped, staleResource.Status)
+		} else {
+			assert.Equal(t, StaleResourceWouldDelete, staleResource.Status)
+		}
+	}
+
+	report, err = SweepStaleResources(sweepers, "ocid1.compartment.test", filter, false, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ocid1.subnet.old", "ocid1.vcn.old"}, deleted)
+	statuses := map[string]string{}
+	for _, staleResource := range report.Resources {
+		statuses[staleResource.Id] = staleResource.Status
+	}
+	assert.Equal(t, map[string]string{
+		"ocid1.subnet.old":         StaleResourceDeleted,
+		"ocid1.subnet.failed":      StaleResourceFailed,
+		"ocid1.routetable.default": StaleResourceSkipped,
+		"ocid1.vcn.old":            StaleResourceDeleted,
+	}, statuses)
+}
@@ -369,6 +369,43 @@ func TestUnitBuildClientConfigureFn_interceptor(t *testing.T) {
 	assert.Equal(t, "another-token", r.Header.Get(globalvar.RequestHeaderOpcOboToken))
 }
 
+// ensure read_only mode results in mutating requests being rejected by the interceptor
+// issue-routing-tag: terraform/default
+func TestUnitBuildClientConfigureFn_readOnlyInterceptor(t *testing.T) {
+	provider.ReadOnly = true
+	defer func() { provider.ReadOnly = false }()
+
+	configProvider := oci_common.DefaultConfigProvider()
+	httpClient := provider.BuildHttpClient()
+	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient)
+	assert.NoError(t, err)
+
+	baseClient := &oci_common.BaseClient{}
+	err = configureClientFn(baseClient)
+	assert.NoError(t, err)
+
+	r, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	assert.NoError(t, baseClient.Interceptor(r))
+
+	r, _ = http.NewRequest(http.MethodPost, "https://telemetry.us-phoenix-1.oraclecloud.com/20180401/metrics/actions/summarizeMetricsData", nil)
+	assert.NoError(t, baseClient.Interceptor(r))
+
+	r, _ = http.NewRequest(http.MethodPost, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	err = baseClient.Interceptor(r)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "create vcns")
+
+	r, _ = http.NewRequest(http.MethodDelete, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..xxx", nil)
+	err = baseClient.Interceptor(r)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "delete vcns ocid1.vcn.oc1..xxx")
+
+	r, _ = http.NewRequest(http.MethodPost, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..xxx/actions/changeCompartment", nil)
+	err = baseClient.Interceptor(r)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "call changeCompartment on vcns ocid1.vcn.oc1..xxx")
+}
+
 /*
 // issue-routing-tag: terraform/default
 func TestUnitSupportChangeOboToken(t *testing.T) {
@@ -0,0 +1,482 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakeoci
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type lifecycle struct {
+	creating string
+	created  string
+	deleting string
+	deleted  string
+}
+
+var (
+	coreLifecycle        = lifecycle{creating: "PROVISIONING", created: "AVAILABLE", deleting: "TERMINATING", deleted: "TERMINATED"}
+	compartmentLifecycle = lifecycle{creating: "CREATING", created: "ACTIVE", deleting: "DELETING", deleted: "DELETED"}
+	taggingLifecycle     = lifecycle{created: "ACTIVE", deleting: "DELETING", deleted: "DELETED"}
+)
+
+// resourceKind describes a collection of resources of the fake backend
+type resourceKind struct {
+	// collection is the path of the collection after the version of the API, e.g. "vcns"
+	collection string
+
+	// ocidType is the type of resource in its OCIDs
+	ocidType string
+
+	regional       bool
+	hasDisplayName bool
+	lifecycle      lifecycle
+
+	// required are the attributes that must be set to create the resource
+	required []string
+
+	// parent is the attribute with the OCID of the resource containing this one, which cannot be deleted before it
+	parent string
+
+	// uniqueName is an attribute that must be unique among the resources with the same uniqueScope attribute
+	uniqueName  string
+	uniqueScope string
+
+	// onCreate sets the computed attributes of a new resource
+	onCreate func(s *Server, r *resource)
+
+	// onUpdate sets the computed attributes of an updated resource
+	onUpdate func(s *Server, r *resource)
+}
+
+var resourceKinds = map[string]*resourceKind{}
+
+func registerResourceKind(kind *resourceKind) {
+	resourceKinds[kind.collection] = kind
+}
+
+func init() {
+	registerResourceKind(&resourceKind{
+		collection:     "vcns",
+		ocidType:       "vcn",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId"},
+		onCreate:       onCreateVcn,
+		onUpdate:       setVcnCidrBlocks,
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "subnets",
+		ocidType:       "subnet",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId", "cidrBlock"},
+		parent:         "vcnId",
+		onCreate:       onCreateSubnet,
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "routeTables",
+		ocidType:       "routetable",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId"},
+		parent:         "vcnId",
+		onCreate:       setDefaults(map[string]interface{}{"routeRules": []interface{}{}}),
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "securityLists",
+		ocidType:       "securitylist",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId"},
+		parent:         "vcnId",
+		onCreate:       setDefaults(map[string]interface{}{"egressSecurityRules": []interface{}{}, "ingressSecurityRules": []interface{}{}}),
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "dhcps",
+		ocidType:       "dhcpoptions",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId", "options"},
+		parent:         "vcnId",
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "networkSecurityGroups",
+		ocidType:       "networksecuritygroup",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId"},
+		parent:         "vcnId",
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "internetGateways",
+		ocidType:       "internetgateway",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId"},
+		parent:         "vcnId",
+		onCreate:       setDefaults(map[string]interface{}{"isEnabled": true}),
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "natGateways",
+		ocidType:       "natgateway",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId"},
+		parent:         "vcnId",
+		onCreate:       onCreateNatGateway,
+	})
+	registerResourceKind(&resourceKind{
+		collection:     "serviceGateways",
+		ocidType:       "servicegateway",
+		regional:       true,
+		hasDisplayName: true,
+		lifecycle:      coreLifecycle,
+		required:       []string{"compartmentId", "vcnId", "services"},
+		parent:         "vcnId",
+		onCreate:       setServiceGatewayServices,
+		onUpdate:       setServiceGatewayServices,
+	})
+	registerResourceKind(&resourceKind{
+		collection:  "compartments",
+		ocidType:    "compartment",
+		lifecycle:   compartmentLifecycle,
+		required:    []string{"compartmentId", "name", "description"},
+		uniqueName:  "name",
+		uniqueScope: "compartmentId",
+	})
+	registerResourceKind(&resourceKind{
+		collection:  "tagNamespaces",
+		ocidType:    "tagnamespace",
+		lifecycle:   taggingLifecycle,
+		required:    []string{"compartmentId", "name", "description"},
+		uniqueName:  "name",
+		uniqueScope: "compartmentId",
+		onCreate:    setDefaults(map[string]interface{}{"isRetired": false}),
+	})
+	registerResourceKind(&resourceKind{
+		collection:  "tags",
+		ocidType:    "tagdefinition",
+		lifecycle:   taggingLifecycle,
+		required:    []string{"name", "description"},
+		parent:      "tagNamespaceId",
+		uniqueName:  "name",
+		uniqueScope: "tagNamespaceId",
+		onCreate:    setDefaults(map[string]interface{}{"isRetired": false, "isCostTracking": false}),
+	})
+}
+
+// services are the services that can be used by the service gateways
+func (s *Server) services() []map[string]interface{} {
+	regionKey := strings.ToLower(s.RegionKey)
+	return []map[string]interface{}{
+		{
+			"id":          fmt.Sprintf("ocid1.service.oc1.%s.aaaaaaaaallservices", regionKey),
+			"name":        fmt.Sprintf("All %s Services In Oracle Services Network", strings.ToUpper(regionKey)),
+			"cidrBlock":   fmt.Sprintf("all-%s-services-in-oracle-services-network", regionKey),
+			"description": "All services in the Oracle Services Network",
+		},
+		{
+			"id":          fmt.Sprintf("ocid1.service.oc1.%s.aaaaaaaaobjectstorage", regionKey),
+			"name":        fmt.Sprintf("OCI %s Object Storage", strings.ToUpper(regionKey)),
+			"cidrBlock":   fmt.Sprintf("oci-%s-objectstorage", regionKey),
+			"description": "Object Storage",
+		},
+	}
+}
+
+func setDefaults(defaults map[string]interface{}) func(s *Server, r *resource) {
+	return func(s *Server, r *resource) {
+		for key, value := range defaults {
+			if _, ok := r.attributes[key]; !ok {
+				r.attributes[key] = value
+			}
+		}
+	}
+}
+
+// onCreateVcn creates the default route table, security list and DHCP options of a new VCN
+func onCreateVcn(s *Server, r *resource) {
+	setVcnCidrBlocks(s, r)
+	if dnsLabel, ok := r.attributes["dnsLabel"].(string); ok && dnsLabel != "" {
+		r.attributes["vcnDomainName"] = dnsLabel + ".oraclevcn.com"
+	}
+
+	displayName := r.attributes["displayName"]
+	defaults := []struct {
+		kind       string
+		attribute  string
+		attributes map[string]interface{}
+	}{
+		{"routeTables", "defaultRouteTableId", map[string]interface{}{
+			"displayName": fmt.Sprintf("Default Route Table for %v", displayName),
+			"routeRules":  []interface{}{},
+		}},
+		{"securityLists", "defaultSecurityListId", map[string]interface{}{
+			"displayName": fmt.Sprintf("Default Security List for %v", displayName),
+			"egressSecurityRules": []interface{}{
+				map[string]interface{}{"destination": "0.0.0.0/0", "destinationType": "CIDR_BLOCK", "protocol": "all", "isStateless": false},
+			},
+			"ingressSecurityRules": []interface{}{
+				map[string]interface{}{"source": "0.0.0.0/0", "sourceType": "CIDR_BLOCK", "protocol": "6", "isStateless": false,
+					"tcpOptions": map[string]interface{}{"destinationPortRange": map[string]interface{}{"min": 22, "max": 22}}},
+			},
+		}},
+		{"dhcps", "defaultDhcpOptionsId", map[string]interface{}{
+			"displayName": fmt.Sprintf("Default DHCP Options for %v", displayName),
+			"options": []interface{}{
+				map[string]interface{}{"type": "DomainNameServer", "serverType": "VcnLocalPlusInternet"},
+			},
+		}},
+	}
+	for _, d := range defaults {
+		d.attributes["compartmentId"] = r.attributes["compartmentId"]
+		d.attributes["vcnId"] = r.attributes["id"]
+		child, _, err := s.newResource(resourceKinds[d.kind], d.attributes)
+		if err != nil {
+			continue
+		}
+		child.isDefault = true
+		child.setState(child.kind.lifecycle.created)
+		r.attributes[d.attribute] = child.attributes["id"]
+	}
+}
+
+func setVcnCidrBlocks(s *Server, r *resource) {
+	if cidrBlocks, ok := r.attributes["cidrBlocks"].([]interface{}); ok && len(cidrBlocks) > 0 {
+		r.attributes["cidrBlock"] = cidrBlocks[0]
+	} else if cidrBlock, ok := r.attributes["cidrBlock"]; ok {
+		r.attributes["cidrBlocks"] = []interface{}{cidrBlock}
+	}
+}
+
+// onCreateSubnet uses the default route table, security list and DHCP options of the VCN unless they are set
+func onCreateSubnet(s *Server, r *resource) {
+	vcn := s.resources[r.attributes["vcnId"].(string)]
+	if _, ok := r.attributes["routeTableId"]; !ok {
+		r.attributes["routeTableId"] = vcn.attributes["defaultRouteTableId"]
+	}
+	if _, ok := r.attributes["securityListIds"]; !ok {
+		r.attributes["securityListIds"] = []interface{}{vcn.attributes["defaultSecurityListId"]}
+	}
+	if _, ok := r.attributes["dhcpOptionsId"]; !ok {
+		r.attributes["dhcpOptionsId"] = vcn.attributes["defaultDhcpOptionsId"]
+	}
+	setDefaults(map[string]interface{}{
+		"prohibitPublicIpOnVnic":  false,
+		"prohibitInternetIngress": false,
+		"virtualRouterMac":        "00:00:17:00:00:01",
+	})(s, r)
+	if dnsLabel, ok := r.attributes["dnsLabel"].(string); ok && dnsLabel != "" {
+		if vcnDomainName, ok := vcn.attributes["vcnDomainName"].(string); ok {
+			r.attributes["subnetDomainName"] = dnsLabel + "." + vcnDomainName
+		}
+	}
+	// The virtual router uses the first host address of the subnet
+	if _, network, err := net.ParseCIDR(fmt.Sprintf("%v", r.attributes["cidrBlock"])); err == nil {
+		ip := network.IP.To4()
+		if ip != nil {
+			router := make(net.IP, len(ip))
+			copy(router, ip)
+			router[len(router)-1]++
+			r.attributes["virtualRouterIp"] = router.String()
+		}
+	}
+}
+
+func onCreateNatGateway(s *Server, r *resource) {
+	setDefaults(map[string]interface{}{"blockTraffic": false})(s, r)
+	r.attributes["natIp"] = fmt.Sprintf("203.0.113.%d", r.sequence%254+1)
+	r.attributes["publicIpId"] = newId(fmt.Sprintf("ocid1.publicip.oc1.%s.", s.RegionKey))
+}
+
+// setServiceGatewayServices adds the names of the services, the requests only contain their OCIDs
+func setServiceGatewayServices(s *Server, r *resource) {
+	setDefaults(map[string]interface{}{"blockTraffic": false})(s, r)
+	services, _ := r.attributes["services"].([]interface{})
+	for _, unknown := range services {
+		service, ok := unknown.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, known := range s.services() {
+			if known["id"] == service["serviceId"] {
+				service["serviceName"] = known["name"]
+			}
+		}
+	}
+}
+
+func (s *Server) serveServices(w http.ResponseWriter, req *http.Request, segments []string) {
+	if req.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", req.Method)
+		return
+	}
+	if len(segments) == 1 {
+		writeJSON(w, http.StatusOK, s.services())
+		return
+	}
+	for _, service := range s.services() {
+		if service["id"] == segments[1] {
+			writeJSON(w, http.StatusOK, service)
+			return
+		}
+	}
+	writeNotFound(w, segments[1])
+}
+
+// serveTags serves the tags of a namespace, which are identified by their name
+func (s *Server) serveTags(w http.ResponseWriter, req *http.Request, segments []string, body map[string]interface{}) {
+	namespace, ok := s.get(resourceKinds["tagNamespaces"], segments[1])
+	if !ok {
+		writeNotFound(w, segments[1])
+		return
+	}
+	kind := resourceKinds["tags"]
+
+	if len(segments) == 3 {
+		switch req.Method {
+		case http.MethodGet:
+			s.list(w, req, kind, func(r *resource) bool {
+				return r.attributes["tagNamespaceId"] == segments[1]
+			})
+		case http.MethodPost:
+			if body == nil {
+				body = map[string]interface{}{}
+			}
+			body["tagNamespaceId"] = segments[1]
+			body["tagNamespaceName"] = namespace.attributes["name"]
+			body["compartmentId"] = namespace.attributes["compartmentId"]
+			s.create(w, req, kind, body)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", req.Method)
+		}
+		return
+	}
+
+	var tag *resource
+	for _, r := range s.resources {
+		if r.kind == kind && r.attributes["tagNamespaceId"] == segments[1] && r.attributes["name"] == segments[3] && r.state() != kind.lifecycle.deleted {
+			tag = r
+		}
+	}
+	if tag == nil || len(segments) != 4 {
+		writeNotFound(w, strings.Join(segments, "/"))
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		s.poll(tag)
+		writeResource(w, http.StatusOK, tag)
+	case http.MethodPut:
+		s.update(w, req, tag, body)
+	case http.MethodDelete:
+		// The tags are deleted asynchronously with a tagging work request
+		now := time.Now().UTC().Format(timeFormat)
+		workRequestId := newId("ocid1.taggingworkrequest.oc1..")
+		s.workRequests[workRequestId] = map[string]interface{}{
+			"id":            workRequestId,
+			"operationType": "DELETE_TAG_DEFINITION",
+			"status":        "SUCCEEDED",
+			"compartmentId": tag.attributes["compartmentId"],
+			"resources": []interface{}{
+				map[string]interface{}{
+					"entityType": "tag",
+					"actionType": "DELETED",
+					"identifier": tag.attributes["id"],
+					"entityUri":  "/" + strings.Join(segments, "/"),
+				},
+			},
+			"timeAccepted":    now,
+			"timeStarted":     now,
+			"timeFinished":    now,
+			"percentComplete": 100,
+		}
+		w.Header().Set("opc-work-request-id", workRequestId)
+		s.delete(w, tag)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", req.Method)
+	}
+}
+
+func (s *Server) serveTaggingWorkRequest(w http.ResponseWriter, req *http.Request, segments []string) {
+	if len(segments) < 2 || req.Method != http.MethodGet {
+		writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", req.URL.Path)
+		return
+	}
+	workRequest, ok := s.workRequests[segments[1]]
+	if !ok {
+		writeNotFound(w, segments[1])
+		return
+	}
+	if len(segments) == 3 && (segments[2] == "errors" || segments[2] == "logs") {
+		writeJSON(w, http.StatusOK, []interface{}{})
+		return
+	}
+	writeJSON(w, http.StatusOK, workRequest)
+}
+
+// securityRulesAction adds, updates or removes the security rules of a network security group
+func (s *Server) securityRulesAction(w http.ResponseWriter, r *resource, action string, body map[string]interface{}) {
+	var result []map[string]interface{}
+	switch action {
+	case "addSecurityRules", "updateSecurityRules":
+		rules, _ := body["securityRules"].([]interface{})
+		for _, unknown := range rules {
+			rule, ok := unknown.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rule["isValid"] = true
+			if action == "addSecurityRules" {
+				s.sequence++
+				rule["id"] = fmt.Sprintf("%06X", s.sequence)
+				rule["timeCreated"] = time.Now().UTC().Format(timeFormat)
+				r.securityRules = append(r.securityRules, rule)
+				result = append(result, rule)
+				continue
+			}
+			for index, existing := range r.securityRules {
+				if existing["id"] == rule["id"] {
+					rule["timeCreated"] = existing["timeCreated"]
+					r.securityRules[index] = rule
+					result = append(result, rule)
+				}
+			}
+		}
+	case "removeSecurityRules":
+		ids, _ := body["securityRuleIds"].([]interface{})
+		kept := r.securityRules[:0]
+		for _, rule := range r.securityRules {
+			removed := false
+			for _, id := range ids {
+				if rule["id"] == id {
+					removed = true
+				}
+			}
+			if !removed {
+				kept = append(kept, rule)
+			}
+		}
+		r.securityRules = kept
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if result == nil {
+		result = []map[string]interface{}{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"securityRules": result})
+}
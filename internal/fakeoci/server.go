@@ -0,0 +1,497 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakeoci
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	DefaultTenancyOcid     = globalvar.TestAuthTenancyOcid
+	DefaultRegionKey       = "phx"
+	DefaultTransitionPolls = 2
+
+	timeFormat = "2006-01-02T15:04:05.000Z"
+)
+
+// listFilters are the query parameters of the list operations that filter the resources by attribute
+var listFilters = []string{"compartmentId", "vcnId", "displayName", "name", "lifecycleState"}
+
+// Server is an in-memory fake of the OCI control plane for the core networking and identity resources. The provider is
+// pointed at it with CLIENT_HOST_OVERRIDES or domain_name_override and the Test auth, so that the resources can be
+// created, polled and deleted without a tenancy.
+type Server struct {
+	// TenancyOcid is the OCID of the root compartment
+	TenancyOcid string
+
+	// RegionKey is put in the OCIDs of the regional resources
+	RegionKey string
+
+	// TransitionPolls is the number of reads after which a resource leaves its transitional lifecycle state
+	TransitionPolls int
+
+	mutex        sync.Mutex
+	resources    map[string]*resource
+	retryTokens  map[string]string
+	workRequests map[string]map[string]interface{}
+	sequence     int
+}
+
+type resource struct {
+	kind       *resourceKind
+	attributes map[string]interface{}
+	sequence   int
+	polls      int
+	revision   int
+
+	// isDefault is set on the default route table, security list and DHCP options of a VCN, which are deleted with it
+	isDefault bool
+
+	// securityRules are the rules of a network security group
+	securityRules []map[string]interface{}
+}
+
+// NewServer returns a Server with an empty tenancy
+func NewServer() *Server {
+	s := &Server{
+		TenancyOcid:     DefaultTenancyOcid,
+		RegionKey:       DefaultRegionKey,
+		TransitionPolls: DefaultTransitionPolls,
+		resources:       map[string]*resource{},
+		retryTokens:     map[string]string{},
+		workRequests:    map[string]map[string]interface{}{},
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	w.Header().Set("opc-request-id", newId(""))
+
+	// The first segment is the version of the API, e.g. 20160918
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) < 2 {
+		writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s is not supported by the fake backend", req.URL.Path))
+		return
+	}
+	segments = segments[1:]
+
+	var body map[string]interface{}
+	if req.Method == http.MethodPost || req.Method == http.MethodPut {
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", err.Error())
+			return
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("the body is not a JSON object: %v", err))
+				return
+			}
+		}
+	}
+
+	s.ensureTenancy()
+	s.route(w, req, segments, body)
+}
+
+func (s *Server) route(w http.ResponseWriter, req *http.Request, segments []string, body map[string]interface{}) {
+	collection := segments[0]
+	switch {
+	case collection == "services":
+		s.serveServices(w, req, segments)
+		return
+	case collection == "taggingWorkRequests":
+		s.serveTaggingWorkRequest(w, req, segments)
+		return
+	case collection == "tagNamespaces" && len(segments) >= 3 && segments[2] == "tags":
+		s.serveTags(w, req, segments, body)
+		return
+	}
+
+	kind, ok := resourceKinds[collection]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s is not supported by the fake backend", req.URL.Path))
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && req.Method == http.MethodGet:
+		s.list(w, req, kind, nil)
+	case len(segments) == 1 && req.Method == http.MethodPost:
+		s.create(w, req, kind, body)
+	case len(segments) == 2:
+		r, ok := s.get(kind, segments[1])
+		if !ok {
+			writeNotFound(w, segments[1])
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			s.poll(r)
+			writeResource(w, http.StatusOK, r)
+		case http.MethodPut:
+			s.update(w, req, r, body)
+		case http.MethodDelete:
+			s.delete(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", req.Method)
+		}
+	case len(segments) == 3 && segments[2] == "securityRules" && req.Method == http.MethodGet:
+		r, ok := s.get(kind, segments[1])
+		if !ok {
+			writeNotFound(w, segments[1])
+			return
+		}
+		writeJSON(w, http.StatusOK, r.securityRules)
+	case len(segments) == 4 && segments[2] == "actions" && req.Method == http.MethodPost:
+		r, ok := s.get(kind, segments[1])
+		if !ok {
+			writeNotFound(w, segments[1])
+			return
+		}
+		s.action(w, r, segments[3], body)
+	default:
+		writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s %s is not supported by the fake backend", req.Method, req.URL.Path))
+	}
+}
+
+// ensureTenancy adds the root compartment, so that it can be read like any compartment
+func (s *Server) ensureTenancy() {
+	if _, ok := s.resources[s.TenancyOcid]; ok {
+		return
+	}
+	s.sequence++
+	s.resources[s.TenancyOcid] = &resource{
+		kind:     resourceKinds["compartments"],
+		sequence: s.sequence,
+		attributes: map[string]interface{}{
+			"id":             s.TenancyOcid,
+			"compartmentId":  s.TenancyOcid,
+			"name":           "tenancy",
+			"description":    "The root compartment of the fake tenancy",
+			"lifecycleState": "ACTIVE",
+			"timeCreated":    time.Now().UTC().Format(timeFormat),
+		},
+	}
+}
+
+func (s *Server) get(kind *resourceKind, id string) (*resource, bool) {
+	r, ok := s.resources[id]
+	if !ok || r.kind != kind {
+		return nil, false
+	}
+	return r, true
+}
+
+// poll moves a resource out of its transitional lifecycle state once it was read TransitionPolls times
+func (s *Server) poll(r *resource) {
+	state := r.state()
+	if state != r.kind.lifecycle.creating && state != r.kind.lifecycle.deleting {
+		return
+	}
+	r.polls++
+	if r.polls < s.TransitionPolls {
+		return
+	}
+	r.polls = 0
+	if state == r.kind.lifecycle.creating {
+		r.setState(r.kind.lifecycle.created)
+	} else {
+		r.setState(r.kind.lifecycle.deleted)
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, req *http.Request, kind *resourceKind, match func(*resource) bool) {
+	query := req.URL.Query()
+	var items []*resource
+	for _, r := range s.resources {
+		if r.kind != kind || (match != nil && !match(r)) {
+			continue
+		}
+		// The root compartment is not listed in itself
+		if r.attributes["id"] == query.Get("compartmentId") {
+			continue
+		}
+		s.poll(r)
+		matched := true
+		for _, filter := range listFilters {
+			if value := query.Get(filter); value != "" && r.attributes[filter] != value {
+				matched = false
+			}
+		}
+		if matched {
+			items = append(items, r)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].sequence < items[j].sequence
+	})
+
+	start, _ := strconv.Atoi(query.Get("page"))
+	if start > len(items) {
+		start = len(items)
+	}
+	end := len(items)
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 && start+limit < end {
+		end = start + limit
+		w.Header().Set("opc-next-page", strconv.Itoa(end))
+	}
+
+	result := make([]map[string]interface{}, 0, end-start)
+	for _, r := range items[start:end] {
+		result = append(result, r.attributes)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) create(w http.ResponseWriter, req *http.Request, kind *resourceKind, body map[string]interface{}) {
+	retryToken := req.Header.Get("opc-retry-token")
+	if id, ok := s.retryTokens[kind.collection+retryToken]; ok && retryToken != "" {
+		writeResource(w, http.StatusOK, s.resources[id])
+		return
+	}
+
+	r, status, err := s.newResource(kind, body)
+	if err != nil {
+		writeError(w, status, err.code, err.message)
+		return
+	}
+	if retryToken != "" {
+		s.retryTokens[kind.collection+retryToken] = r.attributes["id"].(string)
+	}
+	writeResource(w, http.StatusOK, r)
+}
+
+type serviceError struct {
+	code    string
+	message string
+}
+
+// newResource validates the attributes of a new resource and adds it in its creating lifecycle state
+func (s *Server) newResource(kind *resourceKind, attributes map[string]interface{}) (*resource, int, *serviceError) {
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+	for _, attribute := range kind.required {
+		if value, ok := attributes[attribute]; !ok || value == nil || value == "" {
+			return nil, http.StatusBadRequest, &serviceError{"MissingParameter", fmt.Sprintf("%s is required", attribute)}
+		}
+	}
+	if kind.parent != "" {
+		parentId, _ := attributes[kind.parent].(string)
+		parent, ok := s.resources[parentId]
+		if !ok || !parent.isAlive() {
+			return nil, http.StatusNotFound, &serviceError{"NotAuthorizedOrNotFound", fmt.Sprintf("%s %s not found", kind.parent, parentId)}
+		}
+	}
+	if kind.uniqueName != "" {
+		for _, other := range s.resources {
+			if other.kind == kind && other.isAlive() && other.attributes[kind.uniqueName] == attributes[kind.uniqueName] &&
+				other.attributes[kind.uniqueScope] == attributes[kind.uniqueScope] {
+				return nil, http.StatusConflict, &serviceError{"Conflict", fmt.Sprintf("%s '%v' already exists", kind.ocidType, attributes[kind.uniqueName])}
+			}
+		}
+	}
+
+	s.sequence++
+	now := time.Now().UTC()
+	attributes["id"] = s.newOcid(kind)
+	attributes["timeCreated"] = now.Format(timeFormat)
+	if kind.lifecycle.creating != "" {
+		attributes["lifecycleState"] = kind.lifecycle.creating
+	} else {
+		attributes["lifecycleState"] = kind.lifecycle.created
+	}
+	if kind.hasDisplayName {
+		if _, ok := attributes["displayName"]; !ok {
+			attributes["displayName"] = fmt.Sprintf("%s%s", kind.ocidType, now.Format("20060102150405"))
+		}
+	}
+	for _, tags := range []string{"freeformTags", "definedTags"} {
+		if _, ok := attributes[tags]; !ok {
+			attributes[tags] = map[string]interface{}{}
+		}
+	}
+
+	r := &resource{
+		kind:       kind,
+		attributes: attributes,
+		sequence:   s.sequence,
+	}
+	s.resources[attributes["id"].(string)] = r
+	if kind.onCreate != nil {
+		kind.onCreate(s, r)
+	}
+	return r, http.StatusOK, nil
+}
+
+func (s *Server) update(w http.ResponseWriter, req *http.Request, r *resource, body map[string]interface{}) {
+	if ifMatch := req.Header.Get("if-match"); ifMatch != "" && ifMatch != r.etag() {
+		writeError(w, http.StatusPreconditionFailed, "NoEtagMatch", "The resource was modified since it was read")
+		return
+	}
+	if !r.isAlive() {
+		writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("%s is %s", r.attributes["id"], r.state()))
+		return
+	}
+	for key, value := range body {
+		switch key {
+		case "id", "lifecycleState", "timeCreated":
+			continue
+		}
+		r.attributes[key] = value
+	}
+	if r.kind.onUpdate != nil {
+		r.kind.onUpdate(s, r)
+	}
+	r.revision++
+	writeResource(w, http.StatusOK, r)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *resource) {
+	if !r.isAlive() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.isDefault {
+		writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("%s is a default resource of its VCN and is deleted with it", r.attributes["id"]))
+		return
+	}
+	if dependent := s.dependent(r); dependent != nil {
+		writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("%s %s is still referenced by %s %s",
+			r.kind.ocidType, r.attributes["id"], dependent.kind.ocidType, dependent.attributes["id"]))
+		return
+	}
+
+	r.polls = 0
+	r.setState(r.kind.lifecycle.deleting)
+	r.revision++
+	for _, other := range s.resources {
+		if other.isDefault && other.attributes["vcnId"] == r.attributes["id"] {
+			other.setState(other.kind.lifecycle.deleted)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dependent returns a resource that prevents r from being deleted, like a subnet of a VCN or a VCN of a compartment
+func (s *Server) dependent(r *resource) *resource {
+	id := r.attributes["id"]
+	for _, other := range s.resources {
+		if other == r || other.isDefault || !other.isAlive() {
+			continue
+		}
+		if other.kind.parent != "" && other.attributes[other.kind.parent] == id {
+			return other
+		}
+		if r.kind.collection == "compartments" && other.attributes["compartmentId"] == id {
+			return other
+		}
+	}
+	return nil
+}
+
+func (s *Server) action(w http.ResponseWriter, r *resource, action string, body map[string]interface{}) {
+	if !r.isAlive() {
+		writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("%s is %s", r.attributes["id"], r.state()))
+		return
+	}
+	switch action {
+	case "changeCompartment":
+		r.attributes["compartmentId"] = body["compartmentId"]
+	case "moveCompartment":
+		r.attributes["compartmentId"] = body["targetCompartmentId"]
+	case "addSecurityRules", "updateSecurityRules", "removeSecurityRules":
+		if r.kind.collection != "networkSecurityGroups" {
+			break
+		}
+		s.securityRulesAction(w, r, action, body)
+		return
+	default:
+		writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("action %s is not supported by the fake backend", action))
+		return
+	}
+	r.revision++
+	w.Header().Set("etag", r.etag())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) newOcid(kind *resourceKind) string {
+	if kind.regional {
+		return newId(fmt.Sprintf("ocid1.%s.oc1.%s.", kind.ocidType, s.RegionKey))
+	}
+	return newId(fmt.Sprintf("ocid1.%s.oc1..", kind.ocidType))
+}
+
+func newId(prefix string) string {
+	random := make([]byte, 33)
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+	return prefix + "aaaaaaaa" + strings.ToLower(base32.StdEncoding.EncodeToString(random))[:52]
+}
+
+func (r *resource) state() string {
+	state, _ := r.attributes["lifecycleState"].(string)
+	return state
+}
+
+func (r *resource) setState(state string) {
+	r.attributes["lifecycleState"] = state
+}
+
+// isAlive returns false when the resource is being deleted or is deleted
+func (r *resource) isAlive() bool {
+	state := r.state()
+	return state != r.kind.lifecycle.deleting && state != r.kind.lifecycle.deleted
+}
+
+func (r *resource) etag() string {
+	return fmt.Sprintf("%x-%d", r.sequence, r.revision)
+}
+
+func writeResource(w http.ResponseWriter, status int, r *resource) {
+	w.Header().Set("etag", r.etag())
+	writeJSON(w, status, r.attributes)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("Authorization failed or requested resource %s not found", id))
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	body, _ := json.Marshal(map[string]string{
+		"code":    code,
+		"message": message,
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakeoci
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http/httptest"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	oci_core "github.com/oracle/oci-go-sdk/v55/core"
+	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfigurationProvider(t *testing.T) oci_common.ConfigurationProvider {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate the key: %v", err)
+	}
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	return oci_common.NewRawConfigurationProvider(DefaultTenancyOcid, "ocid1.user.oc1..test", "us-phoenix-1", "00:00", string(privateKeyPem), nil)
+}
+
+func newTestClients(t *testing.T) (*httptest.Server, oci_core.VirtualNetworkClient, oci_identity.IdentityClient) {
+	server := httptest.NewServer(NewServer())
+	configProvider := testConfigurationProvider(t)
+
+	networkClient, err := oci_core.NewVirtualNetworkClientWithConfigurationProvider(configProvider)
+	if err != nil {
+		t.Fatalf("unable to create the client: %v", err)
+	}
+	networkClient.Host = server.URL
+
+	identityClient, err := oci_identity.NewIdentityClientWithConfigurationProvider(configProvider)
+	if err != nil {
+		t.Fatalf("unable to create the client: %v", err)
+	}
+	identityClient.Host = server.URL
+	return server, networkClient, identityClient
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFakeBackendNetworking(t *testing.T) {
+	server, client, _ := newTestClients(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	createVcn, err := client.CreateVcn(ctx, oci_core.CreateVcnRequest{
+		CreateVcnDetails: oci_core.CreateVcnDetails{
+			CompartmentId: oci_common.String(DefaultTenancyOcid),
+			CidrBlock:     oci_common.String("10.0.0.0/16"),
+			DisplayName:   oci_common.String("vcn"),
+			DnsLabel:      oci_common.String("vcn"),
+		},
+		OpcRetryToken: oci_common.String("create-vcn"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.VcnLifecycleStateProvisioning, createVcn.LifecycleState)
+	assert.Regexp(t, `^ocid1\.vcn\.oc1\.phx\.`, *createVcn.Id)
+	assert.Equal(t, []string{"10.0.0.0/16"}, createVcn.CidrBlocks)
+	assert.Equal(t, "vcn.oraclevcn.com", *createVcn.VcnDomainName)
+
+	// The same retry token returns the same VCN
+	retriedVcn, err := client.CreateVcn(ctx, oci_core.CreateVcnRequest{
+		CreateVcnDetails: oci_core.CreateVcnDetails{CompartmentId: oci_common.String(DefaultTenancyOcid)},
+		OpcRetryToken:    oci_common.String("create-vcn"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, *createVcn.Id, *retriedVcn.Id)
+
+	// The VCN is available after DefaultTransitionPolls reads
+	getVcn, err := client.GetVcn(ctx, oci_core.GetVcnRequest{VcnId: createVcn.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.VcnLifecycleStateProvisioning, getVcn.LifecycleState)
+	getVcn, err = client.GetVcn(ctx, oci_core.GetVcnRequest{VcnId: createVcn.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.VcnLifecycleStateAvailable, getVcn.LifecycleState)
+
+	defaultRouteTable, err := client.GetRouteTable(ctx, oci_core.GetRouteTableRequest{RtId: createVcn.DefaultRouteTableId})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.RouteTableLifecycleStateAvailable, defaultRouteTable.LifecycleState)
+
+	subnet, err := client.CreateSubnet(ctx, oci_core.CreateSubnetRequest{
+		CreateSubnetDetails: oci_core.CreateSubnetDetails{
+			CompartmentId: oci_common.String(DefaultTenancyOcid),
+			VcnId:         createVcn.Id,
+			CidrBlock:     oci_common.String("10.0.1.0/24"),
+			DnsLabel:      oci_common.String("subnet"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, *createVcn.DefaultRouteTableId, *subnet.RouteTableId)
+	assert.Equal(t, []string{*createVcn.DefaultSecurityListId}, subnet.SecurityListIds)
+	assert.Equal(t, "10.0.1.1", *subnet.VirtualRouterIp)
+	assert.Equal(t, "subnet.vcn.oraclevcn.com", *subnet.SubnetDomainName)
+
+	_, err = client.CreateSubnet(ctx, oci_core.CreateSubnetRequest{
+		CreateSubnetDetails: oci_core.CreateSubnetDetails{
+			CompartmentId: oci_common.String(DefaultTenancyOcid),
+			VcnId:         oci_common.String("ocid1.vcn.oc1.phx.missing"),
+			CidrBlock:     oci_common.String("10.0.2.0/24"),
+		},
+	})
+	assert.Equal(t, 404, err.(oci_common.ServiceError).GetHTTPStatusCode())
+
+	// The VCN cannot be deleted before its subnets
+	_, err = client.DeleteVcn(ctx, oci_core.DeleteVcnRequest{VcnId: createVcn.Id})
+	assert.Equal(t, 409, err.(oci_common.ServiceError).GetHTTPStatusCode())
+
+	_, err = client.DeleteSubnet(ctx, oci_core.DeleteSubnetRequest{SubnetId: subnet.Id})
+	assert.NoError(t, err)
+	getSubnet, err := client.GetSubnet(ctx, oci_core.GetSubnetRequest{SubnetId: subnet.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.SubnetLifecycleStateTerminating, getSubnet.LifecycleState)
+	getSubnet, err = client.GetSubnet(ctx, oci_core.GetSubnetRequest{SubnetId: subnet.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.SubnetLifecycleStateTerminated, getSubnet.LifecycleState)
+
+	_, err = client.DeleteVcn(ctx, oci_core.DeleteVcnRequest{VcnId: createVcn.Id})
+	assert.NoError(t, err)
+	defaultRouteTable, err = client.GetRouteTable(ctx, oci_core.GetRouteTableRequest{RtId: createVcn.DefaultRouteTableId})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_core.RouteTableLifecycleStateTerminated, defaultRouteTable.LifecycleState)
+
+	vcns, err := client.ListVcns(ctx, oci_core.ListVcnsRequest{
+		CompartmentId:  oci_common.String(DefaultTenancyOcid),
+		LifecycleState: oci_core.VcnLifecycleStateTerminating,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, vcns.Items, 1)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFakeBackendNetworkSecurityGroupRules(t *testing.T) {
+	server, client, _ := newTestClients(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	vcn, err := client.CreateVcn(ctx, oci_core.CreateVcnRequest{
+		CreateVcnDetails: oci_core.CreateVcnDetails{CompartmentId: oci_common.String(DefaultTenancyOcid), CidrBlock: oci_common.String("10.0.0.0/16")},
+	})
+	assert.NoError(t, err)
+	nsg, err := client.CreateNetworkSecurityGroup(ctx, oci_core.CreateNetworkSecurityGroupRequest{
+		CreateNetworkSecurityGroupDetails: oci_core.CreateNetworkSecurityGroupDetails{CompartmentId: oci_common.String(DefaultTenancyOcid), VcnId: vcn.Id},
+	})
+	assert.NoError(t, err)
+
+	added, err := client.AddNetworkSecurityGroupSecurityRules(ctx, oci_core.AddNetworkSecurityGroupSecurityRulesRequest{
+		NetworkSecurityGroupId: nsg.Id,
+		AddNetworkSecurityGroupSecurityRulesDetails: oci_core.AddNetworkSecurityGroupSecurityRulesDetails{
+			SecurityRules: []oci_core.AddSecurityRuleDetails{
+				{Direction: oci_core.AddSecurityRuleDetailsDirectionIngress, Protocol: oci_common.String("6"), Source: oci_common.String("0.0.0.0/0")},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, added.SecurityRules, 1)
+
+	rules, err := client.ListNetworkSecurityGroupSecurityRules(ctx, oci_core.ListNetworkSecurityGroupSecurityRulesRequest{NetworkSecurityGroupId: nsg.Id})
+	assert.NoError(t, err)
+	assert.Len(t, rules.Items, 1)
+
+	_, err = client.RemoveNetworkSecurityGroupSecurityRules(ctx, oci_core.RemoveNetworkSecurityGroupSecurityRulesRequest{
+		NetworkSecurityGroupId: nsg.Id,
+		RemoveNetworkSecurityGroupSecurityRulesDetails: oci_core.RemoveNetworkSecurityGroupSecurityRulesDetails{
+			SecurityRuleIds: []string{*added.SecurityRules[0].Id},
+		},
+	})
+	assert.NoError(t, err)
+	rules, err = client.ListNetworkSecurityGroupSecurityRules(ctx, oci_core.ListNetworkSecurityGroupSecurityRulesRequest{NetworkSecurityGroupId: nsg.Id})
+	assert.NoError(t, err)
+	assert.Len(t, rules.Items, 0)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFakeBackendIdentity(t *testing.T) {
+	server, _, client := newTestClients(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	tenancy, err := client.GetCompartment(ctx, oci_identity.GetCompartmentRequest{CompartmentId: oci_common.String(DefaultTenancyOcid)})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_identity.CompartmentLifecycleStateActive, tenancy.LifecycleState)
+
+	compartment, err := client.CreateCompartment(ctx, oci_identity.CreateCompartmentRequest{
+		CreateCompartmentDetails: oci_identity.CreateCompartmentDetails{
+			CompartmentId: oci_common.String(DefaultTenancyOcid),
+			Name:          oci_common.String("network"),
+			Description:   oci_common.String("network"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_identity.CompartmentLifecycleStateCreating, compartment.LifecycleState)
+
+	_, err = client.CreateCompartment(ctx, oci_identity.CreateCompartmentRequest{
+		CreateCompartmentDetails: oci_identity.CreateCompartmentDetails{
+			CompartmentId: oci_common.String(DefaultTenancyOcid),
+			Name:          oci_common.String("network"),
+			Description:   oci_common.String("network"),
+		},
+	})
+	assert.Equal(t, 409, err.(oci_common.ServiceError).GetHTTPStatusCode())
+	assert.Contains(t, err.Error(), "already exists")
+
+	namespace, err := client.CreateTagNamespace(ctx, oci_identity.CreateTagNamespaceRequest{
+		CreateTagNamespaceDetails: oci_identity.CreateTagNamespaceDetails{
+			CompartmentId: compartment.Id,
+			Name:          oci_common.String("operations"),
+			Description:   oci_common.String("operations"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_identity.TagNamespaceLifecycleStateActive, namespace.LifecycleState)
+
+	tag, err := client.CreateTag(ctx, oci_identity.CreateTagRequest{
+		TagNamespaceId:   namespace.Id,
+		CreateTagDetails: oci_identity.CreateTagDetails{Name: oci_common.String("cost_center"), Description: oci_common.String("cost center")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "operations", *tag.TagNamespaceName)
+
+	getTag, err := client.GetTag(ctx, oci_identity.GetTagRequest{TagNamespaceId: namespace.Id, TagName: oci_common.String("cost_center")})
+	assert.NoError(t, err)
+	assert.Equal(t, *tag.Id, *getTag.Id)
+
+	// The compartment cannot be deleted while it contains the namespace
+	_, err = client.DeleteCompartment(ctx, oci_identity.DeleteCompartmentRequest{CompartmentId: compartment.Id})
+	assert.Equal(t, 409, err.(oci_common.ServiceError).GetHTTPStatusCode())
+
+	deleteTag, err := client.DeleteTag(ctx, oci_identity.DeleteTagRequest{TagNamespaceId: namespace.Id, TagName: oci_common.String("cost_center")})
+	assert.NoError(t, err)
+	workRequest, err := client.GetTaggingWorkRequest(ctx, oci_identity.GetTaggingWorkRequestRequest{WorkRequestId: deleteTag.OpcWorkRequestId})
+	assert.NoError(t, err)
+	assert.Equal(t, oci_identity.TaggingWorkRequestStatusSucceeded, workRequest.Status)
+	assert.Equal(t, *tag.Id, *workRequest.Resources[0].Identifier)
+}
@@ -7,6 +7,10 @@ const (
 	AuthInstancePrincipalSetting          = "InstancePrincipal"
 	AuthInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
 	AuthSecurityToken                     = "SecurityToken"
+	AuthResourcePrincipal                 = "ResourcePrincipal"
+	AuthOKEWorkloadIdentity               = "OKEWorkloadIdentity"
+	AuthTest                              = "Test"
+	TestAuthTenancyOcid                   = "ocid1.tenancy.oc1..aaaaaaaafaketenancy" // Tenancy of the Test auth when tenancy_ocid is not set
 	RequestHeaderOpcOboToken              = "opc-obo-token"
 	RequestHeaderOpcHostSerial            = "opc-host-serial"
 	DefaultRequestTimeout                 = 0
@@ -22,6 +26,7 @@ const (
 	ClientHostOverridesEnv                = "CLIENT_HOST_OVERRIDES"
 	CustomCertLocationEnv                 = "custom_cert_location"
 	AcceptLocalCerts                      = "accept_local_certs"
+	EnableTestAuthEnv                     = "enable_test_auth"
 
 	AuthAttrName                 = "auth"
 	TenancyOcidAttrName          = "tenancy_ocid"
@@ -37,6 +42,17 @@ const (
 	OboTokenAttrName             = "obo_token"
 	OboTokenPath                 = "obo_token_path"
 	ConfigFileProfileAttrName    = "config_file_profile"
+	DefaultFreeformTagsAttrName  = "default_freeform_tags"
+	DefaultDefinedTagsAttrName   = "default_defined_tags"
+	IgnoreDefinedTagsAttrName    = "ignore_defined_tags"
+
+	RequestsPerSecondAttrName               = "requests_per_second"
+	MaxConcurrentRequestsPerServiceAttrName = "max_concurrent_requests_per_service"
+	RetryAttrName                           = "retry"
+	ReadOnlyAttrName                        = "read_only"
+	DeletionProtectionAttrName              = "deletion_protection"
+	CircuitBreakerThresholdAttrName         = "circuit_breaker_threshold"
+	CircuitBreakerCooldownSecondsAttrName   = "circuit_breaker_cooldown_seconds"
 
 	DefaultConfigFileName    = "config"
 	DefaultConfigDirName     = ".oci"
@@ -59,7 +75,10 @@ These missing attributes are also added to the lifecycle ignore_changes.
 `
 	PlaceholderValueForMissingAttribute = `<placeholder for missing required attribute>`
 	EnvLogFile                          = "TF_LOG_PATH"
-	EnvOCITFLogFile                     = "OCI_TF_LOG_PATH" // Log path for Custom TF logger - TFProviderLogger
+	EnvOCITFLogFile                     = "OCI_TF_LOG_PATH"   // Log path for Custom TF logger - TFProviderLogger
+	EnvOCITFLogFormat                   = "OCI_TF_LOG_FORMAT" // Output format of TFProviderLogger, "text" or "json"
+	EnvOCITFTraceFile                   = "OCI_TF_TRACE_PATH" // Path of the trace file of the OCI calls and the state polling loops
+	EnvOCITFErrorFile                   = "OCI_TF_ERROR_PATH" // Path of the JSON lines file of the errors returned by the resources
 	TerraformBinPathName                = "terraform_bin_path"
 )
 
@@ -0,0 +1,278 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/oracle/oci-go-sdk/v55/common"
+	oci_core "github.com/oracle/oci-go-sdk/v55/core"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
+)
+
+// The stale sweepers of the core networking resources, see acctest.SweepStaleResources
+func init() {
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreVcn", DependencyKey: "vcn", List: listStaleVcns, Delete: deleteStaleVcn})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreSubnet", DependencyKey: "subnet", List: listStaleSubnets, Delete: deleteStaleSubnet})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreRouteTable", DependencyKey: "routeTable", List: listStaleRouteTables, Delete: deleteStaleRouteTable})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreSecurityList", DependencyKey: "securityList", List: listStaleSecurityLists, Delete: deleteStaleSecurityList})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreDhcpOptions", DependencyKey: "dhcpOptions", List: listStaleDhcpOptions, Delete: deleteStaleDhcpOptions})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreInternetGateway", DependencyKey: "internetGateway", List: listStaleInternetGateways, Delete: deleteStaleInternetGateway})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreNatGateway", DependencyKey: "natGateway", List: listStaleNatGateways, Delete: deleteStaleNatGateway})
+	acctest.AddStaleResourceSweeper(&acctest.StaleResourceSweeper{Name: "CoreNetworkSecurityGroup", DependencyKey: "networkSecurityGroup", List: listStaleNetworkSecurityGroups, Delete: deleteStaleNetworkSecurityGroup})
+}
+
+func newStaleResource(id *string, displayName *string, freeformTags map[string]string, timeCreated *common.SDKTime) acctest.StaleResource {
+	staleResource := acctest.StaleResource{Id: *id, FreeformTags: freeformTags}
+	if displayName != nil {
+		staleResource.DisplayName = *displayName
+	}
+	if timeCreated != nil {
+		staleResource.TimeCreated = timeCreated.Time
+	}
+	return staleResource
+}
+
+func staleSweepRequestMetadata() common.RequestMetadata {
+	return common.RequestMetadata{RetryPolicy: tfresource.GetRetryPolicy(true, "core")}
+}
+
+func listStaleVcns(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListVcnsRequest{CompartmentId: &compartment, LifecycleState: oci_core.VcnLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListVcns(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, vcn := range response.Items {
+			staleResources = append(staleResources, newStaleResource(vcn.Id, vcn.DisplayName, vcn.FreeformTags, vcn.TimeCreated))
+			acctest.SweeperDefaultResourceId[*vcn.DefaultDhcpOptionsId] = true
+			acctest.SweeperDefaultResourceId[*vcn.DefaultRouteTableId] = true
+			acctest.SweeperDefaultResourceId[*vcn.DefaultSecurityListId] = true
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleVcn(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteVcn(context.Background(), oci_core.DeleteVcnRequest{VcnId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, vcnSweepWaitCondition, time.Duration(3*time.Minute),
+		vcnSweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleSubnets(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListSubnetsRequest{CompartmentId: &compartment, LifecycleState: oci_core.SubnetLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListSubnets(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, subnet := range response.Items {
+			staleResources = append(staleResources, newStaleResource(subnet.Id, subnet.DisplayName, subnet.FreeformTags, subnet.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleSubnet(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteSubnet(context.Background(), oci_core.DeleteSubnetRequest{SubnetId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, subnetSweepWaitCondition, time.Duration(3*time.Minute),
+		subnetSweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleRouteTables(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListRouteTablesRequest{CompartmentId: &compartment, LifecycleState: oci_core.RouteTableLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListRouteTables(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, routeTable := range response.Items {
+			staleResources = append(staleResources, newStaleResource(routeTable.Id, routeTable.DisplayName, routeTable.FreeformTags, routeTable.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleRouteTable(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteRouteTable(context.Background(), oci_core.DeleteRouteTableRequest{RtId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, routeTableSweepWaitCondition, time.Duration(3*time.Minute),
+		routeTableSweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleSecurityLists(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListSecurityListsRequest{CompartmentId: &compartment, LifecycleState: oci_core.SecurityListLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListSecurityLists(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, securityList := range response.Items {
+			staleResources = append(staleResources, newStaleResource(securityList.Id, securityList.DisplayName, securityList.FreeformTags, securityList.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleSecurityList(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteSecurityList(context.Background(), oci_core.DeleteSecurityListRequest{SecurityListId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, securityListSweepWaitCondition, time.Duration(3*time.Minute),
+		securityListSweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleDhcpOptions(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListDhcpOptionsRequest{CompartmentId: &compartment, LifecycleState: oci_core.DhcpOptionsLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListDhcpOptions(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, dhcpOptions := range response.Items {
+			staleResources = append(staleResources, newStaleResource(dhcpOptions.Id, dhcpOptions.DisplayName, dhcpOptions.FreeformTags, dhcpOptions.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleDhcpOptions(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteDhcpOptions(context.Background(), oci_core.DeleteDhcpOptionsRequest{DhcpId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, dhcpOptionsSweepWaitCondition, time.Duration(3*time.Minute),
+		dhcpOptionsSweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleInternetGateways(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListInternetGatewaysRequest{CompartmentId: &compartment, LifecycleState: oci_core.InternetGatewayLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListInternetGateways(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, internetGateway := range response.Items {
+			staleResources = append(staleResources, newStaleResource(internetGateway.Id, internetGateway.DisplayName, internetGateway.FreeformTags, internetGateway.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleInternetGateway(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteInternetGateway(context.Background(), oci_core.DeleteInternetGatewayRequest{IgId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, internetGatewaySweepWaitCondition, time.Duration(3*time.Minute),
+		internetGatewaySweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleNatGateways(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListNatGatewaysRequest{CompartmentId: &compartment, LifecycleState: oci_core.NatGatewayLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListNatGateways(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, natGateway := range response.Items {
+			staleResources = append(staleResources, newStaleResource(natGateway.Id, natGateway.DisplayName, natGateway.FreeformTags, natGateway.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleNatGateway(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteNatGateway(context.Background(), oci_core.DeleteNatGatewayRequest{NatGatewayId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, natGatewaySweepWaitCondition, time.Duration(3*time.Minute),
+		natGatewaySweepResponseFetchOperation, "core", true)()
+	return nil
+}
+
+func listStaleNetworkSecurityGroups(compartment string) ([]acctest.StaleResource, error) {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	var staleResources []acctest.StaleResource
+	request := oci_core.ListNetworkSecurityGroupsRequest{CompartmentId: &compartment, LifecycleState: oci_core.NetworkSecurityGroupLifecycleStateAvailable}
+	for {
+		response, err := virtualNetworkClient.ListNetworkSecurityGroups(context.Background(), request)
+		if err != nil {
+			return staleResources, err
+		}
+		for _, networkSecurityGroup := range response.Items {
+			staleResources = append(staleResources, newStaleResource(networkSecurityGroup.Id, networkSecurityGroup.DisplayName, networkSecurityGroup.FreeformTags, networkSecurityGroup.TimeCreated))
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return staleResources, nil
+		}
+	}
+}
+
+func deleteStaleNetworkSecurityGroup(id string) error {
+	virtualNetworkClient := acctest.GetTestClients(&schema.ResourceData{}).VirtualNetworkClient()
+	_, err := virtualNetworkClient.DeleteNetworkSecurityGroup(context.Background(), oci_core.DeleteNetworkSecurityGroupRequest{NetworkSecurityGroupId: &id, RequestMetadata: staleSweepRequestMetadata()})
+	if err != nil {
+		return err
+	}
+	acctest.WaitTillCondition(acctest.TestAccProvider, &id, networkSecurityGroupSweepWaitCondition, time.Duration(3*time.Minute),
+		networkSecurityGroupSweepResponseFetchOperation, "core", true)()
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestsPerSecond and MaxConcurrentRequestsPerService throttle the requests sent by the SDK clients of each service,
+// a zero value disables the corresponding limit. They are set from the provider configuration.
+var (
+	RequestsPerSecond               float64
+	MaxConcurrentRequestsPerService int
+)
+
+// inFlightResponseTimeout releases the concurrency slot of a response whose body is neither read to the end nor closed,
+// e.g. streamed object storage content, so that it can not block the other requests to the service
+var inFlightResponseTimeout = 5 * time.Minute
+
+// clientThrottler keeps one serviceThrottle per service, so that all the SDK clients calling the same service share its limits
+type clientThrottler struct {
+	interval    time.Duration
+	maxInFlight int
+
+	mutex     sync.Mutex
+	throttles map[string]*serviceThrottle
+}
+
+func newClientThrottler(requestsPerSecond float64, maxInFlight int) *clientThrottler {
+	if requestsPerSecond <= 0 && maxInFlight <= 0 {
+		return nil
+	}
+
+	throttler := &clientThrottler{
+		maxInFlight: maxInFlight,
+		throttles:   map[string]*serviceThrottle{},
+	}
+	if requestsPerSecond > 0 {
+		throttler.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	log.Printf("[DEBUG] Throttling SDK clients to %v requests per second and %d concurrent requests per service", requestsPerSecond, maxInFlight)
+	return throttler
+}
+
+func (t *clientThrottler) getServiceThrottle(service string) *serviceThrottle {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if throttle, ok := t.throttles[service]; ok {
+		return throttle
+	}
+	throttle := &serviceThrottle{service: service, interval: t.interval}
+	if t.maxInFlight > 0 {
+		throttle.inFlight = make(chan struct{}, t.maxInFlight)
+	}
+	t.throttles[service] = throttle
+	return throttle
+}
+
+// getServiceName returns the service part of a client host, e.g. "identity" for https://identity.us-phoenix-1.oraclecloud.com
+func getServiceName(host string) string {
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		host = u.Hostname()
+	}
+	return strings.SplitN(host, ".", 2)[0]
+}
+
+type serviceThrottle struct {
+	service  string
+	interval time.Duration
+	inFlight chan struct{}
+
+	mutex       sync.Mutex
+	nextRequest time.Time
+}
+
+// acquire waits until the request can be sent without exceeding the limits of the service, or the request is cancelled
+func (s *serviceThrottle) acquire(request *http.Request) error {
+	ctx := request.Context()
+	if s.inFlight != nil {
+		select {
+		case s.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if s.interval > 0 {
+		s.mutex.Lock()
+		now := time.Now()
+		slot := s.nextRequest
+		if slot.Before(now) {
+			slot = now
+		}
+		s.nextRequest = slot.Add(s.interval)
+		s.mutex.Unlock()
+
+		if wait := slot.Sub(now); wait > 0 {
+			log.Printf("[TRACE] Throttling %s %s for %v", request.Method, request.URL.Path, wait)
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				s.release()
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+func (s *serviceThrottle) release() {
+	if s.inFlight != nil {
+		<-s.inFlight
+	}
+}
+
+// throttlingTransport applies the limits of a service to the requests of an SDK client
+type throttlingTransport struct {
+	transport http.RoundTripper
+	throttle  *serviceThrottle
+}
+
+func (t *throttlingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := t.throttle.acquire(request); err != nil {
+		return nil, err
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil || response.Body == nil {
+		t.throttle.release()
+		return response, err
+	}
+
+	// The request is in flight until its response has been read or closed
+	response.Body = newReleaseOnDoneBody(response.Body, request, t.throttle.release)
+	return response, nil
+}
+
+// releaseOnDoneBody releases the concurrency slot of a request once, when its body is read to the end, closed or after
+// inFlightResponseTimeout
+type releaseOnDoneBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+	timer   *time.Timer
+}
+
+func newReleaseOnDoneBody(body io.ReadCloser, request *http.Request, release func()) *releaseOnDoneBody {
+	b := &releaseOnDoneBody{ReadCloser: body, release: release}
+	timeout := inFlightResponseTimeout
+	b.timer = time.AfterFunc(timeout, func() {
+		log.Printf("[DEBUG] Releasing the throttling slot of %s %s, its response was not read within %v", request.Method, request.URL.Path, timeout)
+		b.once.Do(b.release)
+	})
+	return b
+}
+
+func (b *releaseOnDoneBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *releaseOnDoneBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+	return err
+}
+
+func (b *releaseOnDoneBody) done() {
+	b.timer.Stop()
+	b.once.Do(b.release)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestThrottlingTransport(requestsPerSecond float64, maxInFlight int) *throttlingTransport {
+	throttler := newClientThrottler(requestsPerSecond, maxInFlight)
+	return &throttlingTransport{transport: &http.Transport{}, throttle: throttler.getServiceThrottle("test")}
+}
+
+// sendTestRequest sends a request through the transport, it fails if the request is still throttled after the timeout
+func sendTestRequest(t *testing.T, transport *throttlingTransport, url string, timeout time.Duration) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	return transport.RoundTrip(request)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetServiceName(t *testing.T) {
+	assert.Equal(t, "identity", getServiceName("https://identity.us-phoenix-1.oraclecloud.com"))
+	assert.Equal(t, "objectstorage", getServiceName("objectstorage.us-ashburn-1.oraclecloud.com"))
+	assert.Nil(t, newClientThrottler(0, 0))
+
+	throttler := newClientThrottler(0, 2)
+	assert.Equal(t, throttler.getServiceThrottle("core"), throttler.getServiceThrottle("core"))
+	assert.NotEqual(t, throttler.getServiceThrottle("core"), throttler.getServiceThrottle("identity"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitThrottlingTransport_maxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		_, _ = w.Write([]byte("done"))
+	}))
+	defer server.Close()
+	transport := newTestThrottlingTransport(0, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := sendTestRequest(t, transport, server.URL, 10*time.Second)
+			if assert.NoError(t, err) {
+				_, _ = ioutil.ReadAll(response.Body)
+				response.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+	assert.Equal(t, 0, len(transport.throttle.inFlight))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitThrottlingTransport_releaseSlot(t *testing.T) {
+	defer func(timeout time.Duration) { inFlightResponseTimeout = timeout }(inFlightResponseTimeout)
+	inFlightResponseTimeout = time.Hour
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+	transport := newTestThrottlingTransport(0, 1)
+
+	// The slot is held while the response has not been read
+	response, err := sendTestRequest(t, transport, server.URL, time.Second)
+	assert.NoError(t, err)
+	_, err = sendTestRequest(t, transport, server.URL, 50*time.Millisecond)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// Reading the response to the end releases the slot without closing it
+	content, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+	response, err = sendTestRequest(t, transport, server.URL, time.Second)
+	assert.NoError(t, err)
+
+	// Closing the response releases the slot, only once
+	assert.NoError(t, response.Body.Close())
+	assert.NoError(t, response.Body.Close())
+	assert.Equal(t, 0, len(transport.throttle.inFlight))
+
+	// A response that is never read nor closed releases the slot after the timeout
+	inFlightResponseTimeout = 50 * time.Millisecond
+	_, err = sendTestRequest(t, transport, server.URL, time.Second)
+	assert.NoError(t, err)
+	_, err = sendTestRequest(t, transport, server.URL, time.Second)
+	assert.NoError(t, err)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitThrottlingTransport_requestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	transport := newTestThrottlingTransport(20, 0)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		response, err := sendTestRequest(t, transport, server.URL, time.Second)
+		if assert.NoError(t, err) {
+			response.Body.Close()
+		}
+	}
+	// The first request is sent immediately, the next ones are spaced by 50ms
+	assert.True(t, time.Since(start) >= 150*time.Millisecond)
+
+	// A request cancelled while it waits for its turn is not sent
+	transport = newTestThrottlingTransport(0.5, 1)
+	response, err := sendTestRequest(t, transport, server.URL, time.Second)
+	assert.NoError(t, err)
+	response.Body.Close()
+	_, err = sendTestRequest(t, transport, server.URL, 50*time.Millisecond)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 0, len(transport.throttle.inFlight))
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	oci_common_auth "github.com/oracle/oci-go-sdk/v55/common/auth"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	okeProxymuxPort                 = 12250
+	okeProxymuxPath                 = "/resourcePrincipalSessionTokens"
+	kubernetesServiceHostEnv        = "KUBERNETES_SERVICE_HOST"
+	okeServiceAccountTokenPathEnv   = "OCI_KUBERNETES_SERVICE_ACCOUNT_TOKEN_PATH"
+	okeServiceAccountCertPathEnv    = "OCI_KUBERNETES_SERVICE_ACCOUNT_CERT_PATH"
+	defaultServiceAccountTokenPath  = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultServiceAccountCertPath   = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	okeSessionTokenRefreshWindow    = 5 * time.Minute
+	okeWorkloadIdentityTenancyClaim = "res_tenant"
+	jwtExpiryClaim                  = "exp"
+	securityTokenKeyIdPrefix        = "ST$"
+	opcRequestIdHeader              = "opc-request-id"
+)
+
+// okeWorkloadIdentityConfigProvider authenticates as the Kubernetes service account of the pod the provider runs in.
+// The service account token is exchanged with the OKE proxymux endpoint for a resource principal session token (RPST),
+// which is signed for with a session key that is generated when the provider starts.
+type okeWorkloadIdentityConfigProvider struct {
+	region           string
+	proxymuxEndpoint string
+	saTokenPath      string
+	httpClient       *http.Client
+	privateKey       *rsa.PrivateKey
+
+	mutex        sync.Mutex
+	sessionToken string
+	claims       map[string]interface{}
+	expiresAt    time.Time
+}
+
+func newOkeWorkloadIdentityConfigProvider(region string) (*okeWorkloadIdentityConfigProvider, error) {
+	kubernetesServiceHost := utils.GetEnvSettingWithBlankDefault(kubernetesServiceHostEnv)
+	if kubernetesServiceHost == "" {
+		return nil, fmt.Errorf("can not create %s configuration, environment variable %s is not set; the provider must run in an OKE pod", globalvar.AuthOKEWorkloadIdentity, kubernetesServiceHostEnv)
+	}
+
+	caCertPath := utils.GetEnvSettingWithDefault(okeServiceAccountCertPathEnv, defaultServiceAccountCertPath)
+	caCert, err := ioutil.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not read the Kubernetes service account CA certificate from %s: %v", caCertPath, err)
+	}
+	pool := x509.NewCertPool()
+	if ok := pool.AppendCertsFromPEM(caCert); !ok {
+		return nil, fmt.Errorf("failed to append the Kubernetes service account CA certificate to the pool")
+	}
+	httpClient := BuildHttpClient()
+	httpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs = pool
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("can not generate the session key for %s: %v", globalvar.AuthOKEWorkloadIdentity, err)
+	}
+
+	return &okeWorkloadIdentityConfigProvider{
+		region:           region,
+		proxymuxEndpoint: fmt.Sprintf("https://%s:%d%s", kubernetesServiceHost, okeProxymuxPort, okeProxymuxPath),
+		saTokenPath:      utils.GetEnvSettingWithDefault(okeServiceAccountTokenPathEnv, defaultServiceAccountTokenPath),
+		httpClient:       httpClient,
+		privateKey:       privateKey,
+	}, nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) String() string {
+	return fmt.Sprintf("%s configuration provider for endpoint %s", globalvar.AuthOKEWorkloadIdentity, p.proxymuxEndpoint)
+}
+
+// getSessionToken returns the cached session token, and requests a new one from the proxymux endpoint if it is about to expire
+func (p *okeWorkloadIdentityConfigProvider) getSessionToken() (string, map[string]interface{}, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.sessionToken != "" && time.Now().Add(okeSessionTokenRefreshWindow).Before(p.expiresAt) {
+		return p.sessionToken, p.claims, nil
+	}
+
+	sessionToken, err := p.requestSessionToken()
+	if err != nil {
+		return "", nil, err
+	}
+	claims, err := parseJwtClaims(sessionToken)
+	if err != nil {
+		return "", nil, err
+	}
+
+	p.sessionToken = sessionToken
+	p.claims = claims
+	p.expiresAt = time.Now()
+	if exp, ok := claims[jwtExpiryClaim].(float64); ok {
+		p.expiresAt = time.Unix(int64(exp), 0)
+	}
+	log.Printf("[DEBUG] Obtained %s session token valid until %v", globalvar.AuthOKEWorkloadIdentity, p.expiresAt)
+	return p.sessionToken, p.claims, nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) requestSessionToken() (string, error) {
+	saToken, err := utils.GetTokenFromFile(p.saTokenPath)
+	if err != nil {
+		return "", fmt.Errorf("can not read the Kubernetes service account token from %s: %v", p.saTokenPath, err)
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&p.privateKey.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(map[string]string{"podKey": base64.StdEncoding.EncodeToString(publicKey)})
+	if err != nil {
+		return "", err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, p.proxymuxEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(saToken))
+	request.Header.Set(opcRequestIdHeader, utils.RandomString(32, utils.Charset))
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to get a resource principal session token from %s: %v", p.proxymuxEndpoint, err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get a resource principal session token from %s, status code: %d, response: %s", p.proxymuxEndpoint, response.StatusCode, string(responseBody))
+	}
+
+	// The proxymux returns the base64 encoded JSON of the token
+	decodedBody, err := base64.StdEncoding.DecodeString(string(responseBody))
+	if err != nil {
+		return "", fmt.Errorf("can not decode the resource principal session token response: %v", err)
+	}
+	tokenResponse := struct {
+		Token string `json:"token"`
+	}{}
+	if err := json.Unmarshal(decodedBody, &tokenResponse); err != nil {
+		return "", fmt.Errorf("can not parse the resource principal session token response: %v", err)
+	}
+	if tokenResponse.Token == "" {
+		return "", fmt.Errorf("the resource principal session token response from %s did not contain a token", p.proxymuxEndpoint)
+	}
+	return strings.TrimPrefix(tokenResponse.Token, securityTokenKeyIdPrefix), nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) KeyID() (string, error) {
+	sessionToken, _, err := p.getSessionToken()
+	if err != nil {
+		return "", err
+	}
+	return securityTokenKeyIdPrefix + sessionToken, nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) TenancyOCID() (string, error) {
+	_, claims, err := p.getSessionToken()
+	if err != nil {
+		return "", err
+	}
+	if tenancy, ok := claims[okeWorkloadIdentityTenancyClaim].(string); ok && tenancy != "" {
+		return tenancy, nil
+	}
+	return "", fmt.Errorf("the resource principal session token does not contain the %s claim", okeWorkloadIdentityTenancyClaim)
+}
+
+func (p *okeWorkloadIdentityConfigProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+func (p *okeWorkloadIdentityConfigProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{
+			AuthType:         oci_common.UnknownAuthenticationType,
+			IsFromConfigFile: false,
+			OboToken:         nil,
+		},
+		fmt.Errorf("unsupported, keep the interface")
+}
+
+// getResourcePrincipalRegion returns the region from the provider block, and falls back to the region that OCI Functions,
+// Resource Manager and OKE set in the resource principal environment
+func getResourcePrincipalRegion(region interface{}, ok bool) string {
+	if ok && region.(string) != "" {
+		return region.(string)
+	}
+	return utils.GetEnvSettingWithBlankDefault(oci_common_auth.ResourcePrincipalRegionEnvVar)
+}
+
+func parseJwtClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("the session token is not a valid JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("can not decode the session token claims: %v", err)
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("can not parse the session token claims: %v", err)
+	}
+	return claims, nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// getTestSessionToken returns an unsigned JWT with the given claims, the provider only reads the claims
+func getTestSessionToken(claims map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return fmt.Sprintf("%s.%s.signature", header, base64.RawURLEncoding.EncodeToString(payload))
+}
+
+// newTestProxymux returns a proxymux server that responds with the tokens returned by nextToken, and counts the requests
+func newTestProxymux(t *testing.T, saToken string, nextToken func() (int, string)) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, okeProxymuxPath, r.URL.Path)
+		assert.Equal(t, "Bearer "+saToken, r.Header.Get("Authorization"))
+		assert.NotEmpty(t, r.Header.Get(opcRequestIdHeader))
+
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.NotEmpty(t, body["podKey"])
+
+		status, token := nextToken()
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(token))
+			return
+		}
+		response, _ := json.Marshal(map[string]string{"token": securityTokenKeyIdPrefix + token})
+		_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(response)))
+	}))
+	return server, &requests
+}
+
+func newTestOkeWorkloadIdentityConfigProvider(t *testing.T, server *httptest.Server, saToken string) *okeWorkloadIdentityConfigProvider {
+	saTokenPath := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, ioutil.WriteFile(saTokenPath, []byte(saToken+"\n"), 0600))
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	return &okeWorkloadIdentityConfigProvider{
+		region:           "us-ashburn-1",
+		proxymuxEndpoint: server.URL + okeProxymuxPath,
+		saTokenPath:      saTokenPath,
+		httpClient:       server.Client(),
+		privateKey:       privateKey,
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOkeWorkloadIdentityConfigProvider_sessionToken(t *testing.T) {
+	token := getTestSessionToken(map[string]interface{}{
+		okeWorkloadIdentityTenancyClaim: "ocid1.tenancy.oc1..test",
+		jwtExpiryClaim:                  time.Now().Add(time.Hour).Unix(),
+	})
+	server, requests := newTestProxymux(t, "sa-token", func() (int, string) { return http.StatusOK, token })
+	defer server.Close()
+	p := newTestOkeWorkloadIdentityConfigProvider(t, server, "sa-token")
+
+	keyId, err := p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+token, keyId)
+
+	tenancy, err := p.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ocid1.tenancy.oc1..test", tenancy)
+
+	region, err := p.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", region)
+
+	// The token is cached until it is about to expire
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOkeWorkloadIdentityConfigProvider_refresh(t *testing.T) {
+	// The first token expires within the refresh window, so it is refreshed on the next request
+	tokens := []string{
+		getTestSessionToken(map[string]interface{}{jwtExpiryClaim: time.Now().Add(okeSessionTokenRefreshWindow / 2).Unix()}),
+		getTestSessionToken(map[string]interface{}{jwtExpiryClaim: time.Now().Add(time.Hour).Unix()}),
+	}
+	var next int32
+	server, requests := newTestProxymux(t, "sa-token", func() (int, string) {
+		return http.StatusOK, tokens[atomic.AddInt32(&next, 1)-1]
+	})
+	defer server.Close()
+	p := newTestOkeWorkloadIdentityConfigProvider(t, server, "sa-token")
+
+	keyId, err := p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+tokens[0], keyId)
+
+	keyId, err = p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+tokens[1], keyId)
+
+	keyId, err = p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+tokens[1], keyId)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+
+	// A token without the tenancy claim can not be used to resolve the tenancy
+	_, err = p.TenancyOCID()
+	assert.Error(t, err)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOkeWorkloadIdentityConfigProvider_errors(t *testing.T) {
+	type testCase struct {
+		name          string
+		status        int
+		body          string
+		expectedError string
+	}
+	for _, test := range []testCase{
+		{"unauthorized", http.StatusUnauthorized, "service account token rejected", "status code: 401, response: service account token rejected"},
+		{"server error", http.StatusInternalServerError, "internal error", "status code: 500"},
+		{"invalid token", http.StatusOK, "not-a-jwt", "not a valid JWT"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newTestProxymux(t, "sa-token", func() (int, string) { return test.status, test.body })
+			defer server.Close()
+			p := newTestOkeWorkloadIdentityConfigProvider(t, server, "sa-token")
+
+			_, err := p.KeyID()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedError)
+		})
+	}
+
+	// The service account token is required to request a session token
+	server, requests := newTestProxymux(t, "sa-token", func() (int, string) { return http.StatusOK, "" })
+	defer server.Close()
+	p := newTestOkeWorkloadIdentityConfigProvider(t, server, "sa-token")
+	assert.NoError(t, os.Remove(p.saTokenPath))
+	_, err := p.KeyID()
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "service account token"))
+	assert.Equal(t, int32(0), atomic.LoadInt32(requests))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitNewOkeWorkloadIdentityConfigProvider_outsideOke(t *testing.T) {
+	defer os.Setenv(kubernetesServiceHostEnv, os.Getenv(kubernetesServiceHostEnv))
+	os.Unsetenv(kubernetesServiceHostEnv)
+
+	_, err := newOkeWorkloadIdentityConfigProvider("us-ashburn-1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), kubernetesServiceHostEnv)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitNewResourcePrincipalConfigProvider(t *testing.T) {
+	for _, env := range []string{"OCI_RESOURCE_PRINCIPAL_VERSION", "OCI_RESOURCE_PRINCIPAL_RPST", "OCI_RESOURCE_PRINCIPAL_PRIVATE_PEM", "OCI_RESOURCE_PRINCIPAL_REGION"} {
+		defer os.Setenv(env, os.Getenv(env))
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(t, ioutil.WriteFile(keyPath, []byte(getTestPrivateKeyPem(privateKey)), 0600))
+	tokenPath := filepath.Join(t.TempDir(), "rpst")
+	// The token expires within the refresh buffer of the SDK, so the token file is read again on each request
+	token := getTestSessionToken(map[string]interface{}{
+		okeWorkloadIdentityTenancyClaim: "ocid1.tenancy.oc1..test",
+		jwtExpiryClaim:                  time.Now().Add(time.Minute).Unix(),
+	})
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte(token), 0600))
+
+	os.Setenv("OCI_RESOURCE_PRINCIPAL_VERSION", "2.2")
+	os.Setenv("OCI_RESOURCE_PRINCIPAL_RPST", tokenPath)
+	os.Setenv("OCI_RESOURCE_PRINCIPAL_PRIVATE_PEM", keyPath)
+	os.Setenv("OCI_RESOURCE_PRINCIPAL_REGION", "us-phoenix-1")
+
+	// The region of the provider block is used without changing the environment
+	p, err := newResourcePrincipalConfigProvider("us-ashburn-1")
+	assert.NoError(t, err)
+	region, _ := p.Region()
+	assert.Equal(t, "us-ashburn-1", region)
+	assert.Equal(t, "us-phoenix-1", os.Getenv("OCI_RESOURCE_PRINCIPAL_REGION"))
+
+	keyId, err := p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+token, keyId)
+	tenancy, err := p.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ocid1.tenancy.oc1..test", tenancy)
+
+	// The renewed session token is read from the file
+	renewed := getTestSessionToken(map[string]interface{}{
+		okeWorkloadIdentityTenancyClaim: "ocid1.tenancy.oc1..test",
+		jwtExpiryClaim:                  time.Now().Add(time.Hour).Unix(),
+	})
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte(renewed), 0600))
+	keyId, err = p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+renewed, keyId)
+
+	// The SDK requires the region of the resource principal environment
+	os.Unsetenv("OCI_RESOURCE_PRINCIPAL_REGION")
+	_, err = newResourcePrincipalConfigProvider("us-ashburn-1")
+	assert.Error(t, err)
+}
+
+func getTestPrivateKeyPem(privateKey *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+}
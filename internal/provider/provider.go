@@ -12,6 +12,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -90,7 +91,7 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.AuthAPIKeySetting),
+		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s', '%s', '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.AuthResourcePrincipal, globalvar.AuthOKEWorkloadIdentity, globalvar.AuthAPIKeySetting),
 		globalvar.TenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.UserOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.FingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
@@ -104,7 +105,26 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		globalvar.RetryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
+		globalvar.RequestsPerSecondAttrName: "(Optional) The maximum number of requests per second sent to each OCI service. Requests exceeding the limit are delayed.\n" +
+			"By default, requests are not rate limited.",
+		globalvar.DeletionProtectionAttrName: "(Optional) A freeform tag key, or a defined tag name in the `namespace.key` format. Resources carrying this tag in their state are never deleted, and replacing them fails at apply with a message.\n" +
+			"Remove the tag in a separate apply to lift the protection. The tag can not be one of the `ignore_defined_tags`.",
+		globalvar.ReadOnlyAttrName: "(Optional) Reject the API requests that can create, update or delete resources, so that the provider can only be used to read resources, e.g. to run `terraform plan` for drift detection.\n" +
+			"By default, all requests are allowed.",
+		globalvar.RetryAttrName: "(Optional) Overrides the retry behavior of the operations of a service, e.g. `core`, `database`, `identity` or `object_storage`.\n" +
+			"The retry durations of the block take precedence over `retry_duration_seconds`, and are ignored if `disable_auto_retries` is set to true.",
+		globalvar.CircuitBreakerThresholdAttrName: "(Optional) The number of consecutive server errors returned by an OCI service after which its requests fail without being sent, until `circuit_breaker_cooldown_seconds` have passed.\n" +
+			"By default, the circuit breaker is disabled.",
+		globalvar.CircuitBreakerCooldownSecondsAttrName: "(Optional) The duration (in seconds) during which the requests to a service are not sent once its circuit breaker is open. Defaults to 60.",
+		globalvar.MaxConcurrentRequestsPerServiceAttrName: "(Optional) The maximum number of requests in flight to each OCI service. Requests exceeding the limit wait for a previous request to complete.\n" +
+			"By default, the number of concurrent requests is only limited by the Terraform parallelism.",
 		globalvar.ConfigFileProfileAttrName: "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
+		globalvar.DefaultFreeformTagsAttrName: "(Optional) Free-form tags that are merged into the `freeform_tags` of every resource that supports them.\n" +
+			"Tags set on the resource take precedence over these defaults.",
+		globalvar.DefaultDefinedTagsAttrName: "(Optional) Defined tags, in the `namespace.key` format, that are merged into the `defined_tags` of every resource that supports them.\n" +
+			"Tags set on the resource take precedence over these defaults.",
+		globalvar.IgnoreDefinedTagsAttrName: "(Optional) List of defined tag namespaces (e.g. `Oracle-Tags`) or `namespace.key` names (e.g. `Oracle-Tags.CreatedBy`) that are managed outside of Terraform.\n" +
+			"Matching tags are left out of the state and are ignored when computing diffs for all resources.",
 	}
 }
 
@@ -125,7 +145,7 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[globalvar.AuthAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.AuthAttrName), ociVarName(globalvar.AuthAttrName)}, globalvar.AuthAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken}, true),
+			ValidateFunc: validation.StringInSlice(getAuthTypes(), true),
 		},
 		globalvar.TenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -187,12 +207,114 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.RetryDurationSecondsAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.RetryDurationSecondsAttrName), ociVarName(globalvar.RetryDurationSecondsAttrName)}, nil),
 		},
+		globalvar.RequestsPerSecondAttrName: {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  descriptions[globalvar.RequestsPerSecondAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.RequestsPerSecondAttrName), ociVarName(globalvar.RequestsPerSecondAttrName)}, nil),
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		globalvar.MaxConcurrentRequestsPerServiceAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[globalvar.MaxConcurrentRequestsPerServiceAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.MaxConcurrentRequestsPerServiceAttrName), ociVarName(globalvar.MaxConcurrentRequestsPerServiceAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		globalvar.CircuitBreakerThresholdAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[globalvar.CircuitBreakerThresholdAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.CircuitBreakerThresholdAttrName), ociVarName(globalvar.CircuitBreakerThresholdAttrName)}, 0),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		globalvar.CircuitBreakerCooldownSecondsAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[globalvar.CircuitBreakerCooldownSecondsAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.CircuitBreakerCooldownSecondsAttrName), ociVarName(globalvar.CircuitBreakerCooldownSecondsAttrName)}, 60),
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		globalvar.DeletionProtectionAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.DeletionProtectionAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.DeletionProtectionAttrName), ociVarName(globalvar.DeletionProtectionAttrName)}, nil),
+		},
+		globalvar.ReadOnlyAttrName: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions[globalvar.ReadOnlyAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.ReadOnlyAttrName), ociVarName(globalvar.ReadOnlyAttrName)}, false),
+		},
+		globalvar.RetryAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[globalvar.RetryAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "The name of the service whose operations are retried, e.g. `core`, `database`, `identity` or `object_storage`.",
+						ValidateFunc: validation.StringInSlice(tf_resource.RetryServiceNames, false),
+					},
+					"max_attempts": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Description:  "The maximum number of attempts of an operation, including the first one.",
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"max_duration_seconds": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Description:  "The duration (in seconds) to retry an operation in response to a retriable error.",
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"retriable_status_codes": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "The HTTP status codes that are retried. By default, the status codes retried depend on the service.",
+						Elem: &schema.Schema{
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntBetween(400, 599),
+						},
+					},
+					"retry_not_found": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether to retry operations that return a 404. By default, it depends on the service and the operation.",
+					},
+				},
+			},
+		},
 		globalvar.ConfigFileProfileAttrName: {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Description: descriptions[globalvar.ConfigFileProfileAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.ConfigFileProfileAttrName), ociVarName(globalvar.ConfigFileProfileAttrName)}, nil),
 		},
+		globalvar.DefaultFreeformTagsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: descriptions[globalvar.DefaultFreeformTagsAttrName],
+			Elem:        schema.TypeString,
+		},
+		globalvar.DefaultDefinedTagsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: descriptions[globalvar.DefaultDefinedTagsAttrName],
+			Elem:        schema.TypeString,
+		},
+		globalvar.IgnoreDefinedTagsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[globalvar.IgnoreDefinedTagsAttrName],
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^.]+(\.[^.]+)?$`), "must be a defined tag namespace or a namespace.key name"),
+			},
+		},
 	}
 }
 
@@ -200,13 +322,86 @@ func RegisterResource(name string, resourceSchema *schema.Resource) {
 	if OciResources == nil {
 		OciResources = make(map[string]*schema.Resource)
 	}
+	applyDefaultTags(resourceSchema)
+	applyDeletionProtection(resourceSchema)
+	trackResourceOperations(name, resourceSchema)
 	OciResources[name] = resourceSchema
 }
 
+// applyDeletionProtection wraps the Delete function of a taggable resource so that a protected resource is never deleted,
+// including when it is replaced, whether or not its Delete function goes through tfresource.DeleteResource
+func applyDeletionProtection(resourceSchema *schema.Resource) {
+	if resourceSchema.Delete == nil || (!isUserTaggable(resourceSchema, "freeform_tags") && !isUserTaggable(resourceSchema, "defined_tags")) {
+		return
+	}
+
+	deleteFn := resourceSchema.Delete
+	resourceSchema.Delete = func(d *schema.ResourceData, m interface{}) error {
+		if err := tf_resource.CheckDeletionProtection(d); err != nil {
+			return err
+		}
+		return deleteFn(d, m)
+	}
+}
+
+// applyDefaultTags wraps the Create and Update functions of a taggable resource so that the provider level default tags are
+// merged into the tags sent to the service, and suppresses the diffs caused by those tags being present in the state.
+func applyDefaultTags(resourceSchema *schema.Resource) {
+	hasFreeformTags := isUserTaggable(resourceSchema, "freeform_tags")
+	hasDefinedTags := isUserTaggable(resourceSchema, "defined_tags")
+	if !hasFreeformTags && !hasDefinedTags {
+		return
+	}
+
+	if hasFreeformTags {
+		addDiffSuppressFunc(resourceSchema.Schema["freeform_tags"], tf_resource.FreeformTagsDiffSuppressFunction)
+	}
+	if hasDefinedTags {
+		addDiffSuppressFunc(resourceSchema.Schema["defined_tags"], tf_resource.DefinedTagsDiffSuppressFunction)
+	}
+
+	if create := resourceSchema.Create; create != nil {
+		resourceSchema.Create = func(d *schema.ResourceData, m interface{}) error {
+			if err := tf_resource.MergeDefaultTags(d, hasFreeformTags, hasDefinedTags); err != nil {
+				return err
+			}
+			return create(d, m)
+		}
+	}
+	if update := resourceSchema.Update; update != nil {
+		resourceSchema.Update = func(d *schema.ResourceData, m interface{}) error {
+			if err := tf_resource.MergeDefaultTags(d, hasFreeformTags, hasDefinedTags); err != nil {
+				return err
+			}
+			return update(d, m)
+		}
+	}
+}
+
+func isUserTaggable(resourceSchema *schema.Resource, tagsAttrName string) bool {
+	tagsSchema, ok := resourceSchema.Schema[tagsAttrName]
+	return ok && tagsSchema.Type == schema.TypeMap && tagsSchema.Optional
+}
+
+func addDiffSuppressFunc(attrSchema *schema.Schema, diffSuppressFunc schema.SchemaDiffSuppressFunc) {
+	existing := attrSchema.DiffSuppressFunc
+	if existing == nil {
+		attrSchema.DiffSuppressFunc = diffSuppressFunc
+		return
+	}
+	if reflect.ValueOf(existing).Pointer() == reflect.ValueOf(diffSuppressFunc).Pointer() {
+		return
+	}
+	attrSchema.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		return existing(k, old, new, d) || diffSuppressFunc(k, old, new, d)
+	}
+}
+
 func RegisterDatasource(name string, datasourceSchema *schema.Resource) {
 	if OciDatasources == nil {
 		OciDatasources = make(map[string]*schema.Resource)
 	}
+	trackResourceOperations(name, datasourceSchema)
 	OciDatasources[name] = datasourceSchema
 }
 
@@ -251,6 +446,46 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 		tf_resource.ConfiguredRetryDuration = &val
 	}
 
+	defaultDefinedTags := d.Get(globalvar.DefaultDefinedTagsAttrName).(map[string]interface{})
+	if _, err := tf_resource.MapToDefinedTags(defaultDefinedTags); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", globalvar.DefaultDefinedTagsAttrName, err)
+	}
+	tf_resource.DefaultDefinedTags = defaultDefinedTags
+	tf_resource.DefaultFreeformTags = d.Get(globalvar.DefaultFreeformTagsAttrName).(map[string]interface{})
+
+	tf_resource.IgnoredDefinedTags = nil
+	for _, ignoredTag := range d.Get(globalvar.IgnoreDefinedTagsAttrName).([]interface{}) {
+		if ignoredTag != nil {
+			tf_resource.IgnoredDefinedTags = append(tf_resource.IgnoredDefinedTags, ignoredTag.(string))
+		}
+	}
+
+	tf_resource.ServiceRetryConfigs = nil
+	if !d.Get(globalvar.DisableAutoRetriesAttrName).(bool) {
+		serviceRetryConfigs, err := getServiceRetryConfigs(d)
+		if err != nil {
+			return nil, err
+		}
+		tf_resource.ServiceRetryConfigs = serviceRetryConfigs
+	}
+
+	tf_resource.CircuitBreakerThreshold = d.Get(globalvar.CircuitBreakerThresholdAttrName).(int)
+	tf_resource.CircuitBreakerCooldown = time.Duration(d.Get(globalvar.CircuitBreakerCooldownSecondsAttrName).(int)) * time.Second
+
+	tf_resource.DeletionProtectionTagKey = d.Get(globalvar.DeletionProtectionAttrName).(string)
+	if strings.Contains(tf_resource.DeletionProtectionTagKey, ".") && tf_resource.IsIgnoredDefinedTag(tf_resource.DeletionProtectionTagKey) {
+		// The ignored defined tags are left out of the state, the protection would silently be lifted
+		return nil, fmt.Errorf("the %s tag %s can not be one of the %s", globalvar.DeletionProtectionAttrName, tf_resource.DeletionProtectionTagKey, globalvar.IgnoreDefinedTagsAttrName)
+	}
+	ReadOnly = d.Get(globalvar.ReadOnlyAttrName).(bool)
+	RequestsPerSecond = d.Get(globalvar.RequestsPerSecondAttrName).(float64)
+	MaxConcurrentRequestsPerService = d.Get(globalvar.MaxConcurrentRequestsPerServiceAttrName).(int)
+
+	if ociProvider != nil {
+		// Interrupting an apply cancels the requests and the polling of the resources being created, updated or deleted
+		tf_resource.SetStopContext(ociProvider.StopContext())
+	}
+
 	sdkConfigProvider, err := GetSdkConfigProvider(d, clients)
 	if err != nil {
 		return nil, err
@@ -274,6 +509,38 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	return clients, nil
 }
 
+func getServiceRetryConfigs(d *schema.ResourceData) (map[string]*tf_resource.ServiceRetryConfig, error) {
+	serviceRetryConfigs := map[string]*tf_resource.ServiceRetryConfig{}
+	for index, item := range d.Get(globalvar.RetryAttrName).([]interface{}) {
+		retryBlock, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service := retryBlock["service"].(string)
+		if _, exists := serviceRetryConfigs[service]; exists {
+			return nil, fmt.Errorf("%s block for service %s is specified more than once", globalvar.RetryAttrName, service)
+		}
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", globalvar.RetryAttrName, index)
+
+		config := &tf_resource.ServiceRetryConfig{
+			MaxAttempts: retryBlock["max_attempts"].(int),
+		}
+		if maxDurationSeconds, ok := d.GetOkExists(fmt.Sprintf(fieldKeyFormat, "max_duration_seconds")); ok {
+			maxDuration := time.Duration(maxDurationSeconds.(int)) * time.Second
+			config.MaxDuration = &maxDuration
+		}
+		for _, statusCode := range retryBlock["retriable_status_codes"].([]interface{}) {
+			config.RetriableStatusCodes = append(config.RetriableStatusCodes, statusCode.(int))
+		}
+		if retryNotFound, ok := d.GetOkExists(fmt.Sprintf(fieldKeyFormat, "retry_not_found")); ok {
+			tmp := retryNotFound.(bool)
+			config.RetryNotFound = &tmp
+		}
+		serviceRetryConfigs[service] = config
+	}
+	return serviceRetryConfigs, nil
+}
+
 func GetSdkConfigProvider(d *schema.ResourceData, clients *tf_client.OracleClients) (oci_common.ConfigurationProvider, error) {
 
 	auth := strings.ToLower(d.Get(globalvar.AuthAttrName).(string))
@@ -309,6 +576,20 @@ func GetSdkConfigProvider(d *schema.ResourceData, clients *tf_client.OracleClien
 		return nil, err
 	}
 
+	// Keep track of the security token provider so that BuildConfigureClientFn can retry requests with a refreshed token
+	for _, configProvider := range configProviders {
+		if securityTokenProvider, ok := configProvider.(*securityTokenConfigProvider); ok {
+			sdkConfigProvider = securityTokenComposingConfigProvider{sdkConfigProvider, securityTokenProvider}
+		}
+	}
+
+	// Resource principals may not have the region in the Terraform configuration, it is then provided by the environment
+	if _, ok := clients.Configuration["region"]; !ok {
+		if region, err := sdkConfigProvider.Region(); err == nil && region != "" {
+			clients.Configuration["region"] = region
+		}
+	}
+
 	return sdkConfigProvider, nil
 }
 
@@ -415,15 +696,71 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		if err := utils.CheckProfile(profileString, defaultPath); err != nil {
 			return nil, err
 		}
-		securityTokenBasedAuthConfigProvider := oci_common.CustomProfileConfigProvider(defaultPath, profileString)
+		securityTokenBasedAuthConfigProvider, err := newSecurityTokenConfigProvider(defaultPath, profileString)
+		if err != nil {
+			return nil, err
+		}
 
 		keyId, err := securityTokenBasedAuthConfigProvider.KeyID()
-		if err != nil || !strings.HasPrefix(keyId, "ST$") {
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(keyId, "ST$") {
 			return nil, fmt.Errorf("Security token is invalid ")
 		}
 		configProviders = append(configProviders, securityTokenBasedAuthConfigProvider)
+	case strings.ToLower(globalvar.AuthResourcePrincipal):
+		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
+		if !ok {
+			log.Printf("[DEBUG] Ignoring all user credentials for %v authentication", auth)
+		}
+
+		// The resource principal session token and private key are read from the OCI_RESOURCE_PRINCIPAL_* environment variables,
+		// which are set by OCI Functions and Resource Manager
+		cfg, err := newResourcePrincipalConfigProvider(getResourcePrincipalRegion(d.GetOk(globalvar.RegionAttrName)))
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
+
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(globalvar.AuthOKEWorkloadIdentity):
+		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
+		if !ok {
+			log.Printf("[DEBUG] Ignoring all user credentials for %v authentication", auth)
+		}
+
+		region := getResourcePrincipalRegion(d.GetOk(globalvar.RegionAttrName))
+		if region == "" {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (%s)", globalvar.RegionAttrName, globalvar.AuthOKEWorkloadIdentity)
+		}
+
+		cfg, err := newOkeWorkloadIdentityConfigProvider(region)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
+
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(globalvar.AuthTest):
+		if !isTestAuthEnabled() {
+			return nil, fmt.Errorf("auth must be one of '%s', %s is only accepted when the %s environment variable is set", strings.Join(getAuthTypes(), "' or '"), globalvar.AuthTest, globalvar.EnableTestAuthEnv)
+		}
+		region, ok := d.GetOk(globalvar.RegionAttrName)
+		if !ok {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (%s)", globalvar.RegionAttrName, globalvar.AuthTest)
+		}
+		tenancy, _ := d.Get(globalvar.TenancyOcidAttrName).(string)
+
+		cfg, err := newTestConfigProvider(tenancy, region.(string))
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[WARN] %s, the requests are only accepted by fake backends", cfg)
+
+		configProviders = append(configProviders, cfg)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken)
+		return nil, fmt.Errorf("auth must be one of '%s'", strings.Join(getAuthTypes(), "' or '"))
 	}
 
 	return configProviders, nil
@@ -557,11 +894,36 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 		oboTokenProvider = oboTokenProviderFromEnv{}
 	}
 
+	// Requests rejected because the security token was refreshed on disk are retried once with the new token
+	if p, ok := configProvider.(securityTokenComposingConfigProvider); ok {
+		if transport, ok := httpClient.Transport.(*http.Transport); ok {
+			httpClient.Transport = &securityTokenRefreshTransport{transport: transport, refresher: p.securityTokenProvider, signer: requestSigner}
+		}
+	}
+
+	throttler := newClientThrottler(RequestsPerSecond, MaxConcurrentRequestsPerService)
+
 	configureClientFn := func(client *oci_common.BaseClient) error {
 		client.HTTPClient = httpClient
+		if throttler != nil {
+			// Each client gets its own HTTP client, sharing the transport and the limits of its service with the other clients
+			client.HTTPClient = &http.Client{
+				Timeout:   httpClient.Timeout,
+				Transport: &throttlingTransport{transport: httpClient.Transport, throttle: throttler.getServiceThrottle(getServiceName(client.Host))},
+			}
+		}
 		client.UserAgent = userAgent
 		client.Signer = requestSigner
 		client.Interceptor = func(r *http.Request) error {
+			if err := checkReadOnlyRequest(r); err != nil {
+				return err
+			}
+
+			// Fail fast instead of sending requests to a service whose circuit breaker is open
+			if err := tf_resource.CheckCircuitBreaker(r); err != nil {
+				return err
+			}
+
 			if oboToken, err := oboTokenProvider.OboToken(); err == nil && oboToken != "" {
 				r.Header.Set(globalvar.RequestHeaderOpcOboToken, oboToken)
 			}
@@ -610,12 +972,12 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 				return fmt.Errorf("failed to append custom cert to the pool")
 			}
 			// install the certificates in the client
-			httpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs = pool
+			getHttpTransport(httpClient).TLSClientConfig.RootCAs = pool
 		}
 
 		if acceptLocalCerts := utils.GetEnvSettingWithBlankDefault(globalvar.AcceptLocalCerts); acceptLocalCerts != "" {
 			if bool, err := strconv.ParseBool(acceptLocalCerts); err == nil {
-				httpClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = bool
+				getHttpTransport(httpClient).TLSClientConfig.InsecureSkipVerify = bool
 			}
 		}
 
@@ -626,6 +988,7 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 				return err
 			}
 		}
+		client.HTTPClient = &requestLoggingDispatcher{dispatcher: client.HTTPClient}
 
 		return nil
 	}
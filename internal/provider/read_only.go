@@ -0,0 +1,78 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// ReadOnly makes the SDK clients reject the requests that can modify resources, it is set from the provider configuration
+// and forced by the export command
+var ReadOnly bool
+
+var (
+	// POST operations that only read resources, e.g. ListMetrics, SummarizeMetricsData or SearchResources
+	readOnlyPostPathRegex   = regexp.MustCompile(`(?i)(/actions/(list|get|summarize|search|query)[^/]*|/(search|query|searchListings))$`)
+	resourceSearchPathRegex = regexp.MustCompile(`^/[0-9]+/resources$`)
+	ocidPathSegmentRegex    = regexp.MustCompile(`^ocid1\.`)
+)
+
+// checkReadOnlyRequest returns an error if the provider is in read-only mode and the request can modify a resource
+func checkReadOnlyRequest(r *http.Request) error {
+	if !ReadOnly {
+		return nil
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		if readOnlyPostPathRegex.MatchString(r.URL.Path) || (strings.HasPrefix(r.URL.Host, "query.") && resourceSearchPathRegex.MatchString(r.URL.Path)) {
+			return nil
+		}
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("the provider is configured with %s = true, refusing to %s (%s %s)", globalvar.ReadOnlyAttrName, describeMutatingRequest(r.Method, r.URL.Path), r.Method, r.URL.Path)
+}
+
+// describeMutatingRequest names the operation and the resource of a request, e.g. "delete vcns ocid1.vcn.oc1..xxx"
+func describeMutatingRequest(method string, path string) string {
+	var resource, resourceId, action string
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments); i++ {
+		segment := segments[i]
+		switch {
+		case segment == "actions" && i+1 < len(segments):
+			action = segments[i+1]
+			i++
+		case ocidPathSegmentRegex.MatchString(segment):
+			resourceId = segment
+		case i > 0:
+			// The first segment is the API version
+			resource, resourceId = segment, ""
+		}
+	}
+
+	target := resource
+	if resourceId != "" {
+		target = fmt.Sprintf("%s %s", resource, resourceId)
+	}
+
+	switch {
+	case action != "":
+		return fmt.Sprintf("call %s on %s", action, target)
+	case method == http.MethodPost:
+		return fmt.Sprintf("create %s", target)
+	case method == http.MethodDelete:
+		return fmt.Sprintf("delete %s", target)
+	default:
+		return fmt.Sprintf("update %s", target)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+
+	tf_resource "github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// trackResourceOperations wraps the CRUD functions of a resource or data source so that the requests they make are logged
+// with the resource type and the operation
+func trackResourceOperations(resourceType string, resourceSchema *schema.Resource) {
+	resourceSchema.Create = withResourceOperation(resourceType, "create", resourceSchema.Create)
+	resourceSchema.Read = withResourceOperation(resourceType, "read", resourceSchema.Read)
+	resourceSchema.Update = withResourceOperation(resourceType, "update", resourceSchema.Update)
+	resourceSchema.Delete = withResourceOperation(resourceType, "delete", resourceSchema.Delete)
+}
+
+func withResourceOperation(resourceType string, operation string, fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		tf_resource.StartResourceOperation(d, resourceType, operation)
+		defer tf_resource.EndResourceOperation(d)
+		return fn(d, m)
+	}
+}
+
+// requestLoggingDispatcher logs and traces every request sent by an SDK client, including each retry attempt
+type requestLoggingDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d *requestLoggingDispatcher) Do(request *http.Request) (*http.Response, error) {
+	record := tf_resource.TraceRecord{
+		Kind:         tf_resource.TraceKindRequest,
+		Service:      getServiceName(request.URL.Host),
+		Method:       request.Method,
+		Path:         request.URL.Path,
+		Attempt:      tf_resource.GetRequestAttempt(request),
+		OpcRequestId: request.Header.Get(opcRequestIdHeader),
+		Start:        time.Now(),
+	}
+	response, err := d.dispatcher.Do(request)
+	record.End = time.Now()
+
+	if operation, ok := tf_resource.GetResourceOperation(request.Context()); ok {
+		record.ResourceType = operation.ResourceType
+		record.ResourceId = operation.ResourceId
+		record.Operation = operation.Operation
+	}
+	if response != nil {
+		record.Status = response.StatusCode
+		if opcRequestId := response.Header.Get(opcRequestIdHeader); opcRequestId != "" {
+			record.OpcRequestId = opcRequestId
+		}
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	tf_resource.RecordTrace(record)
+	utils.LogRequest(getRequestLogFields(record))
+	return response, err
+}
+
+func getRequestLogFields(record tf_resource.TraceRecord) map[string]interface{} {
+	fields := map[string]interface{}{
+		"service":        record.Service,
+		"http_method":    record.Method,
+		"path":           record.Path,
+		"latency_ms":     record.End.Sub(record.Start).Milliseconds(),
+		"attempt":        record.Attempt,
+		"opc_request_id": record.OpcRequestId,
+	}
+	if record.ResourceType != "" {
+		fields["resource_type"] = record.ResourceType
+		fields["operation"] = record.Operation
+	}
+	if record.ResourceId != "" {
+		fields["resource_id"] = record.ResourceId
+	}
+	if record.Status != 0 {
+		fields["status"] = record.Status
+	}
+	if record.Error != "" {
+		fields["error"] = record.Error
+	}
+	return fields
+}
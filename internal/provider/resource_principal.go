@@ -0,0 +1,39 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"fmt"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	oci_common_auth "github.com/oracle/oci-go-sdk/v55/common/auth"
+)
+
+// newResourcePrincipalConfigProvider returns the configuration provider of the resource principal that OCI Functions and
+// Resource Manager describe with the OCI_RESOURCE_PRINCIPAL_* environment variables. The region of the provider block
+// takes precedence over OCI_RESOURCE_PRINCIPAL_REGION, without changing the environment of the process.
+func newResourcePrincipalConfigProvider(region string) (oci_common.ConfigurationProvider, error) {
+	cfg, err := oci_common_auth.ResourcePrincipalConfigurationProvider()
+	if err != nil {
+		return nil, err
+	}
+	if region == "" {
+		return cfg, nil
+	}
+	return &regionConfigProvider{ConfigurationProvider: cfg, region: region}, nil
+}
+
+// regionConfigProvider overrides the region of a configuration provider
+type regionConfigProvider struct {
+	oci_common.ConfigurationProvider
+	region string
+}
+
+func (p *regionConfigProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+func (p *regionConfigProvider) String() string {
+	return fmt.Sprintf("%s in region %s", p.ConfigurationProvider, p.region)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	securityTokenFileSetting = "security_token_file"
+	// securityTokenRetryMaxBodySize is the size of the largest body buffered to retry a request, larger bodies such as
+	// object uploads are streamed and their request is not retried
+	securityTokenRetryMaxBodySize = 10 * 1024 * 1024
+)
+
+// securityTokenConfigProvider reads the profile from the OCI config file like CustomProfileConfigProvider, but keeps track of
+// the security_token_file so that a token refreshed on disk (e.g. by `oci session refresh`) is picked up during long applies.
+type securityTokenConfigProvider struct {
+	oci_common.ConfigurationProvider
+	profile   string
+	tokenPath string
+
+	mutex   sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+func newSecurityTokenConfigProvider(configPath string, profile string) (*securityTokenConfigProvider, error) {
+	tokenPath, err := getConfigFileProfileSetting(configPath, profile, securityTokenFileSetting)
+	if err != nil {
+		return nil, err
+	}
+	if tokenPath == "" {
+		return nil, fmt.Errorf("profile %s in %s does not contain %s, which is required for %s authentication", profile, configPath, securityTokenFileSetting, globalvar.AuthSecurityToken)
+	}
+	if strings.HasPrefix(tokenPath, "~/") {
+		tokenPath = filepath.Join(utils.GetHomeFolder(), tokenPath[2:])
+	}
+
+	p := &securityTokenConfigProvider{
+		ConfigurationProvider: oci_common.CustomProfileConfigProvider(configPath, profile),
+		profile:               profile,
+		tokenPath:             tokenPath,
+	}
+	if _, err := p.RefreshSecurityToken(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *securityTokenConfigProvider) String() string {
+	return fmt.Sprintf("%s configuration provider for profile %s with token file %s", globalvar.AuthSecurityToken, p.profile, p.tokenPath)
+}
+
+func (p *securityTokenConfigProvider) KeyID() (string, error) {
+	if _, err := p.RefreshSecurityToken(); err != nil {
+		return "", err
+	}
+
+	if expired, err := p.isExpired(); expired {
+		return "", err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return securityTokenKeyIdPrefix + p.token, nil
+}
+
+// RefreshSecurityToken re-reads the token file if its modification time changed since it was last read
+func (p *securityTokenConfigProvider) RefreshSecurityToken() (bool, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	info, err := os.Stat(p.tokenPath)
+	if err != nil {
+		return false, fmt.Errorf("can not read the security token from %s: %v", p.tokenPath, err)
+	}
+	if p.token != "" && info.ModTime().Equal(p.modTime) {
+		return false, nil
+	}
+
+	token, err := utils.GetTokenFromFile(p.tokenPath)
+	if err != nil {
+		return false, fmt.Errorf("can not read the security token from %s: %v", p.tokenPath, err)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return false, fmt.Errorf("the security token file %s is empty", p.tokenPath)
+	}
+
+	changed := p.token != "" && token != p.token
+	if changed {
+		log.Printf("[DEBUG] Security token for profile %s was refreshed from %s", p.profile, p.tokenPath)
+	}
+	p.token = token
+	p.modTime = info.ModTime()
+	return changed, nil
+}
+
+// isExpired returns true, and the error to report, if the current token is past its expiry
+func (p *securityTokenConfigProvider) isExpired() (bool, error) {
+	p.mutex.Lock()
+	token := p.token
+	p.mutex.Unlock()
+
+	if expiresAt, ok := getSecurityTokenExpiry(token); ok && time.Now().After(expiresAt) {
+		return true, fmt.Errorf("the security token for profile %s expired at %s, refresh it with `oci session refresh --profile %s` or create a new session with `oci session authenticate`", p.profile, expiresAt.Format(time.RFC3339), p.profile)
+	}
+	return false, nil
+}
+
+func getSecurityTokenExpiry(token string) (time.Time, bool) {
+	claims, err := parseJwtClaims(token)
+	if err != nil {
+		return time.Time{}, false
+	}
+	exp, ok := claims[jwtExpiryClaim].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(exp), 0), true
+}
+
+// securityTokenComposingConfigProvider is the composed SDK configuration provider, which also keeps the
+// securityTokenConfigProvider so that the HTTP client can retry requests rejected with an outdated token
+type securityTokenComposingConfigProvider struct {
+	oci_common.ConfigurationProvider
+	securityTokenProvider *securityTokenConfigProvider
+}
+
+// securityTokenRefreshTransport retries a request once with the refreshed security token when it was rejected with a 401
+type securityTokenRefreshTransport struct {
+	transport *http.Transport
+	refresher *securityTokenConfigProvider
+	signer    oci_common.HTTPRequestSigner
+}
+
+func (t *securityTokenRefreshTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// The SDK does not set GetBody, the body of the create and update requests is buffered so that it can be sent again
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil &&
+		request.ContentLength >= 0 && request.ContentLength <= securityTokenRetryMaxBodySize {
+		content, err := ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request = request.Clone(request.Context())
+		request.Body = ioutil.NopCloser(bytes.NewReader(content))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(content)), nil
+		}
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	refreshed, err := t.refresher.RefreshSecurityToken()
+	if err != nil {
+		log.Printf("[WARN] Unable to refresh the security token after a 401 response: %v", err)
+		return response, nil
+	}
+	if !refreshed {
+		if expired, expiredErr := t.refresher.isExpired(); expired {
+			response.Body.Close()
+			return nil, expiredErr
+		}
+		return response, nil
+	}
+
+	// The body has already been consumed, it can only be sent again if it was buffered
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return response, nil
+	}
+	retryRequest := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return response, nil
+		}
+		retryRequest.Body = body
+	}
+	if err := t.signer.Sign(retryRequest); err != nil {
+		return response, nil
+	}
+	response.Body.Close()
+
+	log.Printf("[DEBUG] Retrying %s %s with the refreshed security token", request.Method, request.URL.Path)
+	return t.transport.RoundTrip(retryRequest)
+}
+
+// getHttpTransport returns the transport of an HTTP client built by BuildHttpClient
+func getHttpTransport(httpClient *http.Client) *http.Transport {
+	if t, ok := httpClient.Transport.(*securityTokenRefreshTransport); ok {
+		return t.transport
+	}
+	return httpClient.Transport.(*http.Transport)
+}
+
+func getConfigFileProfileSetting(configPath string, profile string, setting string) (string, error) {
+	file, err := os.Open(configPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	profileRegex := regexp.MustCompile(`^\[(.*)\]`)
+	inProfile := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if match := profileRegex.FindStringSubmatch(line); match != nil {
+			inProfile = match[1] == profile
+			continue
+		}
+		if !inProfile || line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if parts := strings.SplitN(line, "=", 2); len(parts) == 2 && strings.TrimSpace(parts[0]) == setting {
+			return strings.TrimSpace(parts[1]), nil
+		}
+	}
+	return "", scanner.Err()
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestSecurityToken writes the token and moves the modification time forward so that the change is always detected
+func writeTestSecurityToken(t *testing.T, tokenPath string, token string, modTime time.Time) {
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte(token+"\n"), 0600))
+	assert.NoError(t, os.Chtimes(tokenPath, modTime, modTime))
+}
+
+// newTestSecurityTokenConfigProvider writes an OCI config file with a SecurityToken profile and returns its provider
+func newTestSecurityTokenConfigProvider(t *testing.T, token string) *securityTokenConfigProvider {
+	dir := t.TempDir()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keyPath := filepath.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(keyPath, []byte(getTestPrivateKeyPem(privateKey)), 0600))
+	tokenPath := filepath.Join(dir, "token")
+	writeTestSecurityToken(t, tokenPath, token, time.Now().Add(-time.Minute))
+
+	configPath := filepath.Join(dir, "config")
+	config := fmt.Sprintf(`[DEFAULT]
+tenancy=ocid1.tenancy.oc1..default
+region=us-phoenix-1
+
+[session]
+fingerprint=aa:bb
+key_file=%s
+tenancy=ocid1.tenancy.oc1..test
+region=us-ashburn-1
+# security_token_file=commented
+security_token_file = %s
+`, keyPath, tokenPath)
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte(config), 0600))
+
+	p, err := newSecurityTokenConfigProvider(configPath, "session")
+	assert.NoError(t, err)
+	return p
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetConfigFileProfileSetting(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config")
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte(`[DEFAULT]
+security_token_file=/default/token
+
+[session]
+# security_token_file=/commented/token
+region = us-ashburn-1
+security_token_file = /session/token
+`), 0600))
+
+	value, err := getConfigFileProfileSetting(configPath, "session", securityTokenFileSetting)
+	assert.NoError(t, err)
+	assert.Equal(t, "/session/token", value)
+
+	value, err = getConfigFileProfileSetting(configPath, "DEFAULT", securityTokenFileSetting)
+	assert.NoError(t, err)
+	assert.Equal(t, "/default/token", value)
+
+	value, err = getConfigFileProfileSetting(configPath, "DEFAULT", "region")
+	assert.NoError(t, err)
+	assert.Equal(t, "", value)
+
+	_, err = getConfigFileProfileSetting(filepath.Join(t.TempDir(), "missing"), "DEFAULT", securityTokenFileSetting)
+	assert.Error(t, err)
+
+	_, err = newSecurityTokenConfigProvider(configPath, "missing")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), securityTokenFileSetting)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitSecurityTokenConfigProvider_KeyID(t *testing.T) {
+	p := newTestSecurityTokenConfigProvider(t, "token1")
+
+	keyId, err := p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+"token1", keyId)
+	region, err := p.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", region)
+
+	// The token refreshed on disk is used for the next request
+	writeTestSecurityToken(t, p.tokenPath, "token2", time.Now())
+	keyId, err = p.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, securityTokenKeyIdPrefix+"token2", keyId)
+
+	refreshed, err := p.RefreshSecurityToken()
+	assert.NoError(t, err)
+	assert.False(t, refreshed)
+
+	// An expired token is reported instead of being sent
+	expired := getTestSessionToken(map[string]interface{}{jwtExpiryClaim: time.Now().Add(-time.Hour).Unix()})
+	writeTestSecurityToken(t, p.tokenPath, expired, time.Now().Add(time.Minute))
+	_, err = p.KeyID()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "oci session refresh --profile session")
+
+	writeTestSecurityToken(t, p.tokenPath, "", time.Now().Add(2*time.Minute))
+	_, err = p.KeyID()
+	assert.Error(t, err)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitSecurityTokenRefreshTransport(t *testing.T) {
+	p := newTestSecurityTokenConfigProvider(t, "token1")
+
+	// The server only accepts requests signed with token2
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if !strings.Contains(r.Header.Get("Authorization"), `keyId="ST$token2"`) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	signer := oci_common.DefaultRequestSigner(p)
+	transport := &securityTokenRefreshTransport{transport: &http.Transport{}, refresher: p, signer: signer}
+	newSignedRequest := func() *http.Request {
+		request, err := http.NewRequest(http.MethodPost, server.URL+"/vcns", strings.NewReader(`{"displayName":"test"}`))
+		assert.NoError(t, err)
+		request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+		assert.NoError(t, signer.Sign(request))
+		return request
+	}
+
+	// Without a refreshed token the 401 is returned as is
+	response, err := transport.RoundTrip(newSignedRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// The request signed with the previous token is retried once with the token refreshed on disk
+	request := newSignedRequest()
+	writeTestSecurityToken(t, p.tokenPath, "token2", time.Now())
+	response, err = transport.RoundTrip(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	body, _ := ioutil.ReadAll(response.Body)
+	assert.Equal(t, `{"displayName":"test"}`, string(body))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	// The body of a request built by the SDK, without GetBody, is sent again
+	writeTestSecurityToken(t, p.tokenPath, "token1", time.Now().Add(30*time.Second))
+	_, err = p.RefreshSecurityToken()
+	assert.NoError(t, err)
+	request = newSignedRequest()
+	request.GetBody = nil
+	writeTestSecurityToken(t, p.tokenPath, "token2", time.Now().Add(45*time.Second))
+	response, err = transport.RoundTrip(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	body, _ = ioutil.ReadAll(response.Body)
+	assert.Equal(t, `{"displayName":"test"}`, string(body))
+	assert.Equal(t, int32(5), atomic.LoadInt32(&requests))
+
+	// A request rejected with an expired token fails with the expiry error
+	expired := getTestSessionToken(map[string]interface{}{jwtExpiryClaim: time.Now().Add(-time.Hour).Unix()})
+	writeTestSecurityToken(t, p.tokenPath, expired, time.Now().Add(time.Minute))
+	_, err = p.RefreshSecurityToken()
+	assert.NoError(t, err)
+	request, err = http.NewRequest(http.MethodGet, server.URL+"/vcns", nil)
+	assert.NoError(t, err)
+	_, err = transport.RoundTrip(request)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "expired")
+}
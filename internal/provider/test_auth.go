@@ -0,0 +1,99 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"strconv"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	testAuthUserOcid    = "ocid1.user.oc1..aaaaaaaafakeuser"
+	testAuthFingerprint = "00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00"
+)
+
+// isTestAuthEnabled returns whether the Test auth can be selected. It is only enabled by the enable_test_auth environment
+// variable, so that the configurations targeting OCI can not select it.
+func isTestAuthEnabled() bool {
+	enabled, err := strconv.ParseBool(utils.GetEnvSettingWithBlankDefault(globalvar.EnableTestAuthEnv))
+	return err == nil && enabled
+}
+
+// getAuthTypes returns the values accepted by the auth attribute
+func getAuthTypes() []string {
+	authTypes := []string{globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting,
+		globalvar.AuthSecurityToken, globalvar.AuthResourcePrincipal, globalvar.AuthOKEWorkloadIdentity}
+	if isTestAuthEnabled() {
+		authTypes = append(authTypes, globalvar.AuthTest)
+	}
+	return authTypes
+}
+
+// testConfigProvider signs the requests with a key generated when the provider starts. It is only accepted by fake
+// backends, like the one of the fakeoci package, which the provider targets with CLIENT_HOST_OVERRIDES or
+// domain_name_override.
+type testConfigProvider struct {
+	tenancy    string
+	region     string
+	privateKey *rsa.PrivateKey
+}
+
+func newTestConfigProvider(tenancy string, region string) (*testConfigProvider, error) {
+	if tenancy == "" {
+		tenancy = globalvar.TestAuthTenancyOcid
+	}
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("can not generate the key of the %s auth: %v", globalvar.AuthTest, err)
+	}
+	return &testConfigProvider{
+		tenancy:    tenancy,
+		region:     region,
+		privateKey: privateKey,
+	}, nil
+}
+
+func (p *testConfigProvider) String() string {
+	return fmt.Sprintf("%s configuration provider for tenancy %s", globalvar.AuthTest, p.tenancy)
+}
+
+func (p *testConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
+
+func (p *testConfigProvider) KeyID() (string, error) {
+	return fmt.Sprintf("%s/%s/%s", p.tenancy, testAuthUserOcid, testAuthFingerprint), nil
+}
+
+func (p *testConfigProvider) TenancyOCID() (string, error) {
+	return p.tenancy, nil
+}
+
+func (p *testConfigProvider) UserOCID() (string, error) {
+	return testAuthUserOcid, nil
+}
+
+func (p *testConfigProvider) KeyFingerprint() (string, error) {
+	return testAuthFingerprint, nil
+}
+
+func (p *testConfigProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+func (p *testConfigProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{
+			AuthType:         oci_common.UnknownAuthenticationType,
+			IsFromConfigFile: false,
+			OboToken:         nil,
+		},
+		fmt.Errorf("unsupported, keep the interface")
+}
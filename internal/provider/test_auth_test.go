@@ -0,0 +1,88 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/fakeoci"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitTestAuth_disabled(t *testing.T) {
+	defer os.Setenv(globalvar.EnableTestAuthEnv, os.Getenv(globalvar.EnableTestAuthEnv))
+	os.Unsetenv(globalvar.EnableTestAuthEnv)
+
+	// The Test auth can not be selected by the configurations targeting OCI
+	_, errs := SchemaMap()[globalvar.AuthAttrName].ValidateFunc(globalvar.AuthTest, globalvar.AuthAttrName)
+	assert.NotEmpty(t, errs)
+	assert.NotContains(t, descriptions[globalvar.AuthAttrName], globalvar.AuthTest)
+
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:   globalvar.AuthTest,
+		globalvar.RegionAttrName: "us-phoenix-1",
+	})
+	_, err := getConfigProviders(d, strings.ToLower(globalvar.AuthTest))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), globalvar.EnableTestAuthEnv)
+
+	os.Setenv(globalvar.EnableTestAuthEnv, "true")
+	_, errs = SchemaMap()[globalvar.AuthAttrName].ValidateFunc(globalvar.AuthTest, globalvar.AuthAttrName)
+	assert.Empty(t, errs)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitTestAuth_fakeBackendVcn(t *testing.T) {
+	for _, env := range []string{globalvar.EnableTestAuthEnv, globalvar.ClientHostOverridesEnv} {
+		defer os.Setenv(env, os.Getenv(env))
+	}
+	server := httptest.NewServer(fakeoci.NewServer())
+	defer server.Close()
+	os.Setenv(globalvar.EnableTestAuthEnv, "true")
+	os.Setenv(globalvar.ClientHostOverridesEnv, "oci_core.VirtualNetworkClient="+server.URL+";oci_identity.IdentityClient="+server.URL)
+
+	p := Provider().(*schema.Provider)
+	err := p.Configure(terraform.NewResourceConfigRaw(map[string]interface{}{
+		globalvar.AuthAttrName:   globalvar.AuthTest,
+		globalvar.RegionAttrName: "us-phoenix-1",
+	}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// The VCN is created and polled until it is available through the CRUD of the resource
+	vcn := p.ResourcesMap["oci_core_vcn"]
+	d := schema.TestResourceDataRaw(t, vcn.Schema, map[string]interface{}{
+		"compartment_id": fakeoci.DefaultTenancyOcid,
+		"cidr_blocks":    []interface{}{"10.0.0.0/16"},
+		"display_name":   "vcn",
+		"dns_label":      "vcn",
+	})
+	if !assert.NoError(t, vcn.Create(d, p.Meta())) {
+		return
+	}
+	assert.Regexp(t, `^ocid1\.vcn\.oc1\.phx\.`, d.Id())
+	assert.Equal(t, "AVAILABLE", d.Get("state"))
+	assert.Equal(t, "vcn.oraclevcn.com", d.Get("vcn_domain_name"))
+	assert.NotEmpty(t, d.Get("default_route_table_id"))
+
+	id := d.Id()
+	assert.NoError(t, vcn.Read(d, p.Meta()))
+	assert.Equal(t, id, d.Id())
+	assert.Equal(t, "vcn", d.Get("display_name"))
+
+	// The VCN is deleted and polled until it is terminated, then it is removed from the state on read
+	assert.NoError(t, vcn.Delete(d, p.Meta()))
+	d.SetId(id)
+	assert.NoError(t, vcn.Read(d, p.Meta()))
+	assert.Equal(t, "", d.Id())
+}
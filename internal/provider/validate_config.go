@@ -0,0 +1,265 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/md5"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	oci_common_auth "github.com/oracle/oci-go-sdk/v55/common/auth"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+type configCheckStatusEnum string
+
+const (
+	ConfigCheckPass configCheckStatusEnum = "PASS"
+	ConfigCheckWarn configCheckStatusEnum = "WARN"
+	ConfigCheckFail configCheckStatusEnum = "FAIL"
+	ConfigCheckSkip configCheckStatusEnum = "SKIP"
+)
+
+var regionFormatRegex = regexp.MustCompile(`^[a-z]+(-[a-z]+)+-[0-9]+$`)
+
+// The provider attributes read from the environment by the validate_config command
+var validateConfigAttributes = []string{
+	globalvar.AuthAttrName,
+	globalvar.ConfigFileProfileAttrName,
+	globalvar.RegionAttrName,
+	globalvar.TenancyOcidAttrName,
+	globalvar.UserOcidAttrName,
+	globalvar.FingerprintAttrName,
+	globalvar.PrivateKeyAttrName,
+	globalvar.PrivateKeyPathAttrName,
+	globalvar.PrivateKeyPasswordAttrName,
+}
+
+type ConfigCheck struct {
+	Name    string
+	Status  configCheckStatusEnum
+	Message string
+}
+
+// ConfigValidationReport is the result of the offline checks of the provider configuration
+type ConfigValidationReport struct {
+	Auth   string
+	Checks []ConfigCheck
+}
+
+func (r *ConfigValidationReport) add(name string, status configCheckStatusEnum, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, ConfigCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *ConfigValidationReport) addResult(name string, err error, format string, args ...interface{}) {
+	if err != nil {
+		r.add(name, ConfigCheckFail, "%v", err)
+		return
+	}
+	r.add(name, ConfigCheckPass, format, args...)
+}
+
+func (r *ConfigValidationReport) Passed() bool {
+	for _, check := range r.Checks {
+		if check.Status == ConfigCheckFail {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ConfigValidationReport) Write(w io.Writer) {
+	fmt.Fprintf(w, "Validating the provider configuration for auth %s\n", r.Auth)
+	failed := 0
+	for _, check := range r.Checks {
+		fmt.Fprintf(w, "  %-4s  %s: %s\n", check.Status, check.Name, check.Message)
+		if check.Status == ConfigCheckFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		fmt.Fprintf(w, "%s: %d of %d checks failed\n", ConfigCheckFail, failed, len(r.Checks))
+		return
+	}
+	fmt.Fprintf(w, "%s: %d checks\n", ConfigCheckPass, len(r.Checks))
+}
+
+// RunValidateConfigCommand validates the provider configuration set in the environment, like the export command reads it
+func RunValidateConfigCommand(out io.Writer) (bool, error) {
+	r := &schema.Resource{
+		Schema: SchemaMap(),
+	}
+	d := r.Data(nil)
+	for _, attrName := range validateConfigAttributes {
+		if value := utils.GetProviderEnvSettingWithDefault(attrName, ""); value != "" {
+			if err := d.Set(attrName, value); err != nil {
+				return false, err
+			}
+		}
+	}
+	if auth, _ := d.Get(globalvar.AuthAttrName).(string); auth == "" {
+		if err := d.Set(globalvar.AuthAttrName, globalvar.AuthAPIKeySetting); err != nil {
+			return false, err
+		}
+	}
+
+	report := ValidateConfig(d)
+	report.Write(out)
+	return report.Passed(), nil
+}
+
+// ValidateConfig builds the configuration provider like GetSdkConfigProvider and checks it without any network call.
+// The instance principals and the OKE workload identity get their credentials from a service, so they are not built.
+func ValidateConfig(d *schema.ResourceData) *ConfigValidationReport {
+	auth := d.Get(globalvar.AuthAttrName).(string)
+	report := &ConfigValidationReport{Auth: auth}
+
+	authTypes := getAuthTypes()
+	isApiKey := strings.EqualFold(auth, globalvar.AuthAPIKeySetting)
+	validAuth := false
+	for _, authType := range authTypes {
+		validAuth = validAuth || strings.EqualFold(auth, authType)
+	}
+	if !validAuth {
+		report.add(globalvar.AuthAttrName, ConfigCheckFail, "must be one of %s", strings.Join(authTypes, ", "))
+		return report
+	}
+	report.add(globalvar.AuthAttrName, ConfigCheckPass, "%s", auth)
+
+	if region, ok := d.GetOk(globalvar.RegionAttrName); ok {
+		report.addResult(globalvar.RegionAttrName, checkRegionFormat(region.(string)), "%s", region)
+	} else if isApiKey {
+		report.add(globalvar.RegionAttrName, ConfigCheckWarn, "not set, it is read from the config file profile")
+	} else {
+		report.add(globalvar.RegionAttrName, ConfigCheckFail, "required for %s authentication", auth)
+	}
+
+	if !isApiKey {
+		if attributes, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes); !ok {
+			report.add("incompatible attributes", ConfigCheckWarn, "%s are ignored with %s authentication, unset them", strings.Join(attributes, ", "), auth)
+		} else {
+			report.add("incompatible attributes", ConfigCheckPass, "none")
+		}
+	}
+
+	configPath := path.Join(utils.GetHomeFolder(), globalvar.DefaultConfigDirName, globalvar.DefaultConfigFileName)
+	profile, _ := d.Get(globalvar.ConfigFileProfileAttrName).(string)
+	if profile != "" {
+		report.addResult(globalvar.ConfigFileProfileAttrName, utils.CheckProfile(profile, configPath), "%s found in %s", profile, configPath)
+	} else if strings.EqualFold(auth, globalvar.AuthSecurityToken) {
+		report.add(globalvar.ConfigFileProfileAttrName, ConfigCheckFail, "required for %s authentication", auth)
+	}
+
+	switch {
+	case strings.EqualFold(auth, globalvar.AuthInstancePrincipalSetting), strings.EqualFold(auth, globalvar.AuthInstancePrincipalWithCertsSetting):
+		report.add("configuration provider", ConfigCheckSkip, "the %s credentials are fetched from the instance metadata service", auth)
+		return report
+	case strings.EqualFold(auth, globalvar.AuthOKEWorkloadIdentity):
+		report.add("configuration provider", ConfigCheckSkip, "the %s session token is requested from the proxymux of the cluster", auth)
+		return report
+	case strings.EqualFold(auth, globalvar.AuthResourcePrincipal):
+		if version := os.Getenv(oci_common_auth.ResourcePrincipalVersionEnvVar); version != oci_common_auth.ResourcePrincipalVersion2_2 {
+			report.add("configuration provider", ConfigCheckSkip, "only the resource principals version %s are built offline, %s is '%s'",
+				oci_common_auth.ResourcePrincipalVersion2_2, oci_common_auth.ResourcePrincipalVersionEnvVar, version)
+			return report
+		}
+	case strings.EqualFold(auth, globalvar.AuthSecurityToken) && profile != "":
+		report.addResult("security token file", checkSecurityTokenFile(configPath, profile), "valid")
+	}
+
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	configProvider, err := GetSdkConfigProvider(d, clients)
+	report.addResult("configuration provider", err, "built")
+	if err != nil {
+		return report
+	}
+
+	if _, err := configProvider.KeyID(); err != nil {
+		report.add("key id", ConfigCheckFail, "%v", err)
+	} else if isApiKey {
+		tenancy, _ := configProvider.TenancyOCID()
+		user, _ := configProvider.UserOCID()
+		report.add("key id", ConfigCheckPass, "tenancy %s, user %s", tenancy, user)
+	}
+	if _, ok := d.GetOk(globalvar.RegionAttrName); !ok {
+		region, err := configProvider.Region()
+		if err == nil {
+			err = checkRegionFormat(region)
+		}
+		report.addResult("config file region", err, "%s", region)
+	}
+
+	privateKey, err := configProvider.PrivateRSAKey()
+	report.addResult("private key", err, "parsed")
+	if err == nil && isApiKey {
+		fingerprint, err := configProvider.KeyFingerprint()
+		if err == nil {
+			err = checkKeyFingerprint(privateKey.Public(), fingerprint)
+		}
+		report.addResult(globalvar.FingerprintAttrName, err, "matches the private key")
+	}
+	return report
+}
+
+func checkRegionFormat(region string) error {
+	if regionFormatRegex.MatchString(region) {
+		return nil
+	}
+	// The short region keys, e.g. phx, and the regions known to the SDK are also accepted in any case, the other strings
+	// are returned unchanged by StringToRegion
+	if _, err := oci_common.StringToRegion(region).RealmID(); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s is not a valid region, expected a region identifier like us-phoenix-1", region)
+}
+
+// checkKeyFingerprint compares the fingerprint with the MD5 of the public key, like the one shown in the console
+func checkKeyFingerprint(publicKey interface{}, fingerprint string) error {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	sum := md5.Sum(der)
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02x", b)
+	}
+	if keyFingerprint := strings.Join(hexBytes, ":"); !strings.EqualFold(keyFingerprint, strings.TrimSpace(fingerprint)) {
+		return fmt.Errorf("%s does not match the fingerprint of the private key %s", fingerprint, keyFingerprint)
+	}
+	return nil
+}
+
+func checkSecurityTokenFile(configPath string, profile string) error {
+	tokenPath, err := getConfigFileProfileSetting(configPath, profile, securityTokenFileSetting)
+	if err != nil {
+		return err
+	}
+	if tokenPath == "" {
+		return fmt.Errorf("profile %s does not contain %s", profile, securityTokenFileSetting)
+	}
+	if strings.HasPrefix(tokenPath, "~/") {
+		tokenPath = filepath.Join(utils.GetHomeFolder(), tokenPath[2:])
+	}
+	token, err := utils.GetTokenFromFile(tokenPath)
+	if err != nil {
+		return fmt.Errorf("can not read the security token from %s: %v", tokenPath, err)
+	}
+	if expiresAt, ok := getSecurityTokenExpiry(strings.TrimSpace(token)); ok && time.Now().After(expiresAt) {
+		return fmt.Errorf("the security token in %s expired at %s, refresh it with `oci session refresh --profile %s`", tokenPath, expiresAt.Format(time.RFC3339), profile)
+	}
+	return nil
+}
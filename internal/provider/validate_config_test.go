@@ -0,0 +1,161 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// setTestConfigHome points the home folder at a temporary directory with the given OCI config file, and returns its path
+func setTestConfigHome(t *testing.T, config string) string {
+	home := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(home, globalvar.DefaultConfigDirName), 0700))
+	if config != "" {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(home, globalvar.DefaultConfigDirName, globalvar.DefaultConfigFileName), []byte(config), 0600))
+	}
+	os.Setenv("TF_HOME_OVERRIDE", home)
+	return home
+}
+
+func getConfigCheck(report *ConfigValidationReport, name string) ConfigCheck {
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	return ConfigCheck{}
+}
+
+// newTestApiKeyConfig returns the provider attributes of an ApiKey configuration whose private key is written to dir
+func newTestApiKeyConfig(t *testing.T, dir string) (map[string]interface{}, *rsa.PrivateKey) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keyPath := filepath.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(keyPath, []byte(getTestPrivateKeyPem(privateKey)), 0600))
+	return map[string]interface{}{
+		globalvar.AuthAttrName:           globalvar.AuthAPIKeySetting,
+		globalvar.RegionAttrName:         "us-phoenix-1",
+		globalvar.TenancyOcidAttrName:    "ocid1.tenancy.oc1..test",
+		globalvar.UserOcidAttrName:       "ocid1.user.oc1..test",
+		globalvar.FingerprintAttrName:    "00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff",
+		globalvar.PrivateKeyPathAttrName: keyPath,
+	}, privateKey
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateConfig_apiKey(t *testing.T) {
+	defer os.Setenv("TF_HOME_OVERRIDE", os.Getenv("TF_HOME_OVERRIDE"))
+	home := setTestConfigHome(t, "")
+	config, privateKey := newTestApiKeyConfig(t, home)
+
+	// The fingerprint does not match the private key
+	report := ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), config))
+	assert.False(t, report.Passed())
+	assert.Equal(t, ConfigCheckPass, getConfigCheck(report, "key id").Status)
+	assert.Equal(t, ConfigCheckPass, getConfigCheck(report, "private key").Status)
+	fingerprintCheck := getConfigCheck(report, globalvar.FingerprintAttrName)
+	assert.Equal(t, ConfigCheckFail, fingerprintCheck.Status)
+	assert.Contains(t, fingerprintCheck.Message, "does not match the fingerprint of the private key")
+
+	// The fingerprint of the private key, like the one shown in the console, is accepted
+	fields := strings.Fields(fingerprintCheck.Message)
+	keyFingerprint := fields[len(fields)-1]
+	assert.NoError(t, checkKeyFingerprint(privateKey.Public(), keyFingerprint))
+	config[globalvar.FingerprintAttrName] = keyFingerprint
+	report = ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), config))
+	assert.True(t, report.Passed())
+
+	// The region is not a region identifier
+	config[globalvar.RegionAttrName] = "phoenix"
+	report = ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), config))
+	assert.False(t, report.Passed())
+	regionCheck := getConfigCheck(report, globalvar.RegionAttrName)
+	assert.Equal(t, ConfigCheckFail, regionCheck.Status)
+	assert.Contains(t, regionCheck.Message, "phoenix is not a valid region")
+	assert.NoError(t, checkRegionFormat("phx"))
+	assert.NoError(t, checkRegionFormat("US-ASHBURN-1"))
+	assert.NoError(t, checkRegionFormat("xx-newregion-1"))
+	assert.Error(t, checkRegionFormat("Foo"))
+	assert.Error(t, checkRegionFormat("Phoenix"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateConfig_profile(t *testing.T) {
+	defer os.Setenv("TF_HOME_OVERRIDE", os.Getenv("TF_HOME_OVERRIDE"))
+	home := setTestConfigHome(t, "[DEFAULT]\nregion=us-phoenix-1\n")
+	config, _ := newTestApiKeyConfig(t, home)
+
+	config[globalvar.ConfigFileProfileAttrName] = "missing"
+	report := ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), config))
+	assert.False(t, report.Passed())
+	profileCheck := getConfigCheck(report, globalvar.ConfigFileProfileAttrName)
+	assert.Equal(t, ConfigCheckFail, profileCheck.Status)
+	assert.Contains(t, profileCheck.Message, "configuration file did not contain profile: missing")
+
+	// The SecurityToken auth requires a profile
+	report = ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:   globalvar.AuthSecurityToken,
+		globalvar.RegionAttrName: "us-phoenix-1",
+	}))
+	assert.False(t, report.Passed())
+	assert.Equal(t, ConfigCheckFail, getConfigCheck(report, globalvar.ConfigFileProfileAttrName).Status)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateConfig_expiredSecurityToken(t *testing.T) {
+	defer os.Setenv("TF_HOME_OVERRIDE", os.Getenv("TF_HOME_OVERRIDE"))
+	home := setTestConfigHome(t, "")
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keyPath := filepath.Join(home, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(keyPath, []byte(getTestPrivateKeyPem(privateKey)), 0600))
+	tokenPath := filepath.Join(home, "token")
+	expired := getTestSessionToken(map[string]interface{}{jwtExpiryClaim: time.Now().Add(-time.Hour).Unix()})
+	writeTestSecurityToken(t, tokenPath, expired, time.Now())
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(home, globalvar.DefaultConfigDirName, globalvar.DefaultConfigFileName), []byte(fmt.Sprintf(`[session]
+fingerprint=aa:bb
+key_file=%s
+tenancy=ocid1.tenancy.oc1..test
+region=us-ashburn-1
+security_token_file=%s
+`, keyPath, tokenPath)), 0600))
+
+	report := ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:              globalvar.AuthSecurityToken,
+		globalvar.RegionAttrName:            "us-ashburn-1",
+		globalvar.ConfigFileProfileAttrName: "session",
+	}))
+	assert.False(t, report.Passed())
+	tokenCheck := getConfigCheck(report, "security token file")
+	assert.Equal(t, ConfigCheckFail, tokenCheck.Status)
+	assert.Contains(t, tokenCheck.Message, "oci session refresh --profile session")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateConfig_okeWorkloadIdentity(t *testing.T) {
+	defer os.Setenv(kubernetesServiceHostEnv, os.Getenv(kubernetesServiceHostEnv))
+	os.Setenv(kubernetesServiceHostEnv, "127.0.0.1")
+
+	// The configuration provider is not built, so the session token is not requested from the proxymux
+	report := ValidateConfig(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:   globalvar.AuthOKEWorkloadIdentity,
+		globalvar.RegionAttrName: "us-ashburn-1",
+	}))
+	assert.True(t, report.Passed())
+	assert.Equal(t, ConfigCheckSkip, getConfigCheck(report, "configuration provider").Status)
+	assert.Equal(t, ConfigCheck{}, getConfigCheck(report, "key id"))
+}
@@ -204,6 +204,10 @@ type ExportCommandArgs struct {
 	ExcludeServices              []string
 	IsExportWithRelatedResources bool
 	Parallelism                  int
+	IncludeSubcompartments       bool
+	OutputFormat                 OutputFormatEnum
+	Filters                      []string
+	Incremental                  bool
 }
 
 func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
@@ -215,6 +219,7 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 			status = StatusFail
 		}
 	}()
+	defer tfresource.LogRetrySummary()
 	resourcesMap = tf_provider.ResourcesMap()
 	datasourcesMap = tf_provider.DataSourcesMap()
 
@@ -223,6 +228,10 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 	}
 
 	tfHclVersion = *args.TFVersion
+	exportOutputFormat = OutputFormatHcl
+	if args.OutputFormat != "" {
+		exportOutputFormat = args.OutputFormat
+	}
 
 	r := &schema.Resource{
 		Schema: tf_provider.SchemaMap(),
@@ -277,13 +286,6 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 
 	sem = make(chan struct{}, args.Parallelism)
 
-	ctx, err := createResourceDiscoveryContext(clients.(*tf_client.OracleClients), args, tenancyOcid)
-	if err != nil {
-		utils.Logln(err.Error())
-		return err, StatusFail
-	}
-	args.finalizeServices(ctx)
-
 	/*
 		Setting retry timeout to a lower value for resource discovery
 		This is done to handle the 404 and 500 errors in case
@@ -297,6 +299,21 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 
 	utils.Logf("[INFO] resource discovery retry timeout duration set to %v", tfresource.ShortRetryTime)
 
+	if args.IncludeSubcompartments {
+		err, status := runSubcompartmentsExport(clients.(*tf_client.OracleClients), args, tenancyOcid)
+		if err != nil {
+			utils.Logln(err.Error())
+		}
+		return err, status
+	}
+
+	ctx, err := createResourceDiscoveryContext(clients.(*tf_client.OracleClients), args, tenancyOcid)
+	if err != nil {
+		utils.Logln(err.Error())
+		return err, StatusFail
+	}
+	args.finalizeServices(ctx)
+
 	if err := runExportCommand(ctx); err != nil {
 		utils.Logln(err.Error())
 		return err, StatusFail
@@ -360,6 +377,44 @@ func (args *ExportCommandArgs) validate() error {
 		return fmt.Errorf("[ERROR] output_path %s should be a directory", *args.OutputDir)
 	}
 
+	if args.OutputFormat != "" && args.OutputFormat != OutputFormatHcl && args.OutputFormat != OutputFormatJson {
+		return fmt.Errorf("[ERROR] invalid output_format '%s', supported values: %s, %s", args.OutputFormat, OutputFormatHcl, OutputFormatJson)
+	}
+
+	if len(args.Filters) > 0 {
+		if _, err := parseResourceFilters(args.Filters); err != nil {
+			return err
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] filter is not supported with ids")
+		}
+	}
+
+	if args.Incremental {
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state is not supported with incremental, the state of the previous export is kept")
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] ids is not supported with incremental")
+		}
+		if args.IncludeSubcompartments {
+			return fmt.Errorf("[ERROR] include_subcompartments is not supported with incremental")
+		}
+	}
+
+	if args.IncludeSubcompartments {
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state is not supported with include_subcompartments")
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] ids is not supported with include_subcompartments")
+		}
+		// Terraform only reads the import blocks of the root module, not the ones of the modules of the subcompartments
+		if args.TFVersion != nil && *args.TFVersion != nil && generatesImportBlocks(*args.TFVersion) {
+			return fmt.Errorf("[ERROR] tf_version %s is not supported with include_subcompartments", (*args.TFVersion).toString())
+		}
+	}
+
 	return nil
 }
 
@@ -385,6 +440,9 @@ func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
+	// Discovery only reads resources, make sure that no request can modify them
+	tf_provider.ReadOnly = true
+
 	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
 	configureClientLocal, err := tf_provider.BuildConfigureClientFn(sdkConfigProvider, httpClient)
 	if err != nil {
@@ -472,6 +530,20 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	ctx.timeTakenToDiscover = totalDiscoveryTime
 	utils.Debug("[DEBUG] ~~~~~~ discover steps completed ~~~~~~")
 
+	// The exported resources reference the resources omitted by the filters through variables
+	if len(ctx.resourceFilters) > 0 {
+		addFilteredResourceReferences(steps)
+	}
+
+	// The incremental export only writes the configuration of the new and adopted resources, the files of the previous export are kept
+	if ctx.previousExport != nil {
+		incrementalStep, err := ctx.previousExport.getIncrementalStep(ctx, steps)
+		if err != nil {
+			return err
+		}
+		steps = []resourceDiscoveryStep{incrementalStep}
+	}
+
 	if ctx.GenerateState {
 		stateStart := time.Now()
 		// Run import commands
@@ -555,11 +627,18 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	}
 	vars["region"] = fmt.Sprintf("\"%s\"", region)
 
-	if err := generateProviderFile(ctx.OutputDir); err != nil {
-		return err
+	// The modules of the subcompartments use the provider of the root module
+	if !ctx.isCompartmentModule && ctx.previousExport == nil {
+		if err := generateProviderFile(ctx.OutputDir); err != nil {
+			return err
+		}
 	}
 
-	if err := generateVarsFile(vars, ctx.OutputDir); err != nil {
+	if ctx.previousExport != nil {
+		if err := writeVarsFile(ctx.previousExport.getNewVariables(vars), ctx.OutputDir, incrementalVarsFile); err != nil {
+			return err
+		}
+	} else if err := generateVarsFile(vars, ctx.OutputDir); err != nil {
 		return err
 	}
 
@@ -770,10 +849,7 @@ func importResource(ctx *resourceDiscoveryContext, resource *OCIResource, tmpSta
 		return
 	}
 
-	importId := resource.importId
-	if len(importId) == 0 {
-		importId = resource.id
-	}
+	importId := resource.getImportId()
 
 	importArgs := []tfexec.ImportOption{
 		tfexec.Config(*ctx.OutputDir),
@@ -918,6 +994,13 @@ func findResources(ctx *resourceDiscoveryContext, root *OCIResource, resourceGra
 				return
 			}
 
+			// Keep the names of the resources found by the previous export
+			if ctx.previousExport != nil {
+				for _, resource := range results {
+					ctx.previousExport.applyPreviousName(resource)
+				}
+			}
+
 			if childType.processDiscoveredResourcesFn != nil {
 				results, err = childType.processDiscoveredResourcesFn(ctx, results)
 				if err != nil {
@@ -959,18 +1042,31 @@ func findResources(ctx *resourceDiscoveryContext, root *OCIResource, resourceGra
 }
 
 func generateVarsFile(vars map[string]string, outputDir *string) error {
-	varsTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), globalvar.VarsFile)
-	varsOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.VarsFile)
-	file, err := os.OpenFile(varsTmpFile, os.O_CREATE|os.O_RDWR, 0666)
+	return writeVarsFile(vars, outputDir, globalvar.VarsFile)
+}
+
+func writeVarsFile(vars map[string]string, outputDir *string, fileName string) error {
+	varsTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), getConfigFileName(fileName))
+	varsOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), getConfigFileName(fileName))
+	file, err := os.OpenFile(varsTmpFile, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
 	if err != nil {
 		return err
 	}
 
-	for variable, defaultVal := range vars {
-		if defaultVal != "" {
-			_, _ = file.WriteString(fmt.Sprintf("variable %s { default = %s }\n", variable, defaultVal))
-		} else {
-			_, _ = file.WriteString(fmt.Sprintf("variable %s {}\n", variable))
+	if exportOutputFormat == OutputFormatJson {
+		content, err := generateJSONVarsFile(vars)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		_, _ = file.Write(content)
+	} else {
+		for variable, defaultVal := range vars {
+			if defaultVal != "" {
+				_, _ = file.WriteString(fmt.Sprintf("variable %s { default = %s }\n", variable, defaultVal))
+			} else {
+				_, _ = file.WriteString(fmt.Sprintf("variable %s {}\n", variable))
+			}
 		}
 	}
 
@@ -986,14 +1082,21 @@ func generateVarsFile(vars map[string]string, outputDir *string) error {
 }
 
 func generateProviderFile(outputDir *string) error {
-	providerTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
-	providerOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
-	file, err := os.OpenFile(providerTmpFile, os.O_CREATE|os.O_RDWR, 0666)
+	providerTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), getConfigFileName(globalvar.ProviderFile))
+	providerOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), getConfigFileName(globalvar.ProviderFile))
+	file, err := os.OpenFile(providerTmpFile, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
 	if err != nil {
 		return err
 	}
 
-	_, err = file.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tfHclVersion.getVarHclString("region")))
+	if exportOutputFormat == OutputFormatJson {
+		var content []byte
+		if content, err = generateJSONProviderFile(); err == nil {
+			_, err = file.Write(content)
+		}
+	} else {
+		_, err = file.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tfHclVersion.getVarHclString("region")))
+	}
 	if err != nil {
 		_ = file.Close()
 		return err
@@ -1037,6 +1140,14 @@ func (tr *TerraformResource) getHclReferenceIdString() string {
 	return tfHclVersion.getDoubleExpHclString(tr.getTerraformReference(), "id")
 }
 
+// getImportId returns the id used to import the resource, the composite id for the resources which have one
+func (tr *TerraformResource) getImportId() string {
+	if len(tr.importId) == 0 {
+		return tr.id
+	}
+	return tr.importId
+}
+
 func (tr *TerraformResource) getTerraformReference() string {
 	return fmt.Sprintf("%s.%s", tr.terraformClass, tr.terraformName)
 }
@@ -1196,30 +1307,8 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 			We can extend this in future to provide this option to customer to add default values for attributes
 			and add this logic to Optional attributes too */
 
-			if ociRes.terraformTypeInfo == nil {
-				ociRes.terraformTypeInfo = &TerraformResourceHints{}
-			}
-
-			if ociRes.terraformTypeInfo.defaultValuesForMissingAttributes == nil {
-				ociRes.terraformTypeInfo.defaultValuesForMissingAttributes = make(map[string]interface{})
-			}
-			if tfAttributeVal, exists := ociRes.terraformTypeInfo.defaultValuesForMissingAttributes[tfAttribute]; exists {
-				builder.WriteString(fmt.Sprintf("%s = %q", tfAttribute, tfAttributeVal))
-			} else {
-				builder.WriteString(fmt.Sprintf("%s = %q", tfAttribute, globalvar.PlaceholderValueForMissingAttribute))
-			}
+			builder.WriteString(fmt.Sprintf("%s = %q", tfAttribute, getMissingRequiredAttributeValue(ociRes, tfAttribute, attributePrefix)))
 			builder.WriteString("\t#Required attribute not found in discovery, placeholder value set to avoid plan failure\n")
-			isMissingRequiredAttributes = true
-
-			/* Add missing required attribute to ignorableRequiredMissingAttributes to be generated in lifecycle ignore_changes */
-			if ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes == nil {
-				ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes = make(map[string]bool)
-			}
-			if attributePrefix == "" {
-				ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes[tfAttribute] = true
-			} else {
-				ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes[attributePrefix+"."+tfAttribute] = true
-			}
 
 		} else if tfSchema.Optional {
 			utils.Logf("[INFO] Optional TF attribute '%s' not found in source\n", tfAttribute)
@@ -1229,6 +1318,34 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 	return nil
 }
 
+// getMissingRequiredAttributeValue returns the value set for a required attribute not found in discovery and adds the
+// attribute to lifecycle ignore_changes
+func getMissingRequiredAttributeValue(ociRes *OCIResource, tfAttribute string, attributePrefix string) interface{} {
+	if ociRes.terraformTypeInfo == nil {
+		ociRes.terraformTypeInfo = &TerraformResourceHints{}
+	}
+
+	if ociRes.terraformTypeInfo.defaultValuesForMissingAttributes == nil {
+		ociRes.terraformTypeInfo.defaultValuesForMissingAttributes = make(map[string]interface{})
+	}
+	var tfAttributeVal interface{} = globalvar.PlaceholderValueForMissingAttribute
+	if defaultVal, exists := ociRes.terraformTypeInfo.defaultValuesForMissingAttributes[tfAttribute]; exists {
+		tfAttributeVal = defaultVal
+	}
+	isMissingRequiredAttributes = true
+
+	/* Add missing required attribute to ignorableRequiredMissingAttributes to be generated in lifecycle ignore_changes */
+	if ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes == nil {
+		ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes = make(map[string]bool)
+	}
+	if attributePrefix == "" {
+		ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes[tfAttribute] = true
+	} else {
+		ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes[attributePrefix+"."+tfAttribute] = true
+	}
+	return tfAttributeVal
+}
+
 func (resource *OCIResource) hasFreeformTag(tagKey string) bool {
 	if freeformTags, exists := resource.sourceAttributes["freeform_tags"]; exists {
 		if freeformTagMap, ok := freeformTags.(map[string]interface{}); ok {
@@ -1241,6 +1358,29 @@ func (resource *OCIResource) hasFreeformTag(tagKey string) bool {
 	return false
 }
 
+func (resource *OCIResource) hasFreeformTagValue(tagKey string, tagValue string) bool {
+	if freeformTags, exists := resource.sourceAttributes["freeform_tags"]; exists {
+		if freeformTagMap, ok := freeformTags.(map[string]interface{}); ok {
+			if freeformTagValue, hasFreeFormTag := freeformTagMap[tagKey]; hasFreeFormTag {
+				return freeformTagValue == tagValue
+			}
+		}
+	}
+
+	return false
+}
+
+func (resource *OCIResource) hasDefinedTagKey(tagKey string) bool {
+	if definedTags, exists := resource.sourceAttributes["defined_tags"]; exists {
+		if definedTagMap, ok := definedTags.(map[string]interface{}); ok {
+			_, hasDefinedTag := definedTagMap[tagKey]
+			return hasDefinedTag
+		}
+	}
+
+	return false
+}
+
 func (resource *OCIResource) hasDefinedTag(tagKey string, tagValue string) bool {
 	if definedTags, exists := resource.sourceAttributes["defined_tags"]; exists {
 		if definedTagMap, ok := definedTags.(map[string]interface{}); ok {
@@ -1521,6 +1661,11 @@ func findResourcesGeneric(ctx *resourceDiscoveryContext, tfMeta *TerraformResour
 				resource.terraformName = fmt.Sprintf("%s_%s_%d", parent.terraformName, tfMeta.resourceAbbreviation, idx+1)
 			}
 
+			// Resources not matching the filters are still discovered for their children and references
+			if !tfMeta.alwaysExportable && !ctx.matchesResourceFilters(resource) {
+				resource.omitFromExport = true
+			}
+
 			results = append(results, resource)
 		}
 	} else if d.Id() != "" {
@@ -1546,6 +1691,9 @@ func findResourcesGeneric(ctx *resourceDiscoveryContext, tfMeta *TerraformResour
 		}
 
 		if discoverable {
+			if !tfMeta.alwaysExportable && !ctx.matchesResourceFilters(resource) {
+				resource.omitFromExport = true
+			}
 			results = append(results, resource)
 		}
 	} else {
@@ -1902,7 +2050,7 @@ func createTerraformStruct(args *ExportCommandArgs) (*tfexec.Terraform, string,
 		executableVersion := semver.MajorMinor(inputTfVersion)
 		configVersion := semver.MajorMinor("v" + tfHclVersion.toString())
 
-		if executableVersion < configVersion {
+		if semver.Compare(executableVersion, configVersion) < 0 {
 			return nil, terraformBinPath, fmt.Errorf("[ERROR] major and minor version of terraform CLI provided is not same as the generated configuration version, "+
 				"configuration version: %s, terraform CLI version: %s, please provide CLI version >= %s ", tfHclVersion.toString(), tfVersion.String(), tfHclVersion.toString())
 		}
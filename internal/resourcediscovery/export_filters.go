@@ -0,0 +1,191 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	freeformTagFilterPrefix = "freeform_tag:"
+	definedTagFilterPrefix  = "defined_tag:"
+)
+
+// resourceFilter restricts the exported resources, the filters are specified as
+//
+//	freeform_tag:<key>[=<value>]
+//	defined_tag:<namespace>.<key>[=<value>]
+//	<attribute>=<value>, e.g. lifecycle_state=AVAILABLE
+//	<attribute>~<regex>, e.g. display_name~^billing-
+type resourceFilter struct {
+	filter      string
+	freeformTag bool
+	definedTag  bool
+	attribute   string
+	value       string
+	hasValue    bool
+	pattern     *regexp.Regexp
+}
+
+func parseResourceFilter(filter string) (*resourceFilter, error) {
+	result := &resourceFilter{filter: filter}
+
+	switch {
+	case strings.HasPrefix(filter, freeformTagFilterPrefix):
+		result.freeformTag = true
+		filter = strings.TrimPrefix(filter, freeformTagFilterPrefix)
+	case strings.HasPrefix(filter, definedTagFilterPrefix):
+		result.definedTag = true
+		filter = strings.TrimPrefix(filter, definedTagFilterPrefix)
+	}
+
+	if result.freeformTag || result.definedTag {
+		parts := strings.SplitN(filter, "=", 2)
+		result.attribute = parts[0]
+		if len(parts) == 2 {
+			result.value = parts[1]
+			result.hasValue = true
+		}
+		if result.attribute == "" {
+			return nil, fmt.Errorf("[ERROR] invalid filter '%s', no tag key specified", result.filter)
+		}
+		if result.definedTag && !strings.Contains(result.attribute, ".") {
+			return nil, fmt.Errorf("[ERROR] invalid filter '%s', the defined tag key should be of the form <namespace>.<key>", result.filter)
+		}
+		return result, nil
+	}
+
+	separator := strings.IndexAny(filter, "=~")
+	if separator <= 0 {
+		return nil, fmt.Errorf("[ERROR] invalid filter '%s', supported filters: freeform_tag:<key>[=<value>], defined_tag:<namespace>.<key>[=<value>], <attribute>=<value>, <attribute>~<regex>", result.filter)
+	}
+	result.attribute = filter[:separator]
+	result.value = filter[separator+1:]
+	result.hasValue = true
+	if filter[separator] == '~' {
+		pattern, err := regexp.Compile(result.value)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] invalid filter '%s': %v", result.filter, err)
+		}
+		result.pattern = pattern
+	}
+	return result, nil
+}
+
+func parseResourceFilters(filters []string) ([]*resourceFilter, error) {
+	result := []*resourceFilter{}
+	for _, filter := range filters {
+		if filter == "" {
+			continue
+		}
+		parsed, err := parseResourceFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
+
+func (f *resourceFilter) matches(resource *OCIResource) bool {
+	switch {
+	case f.freeformTag:
+		if !f.hasValue {
+			return resource.hasFreeformTag(f.attribute)
+		}
+		return resource.hasFreeformTagValue(f.attribute, f.value)
+	case f.definedTag:
+		if !f.hasValue {
+			return resource.hasDefinedTagKey(f.attribute)
+		}
+		return resource.hasDefinedTag(f.attribute, f.value)
+	}
+
+	value, exists := resource.getFilterAttribute(f.attribute)
+	if !exists {
+		return false
+	}
+	if f.pattern != nil {
+		return f.pattern.MatchString(value)
+	}
+	// The lifecycle states are matched like discoverableLifecycleStates
+	return strings.EqualFold(value, f.value)
+}
+
+// getFilterAttribute returns the value of the attribute of the resource, lifecycle_state is the state attribute and
+// display_name falls back to the name of the resources that have no display name
+func (resource *OCIResource) getFilterAttribute(attribute string) (string, bool) {
+	candidates := []string{attribute}
+	switch attribute {
+	case "lifecycle_state":
+		candidates = append(candidates, "state")
+	case "display_name":
+		candidates = append(candidates, "name")
+	}
+
+	for _, candidate := range candidates {
+		if value, exists := resource.sourceAttributes[candidate]; exists && value != nil {
+			switch v := value.(type) {
+			case map[string]interface{}, []interface{}:
+				continue
+			default:
+				return fmt.Sprintf("%v", v), true
+			}
+		}
+	}
+	return "", false
+}
+
+// matchesResourceFilters returns true if the resource matches all of the filters of the export command
+func (ctx *resourceDiscoveryContext) matchesResourceFilters(resource *OCIResource) bool {
+	for _, filter := range ctx.resourceFilters {
+		if !filter.matches(resource) {
+			utils.Debugf("[DEBUG] resource '%s' does not match filter '%s'", resource.getTerraformReference(), filter.filter)
+			return false
+		}
+	}
+	return true
+}
+
+// addFilteredResourceReferences adds a variable for each resource omitted by the filters that is referenced by an
+// exported resource, the exported resources reference the variable instead of the OCID of the omitted resource
+func addFilteredResourceReferences(steps []resourceDiscoveryStep) {
+	referencedValues := map[string]bool{}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			collectReferencedValues(resource.sourceAttributes, referencedValues)
+		}
+	}
+
+	for _, step := range steps {
+		for _, resource := range step.getOmittedResources() {
+			if _, exists := referenceMap[resource.id]; exists || !referencedValues[resource.id] {
+				continue
+			}
+			varName := getModuleOutputName(resource.getHclReferenceIdString())
+			utils.Logf("[INFO] resource '%s' is omitted by the filters, referencing it with variable '%s'", resource.getTerraformReference(), varName)
+			vars[varName] = fmt.Sprintf("\"%s\"", resource.id)
+			referenceMap[resource.id] = tfHclVersion.getVarHclString(varName)
+		}
+	}
+}
+
+func collectReferencedValues(value interface{}, result map[string]bool) {
+	switch v := value.(type) {
+	case string:
+		result[v] = true
+	case map[string]interface{}:
+		for _, item := range v {
+			collectReferencedValues(item, result)
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectReferencedValues(item, result)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitResourceFilter_matches(t *testing.T) {
+	resource := &OCIResource{
+		sourceAttributes: map[string]interface{}{
+			"display_name":  "billing-db",
+			"state":         "AVAILABLE",
+			"freeform_tags": map[string]interface{}{"app": "billing"},
+			"defined_tags":  map[string]interface{}{"Operations.CostCenter": "42"},
+		},
+	}
+
+	type testCase struct {
+		filter  string
+		matches bool
+	}
+	for _, test := range []testCase{
+		{"freeform_tag:app", true},
+		{"freeform_tag:app=billing", true},
+		{"freeform_tag:app=payroll", false},
+		{"freeform_tag:team", false},
+		{"defined_tag:Operations.CostCenter=42", true},
+		{"defined_tag:Operations.CostCenter", true},
+		{"defined_tag:Operations.Owner", false},
+		{"display_name~^billing-", true},
+		{"display_name~^payroll-", false},
+		{"lifecycle_state=available", true},
+		{"lifecycle_state=TERMINATED", false},
+		{"description=billing", false},
+	} {
+		filter, err := parseResourceFilter(test.filter)
+		assert.NoError(t, err, test.filter)
+		assert.Equal(t, test.matches, filter.matches(resource), test.filter)
+	}
+
+	// The resources without a display name are matched by name
+	filter, err := parseResourceFilter("display_name=bucket")
+	assert.NoError(t, err)
+	assert.True(t, filter.matches(&OCIResource{sourceAttributes: map[string]interface{}{"name": "bucket"}}))
+
+	for _, invalid := range []string{"display_name", "=value", "freeform_tag:", "defined_tag:CostCenter=42", "display_name~("} {
+		_, err := parseResourceFilter(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFindResources_filters(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	filters, err := parseResourceFilters([]string{"a_string=string1"})
+	assert.NoError(t, err)
+	ctx := &resourceDiscoveryContext{
+		errorList:       ErrorList{},
+		resourceFilters: filters,
+	}
+	results, err := findResources(ctx, getRootCompartmentResource(), compartmentTestingResourceGraph)
+	assert.NoError(t, err)
+
+	// The resources not matching the filters are discovered but omitted, the parents are always exportable
+	assert.Equal(t, len(childrenResources)+len(parentResources), len(results))
+	exportedChildren := 0
+	for _, resource := range results {
+		switch resource.terraformClass {
+		case "oci_test_parent":
+			assert.False(t, resource.omitFromExport)
+		case "oci_test_child":
+			assert.Equal(t, resource.sourceAttributes["a_string"] != "string1", resource.omitFromExport)
+			if !resource.omitFromExport {
+				exportedChildren++
+			}
+		}
+	}
+	assert.Equal(t, 2, exportedChildren)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAddFilteredResourceReferences(t *testing.T) {
+	tfHclVersion = &TfHclVersion12{}
+	defer func() {
+		vars = map[string]string{}
+		referenceMap = map[string]string{}
+	}()
+	vars = map[string]string{}
+	referenceMap = map[string]string{}
+
+	vcn := &OCIResource{TerraformResource: TerraformResource{id: "ocid1.vcn.prod", terraformClass: "oci_core_vcn", terraformName: "export_prod", omitFromExport: true}}
+	routeTable := &OCIResource{TerraformResource: TerraformResource{id: "ocid1.routetable.prod", terraformClass: "oci_core_route_table", terraformName: "export_prod", omitFromExport: true}}
+	subnet := &OCIResource{
+		sourceAttributes: map[string]interface{}{
+			"vcn_id":         "ocid1.vcn.prod",
+			"compartment_id": resourceDiscoveryTestCompartmentOcid,
+		},
+		TerraformResource: TerraformResource{id: "ocid1.subnet.billing", terraformClass: "oci_core_subnet", terraformName: "export_billing"},
+	}
+	step := &resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			discoveredResources: []*OCIResource{subnet},
+			omittedResources:    []*OCIResource{vcn, routeTable},
+		},
+	}
+
+	addFilteredResourceReferences([]resourceDiscoveryStep{step})
+
+	// Only the omitted resources referenced by the exported resources are added as variables
+	assert.Equal(t, map[string]string{"core_vcn_export_prod_id": "\"ocid1.vcn.prod\""}, vars)
+	assert.Equal(t, map[string]string{"ocid1.vcn.prod": "var.core_vcn_export_prod_id"}, referenceMap)
+}
@@ -0,0 +1,493 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	incrementalStepName = "incremental"
+	incrementalVarsFile = "incremental_vars.tf"
+	driftReportFile     = "drift_report.json"
+)
+
+var (
+	hclResourceBlockRegex = regexp.MustCompile(`(?m)^\s*resource\s+"?([\w-]+)"?\s+"?([\w-]+)"?\s*\{`)
+	hclDataBlockRegex     = regexp.MustCompile(`(?m)^\s*data\s+"?([\w-]+)"?\s+"?([\w-]+)"?\s*\{`)
+	hclVariableBlockRegex = regexp.MustCompile(`(?m)^\s*variable\s+"?([\w-]+)"?`)
+	hclImportBlockRegex   = regexp.MustCompile(`import\s*\{\s*to\s*=\s*([\w-]+)\.([\w-]+)\s+id\s*=\s*"([^"]*)"\s*\}`)
+)
+
+// previousResource is a resource of the previous export, found in its state or in the import blocks of its configuration
+type previousResource struct {
+	terraformClass string
+	terraformName  string
+	attributes     map[string]interface{} // attributes in the state, nil for the resources only found in the configuration
+}
+
+func (r *previousResource) getTerraformReference() string {
+	return fmt.Sprintf("%s.%s", r.terraformClass, r.terraformName)
+}
+
+// previousExport is the output of a previous export in the output directory, the incremental export keeps the names
+// of its resources and only writes the configuration of the new resources and of the resources adopted by a previous
+// incremental export
+type previousExport struct {
+	lock                 sync.Mutex
+	resources            map[string]*previousResource // keyed by the OCID of the resource
+	addresses            map[string]bool              // addresses declared in the previous configuration or state, data.<type>.<name> for the data sources
+	incrementalAddresses map[string]bool              // addresses declared in the files of a previous incremental export
+	variables            map[string]bool
+	discoveredIds        map[string]bool
+}
+
+type driftReport struct {
+	New     []*driftedResource `json:"new"`
+	Removed []*driftedResource `json:"removed"`
+	Changed []*driftedResource `json:"changed"`
+}
+
+type driftedResource struct {
+	Address    string            `json:"address"`
+	Id         string            `json:"id"`
+	Attributes []*driftAttribute `json:"attributes,omitempty"`
+}
+
+type driftAttribute struct {
+	Name     string      `json:"name"`
+	Previous interface{} `json:"previous"`
+	Current  interface{} `json:"current"`
+}
+
+type previousState struct {
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func isIncrementalFile(name string) bool {
+	for _, fileName := range []string{incrementalStepName + ".tf", incrementalVarsFile} {
+		if name == fileName || name == fileName+".json" {
+			return true
+		}
+	}
+	return false
+}
+
+// loadPreviousExport reads the state and the configuration files of the previous export in outputDir. The state is
+// optional, the configuration and its import blocks are used without it, e.g. for the exports with tf_version 1.5
+func loadPreviousExport(outputDir string) (*previousExport, error) {
+	result := &previousExport{
+		resources:            map[string]*previousResource{},
+		addresses:            map[string]bool{},
+		incrementalAddresses: map[string]bool{},
+		variables:            map[string]bool{},
+		discoveredIds:        map[string]bool{},
+	}
+
+	if err := result.loadState(filepath.Join(outputDir, globalvar.DefaultStateFilename)); err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(outputDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		path := filepath.Join(outputDir, file.Name())
+		switch {
+		case strings.HasSuffix(file.Name(), ".tf"):
+			err = result.loadHclConfiguration(path, isIncrementalFile(file.Name()))
+		case strings.HasSuffix(file.Name(), ".tf.json"):
+			err = result.loadJsonConfiguration(path, isIncrementalFile(file.Name()))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	utils.Logf("[INFO] incremental export: found %d resources of the previous export in %s", len(result.resources), outputDir)
+	return result, nil
+}
+
+func (p *previousExport) loadState(statePath string) error {
+	stateBytes, err := ioutil.ReadFile(statePath)
+	if os.IsNotExist(err) {
+		utils.Logf("[INFO] incremental export: %s not found, the resources of the previous export are read from its configuration and import blocks", statePath)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] unable to read the state file %s: %v", statePath, err)
+	}
+	state := &previousState{}
+	if err := json.Unmarshal(stateBytes, state); err != nil {
+		return fmt.Errorf("[ERROR] unable to parse the state file %s: %v", statePath, err)
+	}
+	for _, resource := range state.Resources {
+		if resource.Module != "" || len(resource.Instances) == 0 {
+			continue
+		}
+		if resource.Mode == "data" {
+			p.addresses[getDataSourceAddress(resource.Type, resource.Name)] = true
+			continue
+		}
+		if resource.Mode != "managed" {
+			continue
+		}
+		attributes := resource.Instances[0].Attributes
+		id, _ := attributes["id"].(string)
+		if id == "" {
+			continue
+		}
+		previous := &previousResource{terraformClass: resource.Type, terraformName: resource.Name, attributes: attributes}
+		p.resources[id] = previous
+		p.addresses[previous.getTerraformReference()] = true
+	}
+	return nil
+}
+
+// addConfiguredAddress records an address of the configuration. The addresses declared only in the files of a previous
+// incremental export are not reserved, these files are regenerated and the resources found again get their name back
+func (p *previousExport) addConfiguredAddress(address string, incremental bool) {
+	if incremental {
+		p.incrementalAddresses[address] = true
+	} else {
+		p.addresses[address] = true
+	}
+}
+
+func (p *previousExport) addConfiguredResource(terraformClass string, terraformName string, id string, incremental bool) {
+	address := fmt.Sprintf("%s.%s", terraformClass, terraformName)
+	p.addConfiguredAddress(address, incremental)
+	if id == "" {
+		return
+	}
+	// The import blocks map the resources that were not imported to the state yet, they keep their name
+	p.addresses[address] = true
+	if _, exists := p.resources[id]; !exists {
+		p.resources[id] = &previousResource{terraformClass: terraformClass, terraformName: terraformName}
+	}
+}
+
+// loadHclConfiguration reads the addresses and the variables of a configuration file, the variables of a previous
+// incremental export are not read since incremental_vars.tf is regenerated
+func (p *previousExport) loadHclConfiguration(path string, incremental bool) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	for _, match := range hclResourceBlockRegex.FindAllStringSubmatch(string(content), -1) {
+		p.addConfiguredResource(match[1], match[2], "", incremental)
+	}
+	for _, match := range hclDataBlockRegex.FindAllStringSubmatch(string(content), -1) {
+		p.addConfiguredAddress(getDataSourceAddress(match[1], match[2]), incremental)
+	}
+	for _, match := range hclImportBlockRegex.FindAllStringSubmatch(string(content), -1) {
+		p.addConfiguredResource(match[1], match[2], match[3], incremental)
+	}
+	if !incremental {
+		for _, match := range hclVariableBlockRegex.FindAllStringSubmatch(string(content), -1) {
+			p.variables[match[1]] = true
+		}
+	}
+	return nil
+}
+
+func (p *previousExport) loadJsonConfiguration(path string, incremental bool) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	config := &jsonConfiguration{}
+	if err := json.Unmarshal(content, config); err != nil {
+		return fmt.Errorf("[ERROR] unable to parse the configuration file %s: %v", path, err)
+	}
+	for terraformClass, resources := range config.Resource {
+		for terraformName := range resources {
+			p.addConfiguredResource(terraformClass, terraformName, "", incremental)
+		}
+	}
+	for terraformClass, dataSources := range config.Data {
+		for terraformName := range dataSources {
+			p.addConfiguredAddress(getDataSourceAddress(terraformClass, terraformName), incremental)
+		}
+	}
+	for _, importBlock := range config.Import {
+		if parts := strings.SplitN(importBlock["to"], ".", 2); len(parts) == 2 {
+			p.addConfiguredResource(parts[0], parts[1], importBlock["id"], incremental)
+		}
+	}
+	if !incremental {
+		for variable := range config.Variable {
+			p.variables[variable] = true
+		}
+	}
+	return nil
+}
+
+func getDataSourceAddress(terraformClass string, terraformName string) string {
+	return fmt.Sprintf("data.%s.%s", terraformClass, terraformName)
+}
+
+func isDataSourceResource(resource *OCIResource) bool {
+	return resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource
+}
+
+// getPreviousAddress returns the address of the resource in the configuration of the previous export
+func getPreviousAddress(resource *OCIResource) string {
+	if isDataSourceResource(resource) {
+		return getDataSourceAddress(resource.terraformClass, resource.terraformName)
+	}
+	return resource.getTerraformReference()
+}
+
+// reserveNames adds the names of the previous export to resourceNameCount, the new resources are not given the name of
+// an existing resource
+func (p *previousExport) reserveNames() {
+	resourceNameCountLock.Lock()
+	defer resourceNameCountLock.Unlock()
+	for address := range p.addresses {
+		name := address[strings.LastIndex(address, ".")+1:]
+		if _, exists := resourceNameCount[name]; !exists {
+			resourceNameCount[name] = 1
+		}
+	}
+}
+
+// applyPreviousName gives a rediscovered resource its name in the previous export, new resources are renamed if their
+// generated name is already used by the previous export
+func (p *previousExport) applyPreviousName(resource *OCIResource) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.discoveredIds[resource.id] = true
+
+	// The data sources are named after what they read, an existing data source is not declared again
+	if isDataSourceResource(resource) {
+		return
+	}
+
+	if previous, exists := p.resources[resource.id]; exists && previous.terraformClass == resource.terraformClass {
+		resource.terraformName = previous.terraformName
+		return
+	}
+
+	terraformName := resource.terraformName
+	for i := 1; p.addresses[resource.getTerraformReference()]; i++ {
+		resource.terraformName = fmt.Sprintf("%s_%d", terraformName, i)
+	}
+	p.addresses[resource.getTerraformReference()] = true
+}
+
+func (p *previousExport) isNewResource(resource *OCIResource) bool {
+	if isDataSourceResource(resource) {
+		address := getPreviousAddress(resource)
+		return !p.addresses[address] && !p.incrementalAddresses[address]
+	}
+	previous, exists := p.resources[resource.id]
+	return !exists || previous.terraformClass != resource.terraformClass
+}
+
+// getNewVariables returns the variables that are not declared by the configuration of the previous export
+func (p *previousExport) getNewVariables(vars map[string]string) map[string]string {
+	result := map[string]string{}
+	for variable, defaultVal := range vars {
+		if !p.variables[variable] {
+			result[variable] = defaultVal
+		}
+	}
+	return result
+}
+
+// getDriftReport compares the discovered resources to the previous export, the previous resources are reported as
+// removed only if their resource type is discovered by the steps
+func (p *previousExport) getDriftReport(steps []resourceDiscoveryStep) *driftReport {
+	report := &driftReport{New: []*driftedResource{}, Removed: []*driftedResource{}, Changed: []*driftedResource{}}
+
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			if p.isNewResource(resource) {
+				report.New = append(report.New, &driftedResource{Address: resource.getTerraformReference(), Id: resource.id})
+				continue
+			}
+			previous, exists := p.resources[resource.id]
+			if !exists {
+				continue
+			}
+			if attributes := getChangedAttributes(resource, previous.attributes); len(attributes) > 0 {
+				report.Changed = append(report.Changed, &driftedResource{Address: resource.getTerraformReference(), Id: resource.id, Attributes: attributes})
+			}
+		}
+	}
+
+	discoveredClasses := getStepResourceClasses(steps)
+	for id, previous := range p.resources {
+		if !p.discoveredIds[id] && discoveredClasses[previous.terraformClass] {
+			report.Removed = append(report.Removed, &driftedResource{Address: previous.getTerraformReference(), Id: id})
+		}
+	}
+
+	for _, resources := range [][]*driftedResource{report.New, report.Removed, report.Changed} {
+		sort.Slice(resources, func(i, j int) bool {
+			return resources[i].Address < resources[j].Address
+		})
+	}
+	return report
+}
+
+// isAdoptedResource returns true if the resource is declared in the files of a previous incremental export
+func (p *previousExport) isAdoptedResource(resource *OCIResource) bool {
+	return p.incrementalAddresses[getPreviousAddress(resource)]
+}
+
+// getIncrementalStep writes the drift report and returns the step writing the configuration of the new resources, the
+// resources adopted by the previous incremental exports are written again so they are kept in the regenerated files
+func (p *previousExport) getIncrementalStep(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) (resourceDiscoveryStep, error) {
+	report := p.getDriftReport(steps)
+
+	newResources := []*OCIResource{}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			if p.isNewResource(resource) || p.isAdoptedResource(resource) {
+				newResources = append(newResources, resource)
+			}
+		}
+	}
+
+	removeStaleIncrementalFiles(*ctx.OutputDir)
+
+	reportBytes, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	reportPath := filepath.Join(*ctx.OutputDir, driftReportFile)
+	if err := ioutil.WriteFile(reportPath, reportBytes, 0644); err != nil {
+		return nil, fmt.Errorf("[ERROR] error writing drift report at %s: %s", reportPath, err.Error())
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("Incremental export: %d new, %d removed and %d changed resources. Drift report written to '%s'",
+		len(report.New), len(report.Removed), len(report.Changed), reportPath))
+
+	return &resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			ctx:                 ctx,
+			name:                incrementalStepName,
+			discoveredResources: newResources,
+			omittedResources:    []*OCIResource{},
+		},
+	}, nil
+}
+
+func getStepResourceClasses(steps []resourceDiscoveryStep) map[string]bool {
+	result := map[string]bool{}
+	for _, step := range steps {
+		if graphStep, ok := step.(*resourceDiscoveryWithGraph); ok {
+			for _, associations := range graphStep.resourceGraph {
+				for _, association := range associations {
+					result[association.resourceClass] = true
+				}
+			}
+		}
+	}
+	return result
+}
+
+// getChangedAttributes compares the configurable attributes of the resource to its attributes in the previous state,
+// the attributes that were not discovered are not compared
+func getChangedAttributes(resource *OCIResource, previousAttributes map[string]interface{}) []*driftAttribute {
+	resourceSchema, exists := resourcesMap[resource.terraformClass]
+	if !exists || previousAttributes == nil {
+		return nil
+	}
+
+	current := normalizeAttributes(resource.sourceAttributes)
+	previous := normalizeAttributes(previousAttributes)
+
+	attributes := make([]string, 0, len(resourceSchema.Schema))
+	for attribute := range resourceSchema.Schema {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	result := []*driftAttribute{}
+	for _, attribute := range attributes {
+		tfSchema := resourceSchema.Schema[attribute]
+		if tfSchema.Deprecated != "" || tfSchema.Removed != "" || (!tfSchema.Required && !tfSchema.Optional) {
+			continue
+		}
+		currentVal, discovered := current[attribute]
+		if !discovered {
+			continue
+		}
+		previousVal := previous[attribute]
+		if isEmptyAttributeValue(currentVal) && isEmptyAttributeValue(previousVal) {
+			continue
+		}
+		if !reflect.DeepEqual(currentVal, previousVal) {
+			result = append(result, &driftAttribute{Name: attribute, Previous: previousVal, Current: currentVal})
+		}
+	}
+	return result
+}
+
+// normalizeAttributes converts the attributes to their JSON types, e.g. the numbers are float64 as in the state
+func normalizeAttributes(attributes map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	content, err := json.Marshal(attributes)
+	if err != nil {
+		return attributes
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		return attributes
+	}
+	return result
+}
+
+func isEmptyAttributeValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// removeStaleIncrementalFiles removes the files written by a previous incremental export in the other output format
+func removeStaleIncrementalFiles(outputDir string) {
+	for _, fileName := range []string{incrementalStepName + ".tf", incrementalVarsFile} {
+		stale := fileName + ".json"
+		if exportOutputFormat == OutputFormatJson {
+			stale = fileName
+		}
+		if err := os.Remove(filepath.Join(outputDir, stale)); err != nil && !os.IsNotExist(err) {
+			utils.Logf("[WARN] unable to remove %s: %v", stale, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+const incrementalTestState = `{
+	"version": 4,
+	"resources": [
+		{
+			"mode": "managed",
+			"type": "oci_test_child",
+			"name": "kept_child",
+			"instances": [{"attributes": {"id": "ocid1.child.abcdefghiklmnop.0", "a_string": "string0", "a_int": 0}}]
+		},
+		{
+			"mode": "managed",
+			"type": "oci_test_child",
+			"name": "removed_child",
+			"instances": [{"attributes": {"id": "ocid1.child.removed", "a_string": "string0"}}]
+		},
+		{
+			"mode": "data",
+			"type": "oci_identity_availability_domain",
+			"name": "export_ad",
+			"instances": [{"attributes": {"id": "ocid1.ad.abc"}}]
+		}
+	]
+}`
+
+const incrementalTestConfig = `resource oci_test_child kept_child {
+	a_string = "string0"
+}
+
+resource oci_test_child export_parent1_child_1 {
+	a_string = "hand added"
+}
+
+import {
+	to = oci_test_child.imported_child
+	id = "ocid1.child.abcdefghiklmnop.2"
+}
+
+data oci_identity_availability_domain export_ad {
+	ad_number = 1
+}
+
+variable compartment_ocid { default = "ocid1.testcompartment.abc" }
+`
+
+// issue-routing-tag: terraform/default
+func TestUnitLoadPreviousExport(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "discoveryIncrementalTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	// Without a state, e.g. with tf_version 1.5, the resources are read from the configuration and its import blocks
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "test.tf"), []byte(incrementalTestConfig), 0644))
+	previous, err := loadPreviousExport(outputDir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(previous.resources))
+	assert.Equal(t, "oci_test_child.imported_child", previous.resources["ocid1.child.abcdefghiklmnop.2"].getTerraformReference())
+	assert.True(t, previous.addresses["oci_test_child.kept_child"])
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, globalvar.DefaultStateFilename), []byte(incrementalTestState), 0644))
+	// The resources adopted by a previous incremental export are read, its variables are regenerated
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "incremental.tf"), []byte(`resource oci_test_child new_child {}
+
+resource oci_test_child new_imported_child {}
+
+import {
+	to = oci_test_child.new_imported_child
+	id = "ocid1.child.new"
+}
+
+data oci_identity_availability_domain export_new_ad {}
+`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, incrementalVarsFile), []byte("variable region { default = \"us-phoenix-1\" }\n"), 0644))
+
+	previous, err = loadPreviousExport(outputDir)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(previous.resources))
+	assert.Equal(t, "oci_test_child.kept_child", previous.resources["ocid1.child.abcdefghiklmnop.0"].getTerraformReference())
+	assert.Equal(t, "oci_test_child.imported_child", previous.resources["ocid1.child.abcdefghiklmnop.2"].getTerraformReference())
+	assert.Nil(t, previous.resources["ocid1.child.abcdefghiklmnop.2"].attributes)
+	assert.Equal(t, "oci_test_child.new_imported_child", previous.resources["ocid1.child.new"].getTerraformReference())
+	assert.True(t, previous.addresses["oci_test_child.export_parent1_child_1"])
+	assert.True(t, previous.addresses["data.oci_identity_availability_domain.export_ad"])
+	assert.True(t, previous.addresses["oci_test_child.new_imported_child"])
+	assert.True(t, previous.incrementalAddresses["oci_test_child.new_child"])
+	assert.True(t, previous.incrementalAddresses["data.oci_identity_availability_domain.export_new_ad"])
+	// The names only declared in incremental.tf are not reserved
+	assert.False(t, previous.addresses["oci_test_child.new_child"])
+	assert.Equal(t, map[string]bool{"compartment_ocid": true}, previous.variables)
+	assert.Equal(t, map[string]string{"region": "\"us-phoenix-1\""},
+		previous.getNewVariables(map[string]string{"compartment_ocid": "\"ocid1.testcompartment.abc\"", "region": "\"us-phoenix-1\""}))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitPreviousExport_getDriftReport(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	outputDir, err := ioutil.TempDir("", "discoveryIncrementalTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, globalvar.DefaultStateFilename), []byte(incrementalTestState), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "test.tf"), []byte(incrementalTestConfig), 0644))
+
+	previous, err := loadPreviousExport(outputDir)
+	assert.NoError(t, err)
+	ctx := &resourceDiscoveryContext{
+		errorList:         ErrorList{},
+		previousExport:    previous,
+		ExportCommandArgs: &ExportCommandArgs{OutputDir: &outputDir},
+	}
+
+	step := &resourceDiscoveryWithGraph{
+		root:                      getRootCompartmentResource(),
+		resourceGraph:             compartmentTestingResourceGraph,
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: "testing", ctx: ctx},
+	}
+	assert.NoError(t, step.discover())
+
+	names := map[string]string{}
+	for _, resource := range step.getDiscoveredResources() {
+		names[resource.id] = resource.terraformName
+	}
+	// The rediscovered resources keep their names and the new resources do not reuse the names of the previous export
+	assert.Equal(t, "kept_child", names["ocid1.child.abcdefghiklmnop.0"])
+	assert.Equal(t, "imported_child", names["ocid1.child.abcdefghiklmnop.2"])
+	for id, name := range names {
+		if id != "ocid1.child.abcdefghiklmnop.0" {
+			assert.NotEqual(t, "kept_child", name)
+		}
+		assert.NotEqual(t, "export_parent1_child_1", name)
+	}
+
+	incrementalStep, err := previous.getIncrementalStep(ctx, []resourceDiscoveryStep{step})
+	assert.NoError(t, err)
+	assert.Equal(t, len(step.getDiscoveredResources())-2, len(incrementalStep.getDiscoveredResources()))
+	for _, resource := range incrementalStep.getDiscoveredResources() {
+		assert.True(t, previous.isNewResource(resource))
+	}
+
+	reportBytes, err := ioutil.ReadFile(filepath.Join(outputDir, driftReportFile))
+	assert.NoError(t, err)
+	report := &driftReport{}
+	assert.NoError(t, json.Unmarshal(reportBytes, report))
+	assert.Equal(t, len(incrementalStep.getDiscoveredResources()), len(report.New))
+	assert.Equal(t, []*driftedResource{{Address: "oci_test_child.removed_child", Id: "ocid1.child.removed"}}, report.Removed)
+
+	// The attributes of the kept child differ from the state, the imported child has no state to compare to
+	assert.Equal(t, 1, len(report.Changed))
+	assert.Equal(t, "oci_test_child.kept_child", report.Changed[0].Address)
+	changedAttributes := map[string]bool{}
+	for _, attribute := range report.Changed[0].Attributes {
+		changedAttributes[attribute.Name] = true
+	}
+	assert.False(t, changedAttributes["a_string"])
+	assert.False(t, changedAttributes["a_int"])
+	assert.True(t, changedAttributes["a_bool"])
+
+	// The data sources declared by the previous export are not written again
+	availabilityDomain := &OCIResource{
+		TerraformResource: TerraformResource{
+			id:                "ocid1.ad.abc",
+			terraformClass:    "oci_identity_availability_domain",
+			terraformName:     "export_ad",
+			terraformTypeInfo: exportIdentityAvailabilityDomainHints,
+		},
+	}
+	previous.applyPreviousName(availabilityDomain)
+	assert.Equal(t, "export_ad", availabilityDomain.terraformName)
+	assert.False(t, previous.isNewResource(availabilityDomain))
+	availabilityDomain.terraformName = "export_ad_2"
+	assert.True(t, previous.isNewResource(availabilityDomain))
+}
+
+// runTestIncrementalExport discovers the testing resources against the export in outputDir and writes incremental.tf,
+// it returns the names of the resources written to incremental.tf
+func runTestIncrementalExport(t *testing.T, outputDir string) map[string]string {
+	resourceNameCount = map[string]int{}
+	previous, err := loadPreviousExport(outputDir)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	previous.reserveNames()
+	ctx := &resourceDiscoveryContext{
+		errorList:         ErrorList{},
+		previousExport:    previous,
+		ExportCommandArgs: &ExportCommandArgs{OutputDir: &outputDir},
+	}
+
+	step := &resourceDiscoveryWithGraph{
+		root:                      getRootCompartmentResource(),
+		resourceGraph:             compartmentTestingResourceGraph,
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: "testing", ctx: ctx},
+	}
+	assert.NoError(t, step.discover())
+	incrementalStep, err := previous.getIncrementalStep(ctx, []resourceDiscoveryStep{step})
+	assert.NoError(t, err)
+	assert.NoError(t, incrementalStep.writeConfiguration())
+
+	names := map[string]string{}
+	for _, resource := range incrementalStep.getDiscoveredResources() {
+		names[resource.id] = resource.terraformName
+	}
+	return names
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitIncrementalExport_twice(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	defer func(version TfHclVersion) { tfHclVersion = version }(tfHclVersion)
+	// The previous export was generated with tf_version 1.5, it has import blocks and no state
+	tfHclVersion = &TfHclVersion15{TfHclVersion12{Value: TfVersion15}}
+
+	outputDir, err := ioutil.TempDir("", "discoveryIncrementalTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "test.tf"), []byte(incrementalTestConfig), 0644))
+
+	first := runTestIncrementalExport(t, outputDir)
+	assert.NotEmpty(t, first)
+	_, imported := first["ocid1.child.abcdefghiklmnop.2"]
+	assert.False(t, imported)
+	content, err := ioutil.ReadFile(filepath.Join(outputDir, incrementalStepName+".tf"))
+	assert.NoError(t, err)
+	assert.Equal(t, len(first), len(hclImportBlockRegex.FindAllStringSubmatch(string(content), -1)))
+
+	// The resources adopted by the first run are found in incremental.tf, they are written again with the same names
+	second := runTestIncrementalExport(t, outputDir)
+	assert.Equal(t, first, second)
+	content, err = ioutil.ReadFile(filepath.Join(outputDir, incrementalStepName+".tf"))
+	assert.NoError(t, err)
+	assert.Equal(t, len(first), len(hclResourceBlockRegex.FindAllStringSubmatch(string(content), -1)))
+	for _, match := range hclImportBlockRegex.FindAllStringSubmatch(string(content), -1) {
+		assert.Equal(t, first[match[3]], match[2])
+	}
+
+	reportBytes, err := ioutil.ReadFile(filepath.Join(outputDir, driftReportFile))
+	assert.NoError(t, err)
+	report := &driftReport{}
+	assert.NoError(t, json.Unmarshal(reportBytes, report))
+	assert.Empty(t, report.New)
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+type OutputFormatEnum string
+
+// Set of constants representing the allowed values for OutputFormatEnum
+const (
+	OutputFormatHcl  OutputFormatEnum = "hcl"
+	OutputFormatJson OutputFormatEnum = "json"
+)
+
+const jsonConfigurationComment = "This configuration was generated by terraform-provider-oci"
+
+var exportOutputFormat = OutputFormatHcl
+
+// getConfigFileName returns the name of a generated configuration file, e.g. core.tf or core.tf.json
+func getConfigFileName(name string) string {
+	if exportOutputFormat == OutputFormatJson {
+		return name + ".json"
+	}
+	return name
+}
+
+// getJsonExpression converts an HCL interpolation, e.g. oci_core_vcn.export_vcn.id or "${var.region}", to the template
+// string of the Terraform JSON syntax
+func getJsonExpression(interpolation string) string {
+	return fmt.Sprintf("${%s}", trimHclReference(interpolation))
+}
+
+// jsonConfiguration is the Terraform JSON syntax of a configuration file, the blocks are keyed by type and name
+type jsonConfiguration struct {
+	Comment  string                                       `json:"//,omitempty"`
+	Data     map[string]map[string]map[string]interface{} `json:"data,omitempty"`
+	Resource map[string]map[string]map[string]interface{} `json:"resource,omitempty"`
+	Import   []map[string]string                          `json:"import,omitempty"`
+	Variable map[string]map[string]interface{}            `json:"variable,omitempty"`
+	Provider map[string]map[string]interface{}            `json:"provider,omitempty"`
+	Module   map[string]map[string]interface{}            `json:"module,omitempty"`
+	Output   map[string]map[string]interface{}            `json:"output,omitempty"`
+}
+
+func (c *jsonConfiguration) marshal() ([]byte, error) {
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+func addJsonBlock(blocks *map[string]map[string]map[string]interface{}, blockType string, name string, body map[string]interface{}) {
+	if *blocks == nil {
+		*blocks = map[string]map[string]map[string]interface{}{}
+	}
+	if (*blocks)[blockType] == nil {
+		(*blocks)[blockType] = map[string]map[string]interface{}{}
+	}
+	(*blocks)[blockType][name] = body
+}
+
+func (c *jsonConfiguration) addResource(ociRes *OCIResource, interpolationMap map[string]string) error {
+	// Remove any potential cyclical references from the interpolation map
+	selfReference := ociRes.getTerraformReference()
+	resourceInterpolationMap := map[string]string{}
+	for value, interpolation := range interpolationMap {
+		if !strings.Contains(interpolation, selfReference) {
+			resourceInterpolationMap[value] = interpolation
+		}
+	}
+
+	if ociRes.terraformTypeInfo != nil && ociRes.terraformTypeInfo.getJSONOverrideFn != nil {
+		body, err := ociRes.terraformTypeInfo.getJSONOverrideFn(ociRes, resourceInterpolationMap)
+		if err != nil {
+			return err
+		}
+		addJsonBlock(&c.Data, ociRes.terraformClass, ociRes.terraformName, body)
+		return nil
+	}
+
+	body, err := getJSONObjectFromMap(ociRes.sourceAttributes, resourcesMap[ociRes.terraformClass], resourceInterpolationMap, ociRes, "")
+	if err != nil {
+		return err
+	}
+
+	if ociRes.terraformTypeInfo != nil && len(ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes) > 0 {
+		missingAttributes := make([]string, 0, len(ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes))
+		for attribute := range ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes {
+			missingAttributes = append(missingAttributes, attribute)
+		}
+		sort.Strings(missingAttributes)
+		body["lifecycle"] = map[string]interface{}{
+			"//":             "Required attributes that were not found in discovery have been added to lifecycle ignore_changes",
+			"ignore_changes": missingAttributes,
+		}
+	}
+	addJsonBlock(&c.Resource, ociRes.terraformClass, ociRes.terraformName, body)
+
+	// The import block adopts the resource on `terraform plan`, it is only generated for the resources that support import
+	if generatesImportBlocks(tfHclVersion) {
+		if resourceDefinition, exists := resourcesMap[ociRes.terraformClass]; exists && resourceDefinition.Importer != nil {
+			c.Import = append(c.Import, map[string]string{
+				"to": ociRes.getTerraformReference(),
+				"id": escapeTFStrings(ociRes.getImportId()),
+			})
+		}
+	}
+	return nil
+}
+
+// getJSONObjectFromMap is the Terraform JSON counterpart of getHCLStringFromMap, the nested blocks are lists of objects
+func getJSONObjectFromMap(sourceAttributes map[string]interface{}, resourceSchema *schema.Resource, interpolationMap map[string]string, ociRes *OCIResource, attributePrefix string) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	for tfAttribute, tfSchema := range resourceSchema.Schema {
+		if tfSchema.Deprecated != "" || tfSchema.Removed != "" || (!tfSchema.Required && !tfSchema.Optional) {
+			continue
+		}
+
+		if attributeVal, exists := sourceAttributes[tfAttribute]; exists {
+			switch v := attributeVal.(type) {
+			case InterpolationString, string, int, bool, float64:
+				result[tfAttribute] = getJSONValue(v, interpolationMap)
+				continue
+			case []interface{}:
+				switch tfSchema.Type {
+				case schema.TypeString:
+					if tfAttribute == "delivery_policy" {
+						result[tfAttribute] = parseDeliveryPolicy(v[0].(interface{}))
+						continue
+					}
+				case schema.TypeList, schema.TypeSet:
+					switch elem := tfSchema.Elem.(type) {
+					case *schema.Resource:
+						blocks := []interface{}{}
+						for i, item := range v {
+							if val := item.(map[string]interface{}); val != nil {
+								attributePrefixForRecursiveCall := fmt.Sprintf("%s[%d]", tfAttribute, i)
+								if attributePrefix != "" {
+									attributePrefixForRecursiveCall = fmt.Sprintf("%s.%s[%d]", attributePrefix, tfAttribute, i)
+								}
+								block, err := getJSONObjectFromMap(val, elem, interpolationMap, ociRes, attributePrefixForRecursiveCall)
+								if err != nil {
+									return nil, err
+								}
+								blocks = append(blocks, block)
+							}
+						}
+						result[tfAttribute] = blocks
+						continue
+					case *schema.Schema, schema.ValueType, InterpolationString:
+						list := make([]interface{}, 0, len(v))
+						for _, item := range v {
+							switch item.(type) {
+							case InterpolationString, string, int, bool, float64:
+								list = append(list, getJSONValue(item, interpolationMap))
+							default:
+								return nil, fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': List element type mismatch", tfAttribute, tfAttribute)
+							}
+						}
+						result[tfAttribute] = list
+						continue
+					}
+
+					return nil, fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': List element is neither schema.Resource or schema.Schema", tfAttribute, tfAttribute)
+				}
+			case map[string]interface{}:
+				switch tfSchema.Type {
+				case schema.TypeList:
+					if nestedResource := tfSchema.Elem.(*schema.Resource); nestedResource != nil {
+						attributePrefixForRecursiveCall := tfAttribute
+						if attributePrefix != "" {
+							attributePrefixForRecursiveCall = attributePrefix + "." + tfAttribute
+						}
+						block, err := getJSONObjectFromMap(v, nestedResource, interpolationMap, ociRes, attributePrefixForRecursiveCall)
+						if err != nil {
+							return nil, err
+						}
+						result[tfAttribute] = []interface{}{block}
+						continue
+					}
+					return nil, fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': Nested resource type mismatch", tfAttribute, tfAttribute)
+				case schema.TypeMap:
+					mapResult := map[string]interface{}{}
+					for mapKey, mapVal := range v {
+						switch mapVal.(type) {
+						case InterpolationString, string, int, bool, float64:
+							mapResult[mapKey] = getJSONValue(mapVal, interpolationMap)
+						default:
+							utils.Logf("[WARN] TF attribute '%s' has a complex map value for key '%s', it is not exported\n", tfAttribute, mapKey)
+						}
+					}
+					result[tfAttribute] = mapResult
+					continue
+				default:
+					return nil, fmt.Errorf("[ERROR] sourceAttribute '%s', tfAttribute '%s': Source attribute is nested object but TF attribute is not", tfAttribute, tfAttribute)
+				}
+			case nil:
+				utils.Logf("[INFO] TF attribute '%s' is nil in source\n", tfAttribute)
+				if !tfSchema.Required {
+					continue
+				}
+			default:
+				utils.Logf("[WARN] TF attribute '%s' is unknown type in source\n", tfAttribute)
+			}
+		}
+
+		if tfSchema.Required {
+			utils.Logf("[WARN] Required TF attribute '%s' not found in source\n", tfAttribute)
+			result[tfAttribute] = fmt.Sprintf("%v", getMissingRequiredAttributeValue(ociRes, tfAttribute, attributePrefix))
+		} else if tfSchema.Optional {
+			utils.Logf("[INFO] Optional TF attribute '%s' not found in source\n", tfAttribute)
+		}
+	}
+	return result, nil
+}
+
+// getJSONValue returns the value of a primitive attribute, the values found in the interpolation map are replaced by
+// their reference
+func getJSONValue(value interface{}, interpolationMap map[string]string) interface{} {
+	switch v := value.(type) {
+	case InterpolationString:
+		if ok := failedResourceReferenceSet[v.resourceReference]; ok {
+			return v.value
+		}
+		return getJsonExpression(v.interpolation)
+	case string:
+		if varOverride, exists := interpolationMap[v]; exists {
+			return getJsonExpression(varOverride)
+		}
+		return escapeTFStrings(v)
+	}
+	return value
+}
+
+func getAvailabilityDomainJSONDatasource(ociRes *OCIResource, varMap map[string]string) (map[string]interface{}, error) {
+	adIndex, ok := ociRes.sourceAttributes["index"]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] no index found for availability domain '%s'", ociRes.getTerraformReference())
+	}
+	return map[string]interface{}{
+		"compartment_id": getJsonExpression(varMap[ociRes.compartmentId]),
+		"ad_number":      adIndex,
+	}, nil
+}
+
+func getObjectStorageNamespaceJSONDatasource(ociRes *OCIResource, varMap map[string]string) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"compartment_id": getJsonExpression(varMap[ociRes.compartmentId]),
+	}, nil
+}
+
+func generateJSONVarsFile(vars map[string]string) ([]byte, error) {
+	config := &jsonConfiguration{Variable: map[string]map[string]interface{}{}}
+	for variable, defaultVal := range vars {
+		config.Variable[variable] = map[string]interface{}{}
+		if defaultVal == "" {
+			continue
+		}
+		// The values of the vars are HCL string literals
+		if unquoted, err := strconv.Unquote(defaultVal); err == nil {
+			config.Variable[variable]["default"] = unquoted
+		} else {
+			config.Variable[variable]["default"] = defaultVal
+		}
+	}
+	return config.marshal()
+}
+
+func generateJSONProviderFile() ([]byte, error) {
+	config := &jsonConfiguration{
+		Provider: map[string]map[string]interface{}{
+			"oci": {"region": getJsonExpression(tfHclVersion.getVarHclString("region"))},
+		},
+	}
+	return config.marshal()
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitJsonConfiguration_addResource(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	tfHclVersion = &TfHclVersion12{}
+
+	child := &OCIResource{
+		compartmentId: resourceDiscoveryTestCompartmentOcid,
+		sourceAttributes: map[string]interface{}{
+			"compartment_id": resourceDiscoveryTestCompartmentOcid,
+			"a_string":       "${not_an_interpolation}",
+			"a_int":          3,
+			"a_list":         []interface{}{"ocid1.parent.abcdefghiklmnop.0", "value"},
+			"a_map":          map[string]interface{}{"key0": "value0"},
+			"a_nested":       []interface{}{map[string]interface{}{"nested_bool": true}},
+		},
+		TerraformResource: TerraformResource{
+			id:                "ocid1.child.abcdefghiklmnop.0",
+			terraformClass:    "oci_test_child",
+			terraformName:     "export_child",
+			terraformTypeInfo: &TerraformResourceHints{},
+		},
+	}
+	interpolationMap := map[string]string{
+		resourceDiscoveryTestCompartmentOcid: tfHclVersion.getVarHclString("compartment_ocid"),
+		"ocid1.parent.abcdefghiklmnop.0":     "oci_test_parent.export_parent.id",
+		"ocid1.child.abcdefghiklmnop.0":      "oci_test_child.export_child.id",
+	}
+
+	config := &jsonConfiguration{Comment: jsonConfigurationComment}
+	assert.NoError(t, config.addResource(child, interpolationMap))
+	content, err := config.marshal()
+	assert.NoError(t, err)
+
+	var parsed struct {
+		Resource map[string]map[string]map[string]interface{} `json:"resource"`
+		Import   []map[string]string                          `json:"import"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &parsed))
+	body := parsed.Resource["oci_test_child"]["export_child"]
+	assert.NotNil(t, body)
+
+	assert.Equal(t, "${var.compartment_ocid}", body["compartment_id"])
+	assert.Equal(t, "$${not_an_interpolation}", body["a_string"])
+	assert.Equal(t, float64(3), body["a_int"])
+	assert.Equal(t, []interface{}{"${oci_test_parent.export_parent.id}", "value"}, body["a_list"])
+	assert.Equal(t, map[string]interface{}{"key0": "value0"}, body["a_map"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"nested_bool": true}}, body["a_nested"])
+
+	// The required attribute not found in discovery gets the placeholder and is ignored in lifecycle
+	assert.Equal(t, globalvar.PlaceholderValueForMissingAttribute, body["parent_id"])
+	lifecycle := body["lifecycle"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"parent_id"}, lifecycle["ignore_changes"])
+	assert.Empty(t, parsed.Import)
+
+	// The import blocks are generated for Terraform v1.5
+	tfHclVersion = &TfHclVersion15{}
+	config = &jsonConfiguration{}
+	assert.NoError(t, config.addResource(child, interpolationMap))
+	assert.Equal(t, []map[string]string{{"to": "oci_test_child.export_child", "id": "ocid1.child.abcdefghiklmnop.0"}}, config.Import)
+	tfHclVersion = &TfHclVersion12{}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGenerateJSONVarsFile(t *testing.T) {
+	content, err := generateJSONVarsFile(map[string]string{"compartment_ocid": "\"ocid1.compartment.abc\"", "region": ""})
+	assert.NoError(t, err)
+
+	var parsed struct {
+		Variable map[string]map[string]interface{} `json:"variable"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &parsed))
+	assert.Equal(t, map[string]interface{}{"default": "ocid1.compartment.abc"}, parsed.Variable["compartment_ocid"])
+	assert.Equal(t, map[string]interface{}{}, parsed.Variable["region"])
+}
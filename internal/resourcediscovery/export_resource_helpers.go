@@ -23,6 +23,7 @@ import (
 
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
@@ -54,6 +55,7 @@ type TerraformResourceHints struct {
 
 	// Hints to help with generating HCL representation from this resource
 	getHCLStringOverrideFn func(*strings.Builder, *OCIResource, map[string]string) error // Custom function for generating HCL syntax for the resource
+	getJSONOverrideFn      func(*OCIResource, map[string]string) (map[string]interface{}, error) // Custom function for generating the Terraform JSON syntax of the data source, paired with getHCLStringOverrideFn
 
 	// Hints for adding default value to HCL representation for attributes not found in resource discovery
 	defaultValuesForMissingAttributes map[string]interface{}
@@ -103,6 +105,8 @@ type resourceDiscoveryContext struct {
 	terraform                   *tfexec.Terraform
 	clients                     *tf_client.OracleClients
 	expectedResourceIds         map[string]bool
+	resourceFilters             []*resourceFilter
+	previousExport              *previousExport
 	tenancyOcid                 string
 	discoveredResources         []*OCIResource
 	summaryStatements           []string
@@ -116,6 +120,7 @@ type resourceDiscoveryContext struct {
 	timeTakenToDiscover          time.Duration
 	timeTakenToGenerateState     time.Duration
 	timeTakenForEntireExport     time.Duration
+	isCompartmentModule          bool // the compartment is exported as a module of the root compartment
 }
 
 // Resource discovery Exit status
@@ -183,6 +188,9 @@ func (ctx *resourceDiscoveryContext) printSummary() {
 	utils.Logln(utils.Green(fmt.Sprintf("Total time taken for discovering all services: %v", ctx.timeTakenToDiscover)))
 	utils.Logln(utils.Green(fmt.Sprintf("Total time taken for generating state of all services: %v", ctx.timeTakenToGenerateState)))
 	utils.Logln(utils.Green(fmt.Sprintf("Total time taken by entire export: %v", ctx.timeTakenForEntireExport)))
+	for _, statement := range tfresource.GetTraceSummary() {
+		utils.Logln(utils.Green(statement))
+	}
 }
 
 func (ctx *resourceDiscoveryContext) printErrors() {
@@ -252,6 +260,19 @@ func createResourceDiscoveryContext(clients *tf_client.OracleClients, args *Expo
 
 	result.expectedResourceIds = convertStringSliceToSet(args.IDs, true)
 
+	resourceFilters, err := parseResourceFilters(args.Filters)
+	if err != nil {
+		return result, err
+	}
+	result.resourceFilters = resourceFilters
+
+	if args.Incremental {
+		if result.previousExport, err = loadPreviousExport(*args.OutputDir); err != nil {
+			return result, err
+		}
+		result.previousExport.reserveNames()
+	}
+
 	re := regexp.MustCompile(`oci_([^:]+):(.+$)`)
 
 	for id := range result.expectedResourceIds {
@@ -459,10 +480,10 @@ func (r *resourceDiscoveryBaseStep) writeTmpConfigurationForImport() error {
 
 func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	defer elapsed(fmt.Sprintf("writing actual configuration for %d %s resources", len(r.getDiscoveredResources()), r.name), nil, 0)()
-	configOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-	tmpConfigOutputFile := fmt.Sprintf("%s%s%s.tf.tmp", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
+	configOutputFile := fmt.Sprintf("%s%s%s", *r.ctx.OutputDir, string(os.PathSeparator), getConfigFileName(r.name+".tf"))
+	tmpConfigOutputFile := fmt.Sprintf("%s.tmp", configOutputFile)
 
-	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR, 0666)
+	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
 	if err != nil {
 		return err
 	}
@@ -473,6 +494,7 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	// an empty one.
 	builder := &strings.Builder{}
 	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	jsonConfig := &jsonConfiguration{Comment: jsonConfigurationComment}
 
 	exportedResourceCount := 0
 	for _, resource := range r.discoveredResources {
@@ -480,9 +502,21 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 		// Skip writing the config for resources for which import command failed
 		if !resource.isErrorResource {
 			utils.Logf("[INFO] ===> Generating resource '%s'", resource.getTerraformReference())
-			if err := resource.getHCLString(builder, referenceMap); err != nil {
-				_ = file.Close()
-				return err
+			if exportOutputFormat == OutputFormatJson {
+				if err := jsonConfig.addResource(resource, referenceMap); err != nil {
+					_ = file.Close()
+					return err
+				}
+			} else {
+				if err := resource.getHCLString(builder, referenceMap); err != nil {
+					_ = file.Close()
+					return err
+				}
+
+				// The import block adopts the resource on `terraform plan`, it is only generated for the resources that support import
+				if resourceDefinition, exists := resourcesMap[resource.terraformClass]; exists && resourceDefinition.Importer != nil {
+					builder.WriteString(tfHclVersion.getImportHclString(resource.getTerraformReference(), resource.getImportId()))
+				}
 			}
 
 			if resource.terraformTypeInfo != nil && len(resource.terraformTypeInfo.ignorableRequiredMissingAttributes) > 0 {
@@ -510,10 +544,18 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 		}
 	}
 
-	// Format the HCL config
-	formattedString := hclwrite.Format([]byte(builder.String()))
+	var content []byte
+	if exportOutputFormat == OutputFormatJson {
+		if content, err = jsonConfig.marshal(); err != nil {
+			_ = file.Close()
+			return err
+		}
+	} else {
+		// Format the HCL config
+		content = hclwrite.Format([]byte(builder.String()))
+	}
 
-	_, err = file.WriteString(string(formattedString))
+	_, err = file.Write(content)
 	if err != nil {
 		_ = file.Close()
 		return err
@@ -527,6 +569,14 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 		return err
 	}
 
+	// Remove the HCL config written to run import, Terraform would load the resources twice
+	if exportOutputFormat == OutputFormatJson {
+		hclConfigOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
+		if err := os.Remove(hclConfigOutputFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
 	if r.ctx.targetSpecificResources {
 		r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
 	} else {
@@ -787,6 +837,7 @@ func init() {
 	exportIdentityAvailabilityDomainHints.alwaysExportable = true
 	exportIdentityAvailabilityDomainHints.processDiscoveredResourcesFn = processAvailabilityDomains
 	exportIdentityAvailabilityDomainHints.getHCLStringOverrideFn = getAvailabilityDomainHCLDatasource
+	exportIdentityAvailabilityDomainHints.getJSONOverrideFn = getAvailabilityDomainJSONDatasource
 	exportIdentityAuthenticationPolicyHints.processDiscoveredResourcesFn = processIdentityAuthenticationPolicies
 	exportIdentityTagHints.findResourcesOverrideFn = findIdentityTags
 	exportIdentityTagHints.processDiscoveredResourcesFn = processTagDefinitions
@@ -795,6 +846,7 @@ func init() {
 
 	exportObjectStorageNamespaceHints.processDiscoveredResourcesFn = processObjectStorageNamespace
 	exportObjectStorageNamespaceHints.getHCLStringOverrideFn = getObjectStorageNamespaceHCLDatasource
+	exportObjectStorageNamespaceHints.getJSONOverrideFn = getObjectStorageNamespaceJSONDatasource
 	exportObjectStorageNamespaceHints.alwaysExportable = true
 	exportObjectStorageObjectHints.requireResourceRefresh = true
 	exportObjectStoragePreauthenticatedRequestHints.processDiscoveredResourcesFn = processObjectStoragePreauthenticatedRequest
@@ -0,0 +1,408 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	utils "github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+/*
+The export of a compartment subtree writes the configuration of the starting compartment in the output_path, as the
+root module, and the configuration of each of its subcompartments in output_path/modules/<module_name>. The root module
+instantiates every module in modules.tf.
+
+The ocids of the resources exported by another module are replaced by input variables of the module. The root module
+sets them to its own resources, e.g. the oci_identity_compartment resources exported with the identity service, or to
+the outputs of the module which exported the resource. The compartments are exported parents first, so a reference to
+a resource of a compartment exported later is kept as an ocid.
+*/
+const (
+	modulesDirName = "modules"
+	modulesFile    = "modules.tf"
+	outputsFile    = "outputs.tf"
+)
+
+var moduleVarReferenceRegex = regexp.MustCompile(`var\.([a-zA-Z0-9_\-]+)`)
+
+type compartmentModule struct {
+	name          string
+	compartmentId string
+	path          string
+	outputDir     string
+	inputs        map[string]string // input variable -> value set by the root module
+	outputs       map[string]string // output -> reference to the resource in the module
+}
+
+// exportedReference is a resource exported by the root module, when module is empty, or by a compartment module
+type exportedReference struct {
+	module     *compartmentModule
+	outputName string
+	reference  string
+}
+
+type subcompartmentsExport struct {
+	clients     *tf_client.OracleClients
+	args        *ExportCommandArgs
+	tenancyOcid string
+	modules     []*compartmentModule
+	references  map[string]*exportedReference
+	rootVars    map[string]string
+}
+
+func runSubcompartmentsExport(clients *tf_client.OracleClients, args *ExportCommandArgs, tenancyOcid string) (error, Status) {
+	rootCompartmentId := tenancyOcid
+	if args.CompartmentId != nil && *args.CompartmentId != "" {
+		rootCompartmentId = *args.CompartmentId
+	}
+
+	modules, err := findCompartmentModules(clients, rootCompartmentId, *args.OutputDir)
+	if err != nil {
+		return err, StatusFail
+	}
+	utils.Logf("[INFO] exporting %d subcompartments of %s", len(modules), rootCompartmentId)
+
+	export := &subcompartmentsExport{
+		clients:     clients,
+		args:        args,
+		tenancyOcid: tenancyOcid,
+		modules:     modules,
+		references:  map[string]*exportedReference{},
+	}
+
+	status := StatusSuccess
+	rootStatus, err := export.exportCompartment(nil, rootCompartmentId, args.Services, *args.OutputDir)
+	if err != nil {
+		return err, StatusFail
+	}
+	if rootStatus == StatusPartialSuccess {
+		status = StatusPartialSuccess
+	}
+
+	for _, module := range modules {
+		if err := os.MkdirAll(module.outputDir, os.ModePerm); err != nil {
+			return err, StatusFail
+		}
+		moduleStatus, err := export.exportCompartment(module, module.compartmentId, args.Services, module.outputDir)
+		if err != nil {
+			return fmt.Errorf("[ERROR] error exporting the compartment %s into the module %s: %s", module.path, module.name, err.Error()), StatusFail
+		}
+		if moduleStatus == StatusPartialSuccess {
+			status = StatusPartialSuccess
+		}
+	}
+
+	if err := export.writeRootModule(); err != nil {
+		return err, StatusFail
+	}
+	return nil, status
+}
+
+// exportCompartment runs the export of one compartment with a fresh referenceMap, seeded with the resources exported
+// by the other modules
+func (e *subcompartmentsExport) exportCompartment(module *compartmentModule, compartmentId string, services []string, outputDir string) (Status, error) {
+	referenceMap = map[string]string{}
+	vars = map[string]string{}
+
+	seededVars := map[string]string{}
+	if module != nil {
+		for ocid, exported := range e.references {
+			varName := exported.outputName
+			seededVars[varName] = ocid
+			referenceMap[ocid] = tfHclVersion.getVarHclString(varName)
+		}
+		seededVars["tenancy_ocid"] = e.tenancyOcid
+		referenceMap[e.tenancyOcid] = tfHclVersion.getVarHclString("tenancy_ocid")
+	}
+
+	args := *e.args
+	args.CompartmentId = &compartmentId
+	args.OutputDir = &outputDir
+	args.Services = append([]string{}, services...)
+
+	ctx, err := createResourceDiscoveryContext(e.clients, &args, e.tenancyOcid)
+	if err != nil {
+		return StatusFail, err
+	}
+	ctx.isCompartmentModule = module != nil
+	args.finalizeServices(ctx)
+
+	if err := runExportCommand(ctx); err != nil {
+		return StatusFail, err
+	}
+
+	for ocid, reference := range referenceMap {
+		if _, seeded := e.references[ocid]; seeded || !isResourceReference(reference) {
+			continue
+		}
+		e.references[ocid] = &exportedReference{
+			module:     module,
+			outputName: getModuleOutputName(reference),
+			reference:  reference,
+		}
+	}
+
+	if module == nil {
+		e.rootVars = vars
+	} else if err := e.finalizeModule(module, seededVars); err != nil {
+		return StatusFail, err
+	}
+
+	if len(ctx.errorList.errors) > 0 {
+		ctx.printErrors()
+		return StatusPartialSuccess, nil
+	}
+	return StatusSuccess, nil
+}
+
+// finalizeModule declares the input variables used by the module configuration, without default values as the root
+// module sets them
+func (e *subcompartmentsExport) finalizeModule(module *compartmentModule, seededVars map[string]string) error {
+	usedVars, err := getModuleVarReferences(module.outputDir)
+	if err != nil {
+		return err
+	}
+
+	vars["compartment_ocid"] = ""
+	vars["region"] = ""
+	for varName, ocid := range seededVars {
+		if !usedVars[varName] {
+			continue
+		}
+		vars[varName] = ""
+		if varName == "tenancy_ocid" {
+			module.inputs[varName] = tfHclVersion.getVarHclString("tenancy_ocid")
+			continue
+		}
+		exported := e.references[ocid]
+		if exported.module == nil {
+			module.inputs[varName] = exported.reference
+		} else {
+			exported.module.outputs[exported.outputName] = exported.reference
+			module.inputs[varName] = tfHclVersion.getDoubleExpHclString("module."+exported.module.name, exported.outputName)
+		}
+	}
+	return generateVarsFile(vars, &module.outputDir)
+}
+
+func (e *subcompartmentsExport) writeRootModule() error {
+	if e.rootVars == nil {
+		e.rootVars = map[string]string{}
+	}
+
+	builder := &strings.Builder{}
+	jsonConfig := &jsonConfiguration{Comment: jsonConfigurationComment, Module: map[string]map[string]interface{}{}}
+	for _, module := range e.modules {
+		compartmentReference := tfHclVersion.getVarHclString(module.name + "_compartment_ocid")
+		if exported, ok := e.references[module.compartmentId]; ok && exported.module == nil {
+			compartmentReference = exported.reference
+		} else {
+			e.rootVars[module.name+"_compartment_ocid"] = fmt.Sprintf("\"%s\"", module.compartmentId)
+		}
+		if _, ok := module.inputs["tenancy_ocid"]; ok {
+			e.rootVars["tenancy_ocid"] = fmt.Sprintf("\"%s\"", e.tenancyOcid)
+		}
+
+		source := fmt.Sprintf("./%s/%s", modulesDirName, module.name)
+		if exportOutputFormat == OutputFormatJson {
+			moduleBody := map[string]interface{}{
+				"//":               fmt.Sprintf("compartment %s", module.path),
+				"source":           source,
+				"compartment_ocid": getJsonExpression(compartmentReference),
+				"region":           getJsonExpression(tfHclVersion.getVarHclString("region")),
+			}
+			for input, value := range module.inputs {
+				moduleBody[input] = getJsonExpression(value)
+			}
+			jsonConfig.Module[module.name] = moduleBody
+		} else {
+			builder.WriteString(fmt.Sprintf("## compartment %s\n", module.path))
+			builder.WriteString(fmt.Sprintf("module %s {\n", module.name))
+			builder.WriteString(fmt.Sprintf("\tsource = \"%s\"\n", source))
+			builder.WriteString(fmt.Sprintf("\tcompartment_ocid = %s\n", compartmentReference))
+			builder.WriteString(fmt.Sprintf("\tregion = %s\n", tfHclVersion.getVarHclString("region")))
+			for _, input := range sortedKeys(module.inputs) {
+				builder.WriteString(fmt.Sprintf("\t%s = %s\n", input, module.inputs[input]))
+			}
+			builder.WriteString("}\n\n")
+		}
+
+		if err := writeModuleOutputs(module); err != nil {
+			return err
+		}
+	}
+
+	content := []byte(builder.String())
+	if exportOutputFormat == OutputFormatJson {
+		var err error
+		if content, err = jsonConfig.marshal(); err != nil {
+			return err
+		}
+	}
+	modulesOutputFile := filepath.Join(*e.args.OutputDir, getConfigFileName(modulesFile))
+	if err := ioutil.WriteFile(modulesOutputFile, content, 0644); err != nil {
+		return err
+	}
+	utils.Logf("[INFO] root module written to %s", modulesOutputFile)
+	return generateVarsFile(e.rootVars, e.args.OutputDir)
+}
+
+func writeModuleOutputs(module *compartmentModule) error {
+	if len(module.outputs) == 0 {
+		return nil
+	}
+	builder := &strings.Builder{}
+	jsonConfig := &jsonConfiguration{Output: map[string]map[string]interface{}{}}
+	for _, output := range sortedKeys(module.outputs) {
+		builder.WriteString(fmt.Sprintf("output %s { value = %s }\n", output, module.outputs[output]))
+		jsonConfig.Output[output] = map[string]interface{}{"value": getJsonExpression(module.outputs[output])}
+	}
+
+	content := []byte(builder.String())
+	if exportOutputFormat == OutputFormatJson {
+		var err error
+		if content, err = jsonConfig.marshal(); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(filepath.Join(module.outputDir, getConfigFileName(outputsFile)), content, 0644)
+}
+
+// findCompartmentModules walks the active subcompartments of the root compartment, parents first
+func findCompartmentModules(clients *tf_client.OracleClients, rootCompartmentId string, outputDir string) ([]*compartmentModule, error) {
+	var modules []*compartmentModule
+	moduleNames := map[string]int{}
+
+	var walk func(parentId string, parentPath []string) error
+	walk = func(parentId string, parentPath []string) error {
+		compartments, err := listChildCompartments(clients, parentId)
+		if err != nil {
+			return err
+		}
+		for _, compartment := range compartments {
+			path := append(append([]string{}, parentPath...), *compartment.Name)
+			name := getModuleName(path)
+			if count, exists := moduleNames[name]; exists {
+				moduleNames[name] = count + 1
+				name = fmt.Sprintf("%s_%d", name, count)
+			}
+			moduleNames[name] = 1
+
+			modules = append(modules, &compartmentModule{
+				name:          name,
+				compartmentId: *compartment.Id,
+				path:          strings.Join(path, "/"),
+				outputDir:     filepath.Join(outputDir, modulesDirName, name),
+				inputs:        map[string]string{},
+				outputs:       map[string]string{},
+			})
+			if err := walk(*compartment.Id, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(rootCompartmentId, nil); err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+func listChildCompartments(clients *tf_client.OracleClients, parentId string) ([]oci_identity.Compartment, error) {
+	req := oci_identity.ListCompartmentsRequest{
+		CompartmentId:  &parentId,
+		LifecycleState: oci_identity.CompartmentLifecycleStateActive,
+	}
+
+	var result []oci_identity.Compartment
+	for {
+		resp, err := clients.IdentityClient().ListCompartments(context.Background(), req)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] error listing the subcompartments of %s: %s", parentId, err.Error())
+		}
+		for _, compartment := range resp.Items {
+			if compartment.Id != nil && compartment.Name != nil {
+				result = append(result, compartment)
+			}
+		}
+
+		if resp.OpcNextPage == nil {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return *result[i].Name < *result[j].Name
+	})
+	return result, nil
+}
+
+func getModuleName(compartmentPath []string) string {
+	reg := regexp.MustCompile(`[^a-zA-Z0-9\-\_]+`)
+	name := reg.ReplaceAllString(strings.Join(compartmentPath, "_"), "-")
+	if matched, _ := regexp.MatchString(`^[a-zA-Z]`, name); !matched {
+		name = "compartment_" + name
+	}
+	return name
+}
+
+// isResourceReference returns true for the references to the id of a resource, e.g. oci_core_vcn.export_vcn.id, and
+// false for the variables and the data sources
+func isResourceReference(reference string) bool {
+	return strings.HasPrefix(trimHclReference(reference), "oci_")
+}
+
+func getModuleOutputName(reference string) string {
+	return strings.Replace(strings.TrimPrefix(trimHclReference(reference), "oci_"), ".", "_", -1)
+}
+
+func trimHclReference(reference string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(reference, "\"${"), "}\"")
+}
+
+// getModuleVarReferences returns the variables referenced in the configuration files of the module
+func getModuleVarReferences(moduleDir string) (map[string]bool, error) {
+	files, err := ioutil.ReadDir(moduleDir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]bool{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), getConfigFileName(".tf")) || file.Name() == getConfigFileName(globalvar.VarsFile) {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(moduleDir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range moduleVarReferenceRegex.FindAllStringSubmatch(string(content), -1) {
+			result[match[1]] = true
+		}
+	}
+	return result, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitGetModuleName(t *testing.T) {
+	assert.Equal(t, "network_prod-vcn", getModuleName([]string{"network", "prod.vcn"}))
+	assert.Equal(t, "compartment_1-apps", getModuleName([]string{"1 apps"}))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitModuleReferences(t *testing.T) {
+	tfHclVersion = &TfHclVersion12{}
+	assert.True(t, isResourceReference("oci_core_vcn.export_vcn.id"))
+	assert.True(t, isResourceReference("\"${oci_core_vcn.export_vcn.id}\""))
+	assert.False(t, isResourceReference("var.compartment_ocid"))
+	assert.False(t, isResourceReference("data.oci_identity_availability_domain.export_ad.name"))
+	assert.Equal(t, "core_vcn_export_vcn_id", getModuleOutputName("\"${oci_core_vcn.export_vcn.id}\""))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateSubcompartments(t *testing.T) {
+	outputDir := t.TempDir()
+	var tfVersion TfHclVersion = &TfHclVersion12{Value: TfVersion12}
+	args := &ExportCommandArgs{OutputDir: &outputDir, IncludeSubcompartments: true, TFVersion: &tfVersion}
+	assert.NoError(t, args.validate())
+
+	// The import blocks can not be written in the modules of the subcompartments
+	tfVersion = &TfHclVersion15{TfHclVersion12{Value: TfVersion15}}
+	err := args.validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "tf_version 1.5 is not supported with include_subcompartments")
+
+	args.IncludeSubcompartments = false
+	assert.NoError(t, args.validate())
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitSubcompartmentsExport_writeRootModule(t *testing.T) {
+	tfHclVersion = &TfHclVersion12{}
+	defer func() {
+		vars = map[string]string{}
+		referenceMap = map[string]string{}
+	}()
+
+	outputDir, err := ioutil.TempDir("", "discoveryModulesTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	network := &compartmentModule{name: "network", compartmentId: "ocid1.compartment.network", path: "network",
+		outputDir: filepath.Join(outputDir, modulesDirName, "network"), inputs: map[string]string{}, outputs: map[string]string{}}
+	apps := &compartmentModule{name: "apps", compartmentId: "ocid1.compartment.apps", path: "apps",
+		outputDir: filepath.Join(outputDir, modulesDirName, "apps"), inputs: map[string]string{}, outputs: map[string]string{}}
+	for _, module := range []*compartmentModule{network, apps} {
+		assert.NoError(t, os.MkdirAll(module.outputDir, os.ModePerm))
+	}
+
+	export := &subcompartmentsExport{
+		args:        &ExportCommandArgs{OutputDir: &outputDir},
+		tenancyOcid: resourceDiscoveryTestTenancyOcid,
+		modules:     []*compartmentModule{network, apps},
+		references: map[string]*exportedReference{
+			"ocid1.compartment.network": {outputName: "identity_compartment_export_network_id", reference: "oci_identity_compartment.export_network.id"},
+			"ocid1.vcn.prod":            {module: network, outputName: "core_vcn_export_prod_id", reference: "oci_core_vcn.export_prod.id"},
+		},
+		rootVars: map[string]string{"region": "\"us-phoenix-1\""},
+	}
+
+	// The subnet of the apps compartment references the vcn of the network compartment and the network compartment
+	config := "resource oci_core_subnet export_apps {\n\tcompartment_id = var.compartment_ocid\n\tvcn_id = var.core_vcn_export_prod_id\n" +
+		"\tdefined_tags = { var.identity_compartment_export_network_id = \"\" }\n}\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(apps.outputDir, "core.tf"), []byte(config), 0644))
+	vars = map[string]string{"compartment_ocid": "\"ocid1.compartment.apps\"", "region": "\"us-phoenix-1\""}
+	assert.NoError(t, export.finalizeModule(apps, map[string]string{
+		"core_vcn_export_prod_id":                "ocid1.vcn.prod",
+		"identity_compartment_export_network_id": "ocid1.compartment.network",
+		"tenancy_ocid":                           resourceDiscoveryTestTenancyOcid,
+	}))
+
+	assert.Equal(t, map[string]string{
+		"core_vcn_export_prod_id":                "module.network.core_vcn_export_prod_id",
+		"identity_compartment_export_network_id": "oci_identity_compartment.export_network.id",
+	}, apps.inputs)
+	assert.Equal(t, map[string]string{"core_vcn_export_prod_id": "oci_core_vcn.export_prod.id"}, network.outputs)
+
+	moduleVars, err := ioutil.ReadFile(filepath.Join(apps.outputDir, globalvar.VarsFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(moduleVars), "variable compartment_ocid {}")
+	assert.Contains(t, string(moduleVars), "variable core_vcn_export_prod_id {}")
+	assert.NotContains(t, string(moduleVars), "tenancy_ocid")
+
+	assert.NoError(t, export.writeRootModule())
+
+	modules, err := ioutil.ReadFile(filepath.Join(outputDir, modulesFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(modules), "module network {\n\tsource = \"./modules/network\"\n\tcompartment_ocid = oci_identity_compartment.export_network.id\n")
+	assert.Contains(t, string(modules), "module apps {\n\tsource = \"./modules/apps\"\n\tcompartment_ocid = var.apps_compartment_ocid\n")
+	assert.Contains(t, string(modules), "\tcore_vcn_export_prod_id = module.network.core_vcn_export_prod_id\n")
+
+	outputs, err := ioutil.ReadFile(filepath.Join(network.outputDir, outputsFile))
+	assert.NoError(t, err)
+	assert.Equal(t, "output core_vcn_export_prod_id { value = oci_core_vcn.export_prod.id }\n", string(outputs))
+
+	rootVars, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.VarsFile))
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(rootVars), "variable apps_compartment_ocid { default = \"ocid1.compartment.apps\" }"))
+}
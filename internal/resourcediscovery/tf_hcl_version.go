@@ -3,7 +3,10 @@
 
 package resourcediscovery
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type TfVersionEnum string
 
@@ -11,6 +14,7 @@ type TfVersionEnum string
 const (
 	TfVersion11 TfVersionEnum = "0.11"
 	TfVersion12 TfVersionEnum = "0.12"
+	TfVersion15 TfVersionEnum = "1.5"
 )
 
 type TfHclVersion interface {
@@ -20,6 +24,7 @@ type TfHclVersion interface {
 	getDataSourceHclString(string, string) string
 	getSingleExpHclString(string) string
 	getDoubleExpHclString(string, string) string
+	getImportHclString(string, string) string
 }
 
 type TfHclVersion11 struct {
@@ -50,6 +55,11 @@ func (tfversion *TfHclVersion11) getDoubleExpHclString(expString1 string, expStr
 	return fmt.Sprintf("\"${%s.%s}\"", expString1, expString2)
 }
 
+// Import blocks are only supported from Terraform v1.5
+func (tfversion *TfHclVersion11) getImportHclString(reference string, importId string) string {
+	return ""
+}
+
 type TfHclVersion12 struct {
 	Value TfVersionEnum
 }
@@ -77,3 +87,32 @@ func (tfversion *TfHclVersion12) getSingleExpHclString(expString string) string
 func (tfversion *TfHclVersion12) getDoubleExpHclString(expString1 string, expString2 string) string {
 	return fmt.Sprintf("%s.%s", expString1, expString2)
 }
+
+// Import blocks are only supported from Terraform v1.5
+func (tfversion *TfHclVersion12) getImportHclString(reference string, importId string) string {
+	return ""
+}
+
+// TfHclVersion15 generates the v0.12 syntax with an import block for each resource, so that `terraform plan` imports
+// the discovered resources without generating the state
+type TfHclVersion15 struct {
+	TfHclVersion12
+}
+
+func (tfversion *TfHclVersion15) toString() string {
+	return "1.5"
+}
+
+func (tfversion *TfHclVersion15) getImportHclString(reference string, importId string) string {
+	return fmt.Sprintf("import {\n\tto = %s\n\tid = \"%s\"\n}\n\n", reference, escapeHclString(importId))
+}
+
+// generatesImportBlocks returns whether the configuration generated for the version has an import block for each resource
+func generatesImportBlocks(tfversion TfHclVersion) bool {
+	return tfversion.getImportHclString("", "") != ""
+}
+
+// escapeHclString escapes the quotes, the backslashes and the template sequences of a quoted HCL string
+func escapeHclString(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", "$${", "%{", "%%{").Replace(value)
+}
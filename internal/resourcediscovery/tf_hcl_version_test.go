@@ -331,3 +331,59 @@ func TestUnitTfHclVersion12_getDoubleExpHclString(t *testing.T) {
 		})
 	}
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitTfHclVersion15_getImportHclString(t *testing.T) {
+	type fields struct {
+		Value TfVersionEnum
+	}
+	type args struct {
+		reference string
+		importId  string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		{
+			"ImportHclString",
+			fields{TfVersion15},
+			args{"oci_core_vcn.export_vcn", "ocid1.vcn.oc1..aaa"},
+			"import {\n\tto = oci_core_vcn.export_vcn\n\tid = \"ocid1.vcn.oc1..aaa\"\n}\n\n",
+		},
+		{
+			"ImportHclStringCompositeId",
+			fields{TfVersion15},
+			args{"oci_test_child.export_child", "parents/ocid1.parent/children/${\"child\"}"},
+			"import {\n\tto = oci_test_child.export_child\n\tid = \"parents/ocid1.parent/children/$${\\\"child\\\"}\"\n}\n\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tfversion := &TfHclVersion15{TfHclVersion12{
+				Value: tt.fields.Value,
+			}}
+			if got := tfversion.getImportHclString(tt.args.reference, tt.args.importId); got != tt.want {
+				t.Errorf("TfHclVersion15.getImportHclString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (&TfHclVersion12{Value: TfVersion12}).getImportHclString("oci_core_vcn.export_vcn", "ocid1.vcn.oc1..aaa"); got != "" {
+		t.Errorf("TfHclVersion12.getImportHclString() = %v, want no import block", got)
+	}
+
+	// The other expressions are the ones of v0.12
+	tfversion := &TfHclVersion15{TfHclVersion12{Value: TfVersion15}}
+	if got := tfversion.toString(); got != "1.5" {
+		t.Errorf("TfHclVersion15.toString() = %v, want 1.5", got)
+	}
+	if got := tfversion.getVarHclString("compartment_ocid"); got != "var.compartment_ocid" {
+		t.Errorf("TfHclVersion15.getVarHclString() = %v, want var.compartment_ocid", got)
+	}
+	if !generatesImportBlocks(tfversion) || generatesImportBlocks(&TfHclVersion12{}) || generatesImportBlocks(&TfHclVersion11{}) {
+		t.Errorf("only TfHclVersion15 generates import blocks")
+	}
+}
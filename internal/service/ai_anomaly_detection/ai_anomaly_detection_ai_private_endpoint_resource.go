@@ -4,7 +4,6 @@
 package ai_anomaly_detection
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -216,7 +215,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +234,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) getAiPrivateEndpointFr
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, aiPrivateEndpointId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -294,7 +293,7 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -330,7 +329,7 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -360,7 +359,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -416,7 +415,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -433,7 +432,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteAiPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -548,7 +547,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.ChangeAiPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAiPrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package ai_anomaly_detection
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *AiAnomalyDetectionDataAssetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
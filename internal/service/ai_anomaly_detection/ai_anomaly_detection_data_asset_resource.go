@@ -4,7 +4,6 @@
 package ai_anomaly_detection
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -400,7 +399,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +416,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -465,7 +464,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -482,7 +481,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(s.Context(), request)
 	return err
 }
 
@@ -884,7 +883,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeDataAssetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDataAssetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
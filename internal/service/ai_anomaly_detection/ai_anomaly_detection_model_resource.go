@@ -4,7 +4,6 @@
 package ai_anomaly_detection
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"math"
@@ -356,7 +355,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -375,7 +374,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) getModelFromWorkRequest(workId *st
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, modelId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -435,7 +434,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -471,7 +470,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 }
 
 func getErrorFromAiAnomalyDetectionModelWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -501,7 +500,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -549,7 +548,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -566,7 +565,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteModel(context.Background(), request)
+	response, err := s.Client.DeleteModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -832,7 +831,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package ai_anomaly_detection
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -94,7 +92,7 @@ func (s *AiAnomalyDetectionModelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.ListModels(context.Background(), request)
+	response, err := s.Client.ListModels(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *AiAnomalyDetectionModelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModels(context.Background(), request)
+		listResponse, err := s.Client.ListModels(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package ai_anomaly_detection
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -185,7 +184,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateProject(context.Background(), request)
+	response, err := s.Client.CreateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +203,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) getProjectFromWorkRequest(workId
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, projectId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -263,7 +262,7 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -299,7 +298,7 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 }
 
 func getErrorFromAiAnomalyDetectionProjectWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -329,7 +328,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -377,7 +376,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateProject(context.Background(), request)
+	response, err := s.Client.UpdateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -394,7 +393,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteProject(context.Background(), request)
+	response, err := s.Client.DeleteProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -495,7 +494,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeProjectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeProjectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *AnalyticsAnalyticsInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
 
-	response, err := s.Client.GetPrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.GetPrivateAccessChannel(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
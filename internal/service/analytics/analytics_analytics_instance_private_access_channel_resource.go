@@ -4,7 +4,6 @@
 package analytics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -194,7 +193,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Create() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.CreatePrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.CreatePrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +203,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Create() er
 	getWorkRequestRequest := oci_analytics.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workId
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-	workRequestResponse, _ := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, _ := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	return returnError
 }
@@ -219,7 +218,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) getAnalytic
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, analyticsInstanceId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_analytics.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -237,7 +236,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) getAnalytic
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -300,7 +299,7 @@ func analyticsInstancePrivateAccessChannelWaitForWorkRequest(wId *string, entity
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_analytics.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -336,7 +335,7 @@ func analyticsInstancePrivateAccessChannelWaitForWorkRequest(wId *string, entity
 }
 
 func getErrorFromAnalyticsAnalyticsInstancePrivateAccessChannelWorkRequest(client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -370,7 +369,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetPrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.GetPrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -399,7 +398,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Update() er
 	getRequest.PrivateAccessChannelKey = request.PrivateAccessChannelKey
 	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	getResponse, err := s.Client.GetPrivateAccessChannel(context.Background(), getRequest)
+	getResponse, err := s.Client.GetPrivateAccessChannel(s.Context(), getRequest)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Update() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.UpdatePrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.UpdatePrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -470,7 +469,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Delete() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.DeletePrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.DeletePrivateAccessChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package analytics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -414,7 +413,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.CreateAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.CreateAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -433,7 +432,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) getAnalyticsInstanceFromWorkReq
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, analyticsInstanceId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_analytics.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -497,7 +496,7 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_analytics.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -533,7 +532,7 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromAnalyticsAnalyticsInstanceWorkRequest(client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -563,7 +562,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -615,7 +614,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.UpdateAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.UpdateAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -638,7 +637,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 			scaleRequest.Capacity = &tmp
 
 			scaleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-			scaleResponse, err := s.Client.ScaleAnalyticsInstance(context.Background(), scaleRequest)
+			scaleResponse, err := s.Client.ScaleAnalyticsInstance(s.Context(), scaleRequest)
 
 			if err != nil {
 				return err
@@ -660,7 +659,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.DeleteAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.DeleteAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -744,7 +743,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StartAnalyticsInstance() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	_, err := s.Client.StartAnalyticsInstance(context.Background(), request)
+	_, err := s.Client.StartAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -761,7 +760,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StopAnalyticsInstance() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	_, err := s.Client.StopAnalyticsInstance(context.Background(), request)
+	_, err := s.Client.StopAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -948,7 +947,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.ChangeAnalyticsInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAnalyticsInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
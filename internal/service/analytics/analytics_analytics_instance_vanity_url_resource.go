@@ -4,7 +4,6 @@
 package analytics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -179,7 +178,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Create() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-	response, err := s.Client.CreateVanityUrl(context.Background(), request)
+	response, err := s.Client.CreateVanityUrl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +189,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Create() error {
 	getWorkRequestRequest := oci_analytics.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workId
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	return returnError
 }
@@ -206,7 +205,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) getAnalyticsInstanceVa
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, analyticsInstanceId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_analytics.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -230,7 +229,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) getAnalyticsInstanceVa
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -313,7 +312,7 @@ func analyticsInstanceVanityUrlWaitForWorkRequest(wId *string, entityType string
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_analytics.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -350,7 +349,7 @@ func analyticsInstanceVanityUrlWaitForWorkRequest(wId *string, entityType string
 }
 
 func getErrorFromAnalyticsAnalyticsInstanceVanityUrlWorkRequest(client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -383,7 +382,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -432,7 +431,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.UpdateVanityUrl(context.Background(), request)
+	response, err := s.Client.UpdateVanityUrl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -456,7 +455,7 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.DeleteVanityUrl(context.Background(), request)
+	response, err := s.Client.DeleteVanityUrl(s.Context(), request)
 	if err != nil {
 		return err
 	}
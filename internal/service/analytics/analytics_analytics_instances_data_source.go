@@ -4,8 +4,6 @@
 package analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -92,7 +90,7 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
 
-	response, err := s.Client.ListAnalyticsInstances(context.Background(), request)
+	response, err := s.Client.ListAnalyticsInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +99,7 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnalyticsInstances(context.Background(), request)
+		listResponse, err := s.Client.ListAnalyticsInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package apigateway
 
 import (
-	"context"
 	"io/ioutil"
 	"log"
 
@@ -60,7 +59,7 @@ func (s *ApigatewayApiContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApiContent(context.Background(), request)
+	response, err := s.Client.GetApiContent(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
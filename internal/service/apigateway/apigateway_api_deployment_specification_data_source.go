@@ -4,7 +4,6 @@
 package apigateway
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -1219,7 +1218,7 @@ func (s *ApigatewayApiDeploymentSpecificationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApiDeploymentSpecification(context.Background(), request)
+	response, err := s.Client.GetApiDeploymentSpecification(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package apigateway
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -213,7 +212,7 @@ func (s *ApigatewayApiResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateApi(context.Background(), request)
+	response, err := s.Client.CreateApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +235,7 @@ func (s *ApigatewayApiResourceCrud) getApiFromWorkRequest(workId *string, retryP
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, apiId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(s.Context(),
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -295,7 +294,7 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -329,7 +328,7 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 }
 
 func getErrorFromApigatewayApiWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -359,7 +358,7 @@ func (s *ApigatewayApiResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetApi(context.Background(), request)
+	response, err := s.Client.GetApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -407,7 +406,7 @@ func (s *ApigatewayApiResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateApi(context.Background(), request)
+	response, err := s.Client.UpdateApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -428,7 +427,7 @@ func (s *ApigatewayApiResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteApi(context.Background(), request)
+	response, err := s.Client.DeleteApi(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -553,7 +552,7 @@ func (s *ApigatewayApiResourceCrud) updateCompartment(compartment interface{}) e
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeApiCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeApiCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -601,7 +600,7 @@ func apiWaitForValidation(apiId *string, timeout time.Duration, disableFoundRetr
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetApi(context.Background(),
+			response, err = client.GetApi(tfresource.GetStopContext(),
 				oci_apigateway.GetApiRequest{
 					ApiId: apiId,
 					RequestMetadata: oci_common.RequestMetadata{
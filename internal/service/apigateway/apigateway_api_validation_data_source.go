@@ -4,8 +4,6 @@
 package apigateway
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v55/apigateway"
 
@@ -110,7 +108,7 @@ func (s *ApigatewayApiValidationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApiValidations(context.Background(), request)
+	response, err := s.Client.GetApiValidations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package apigateway
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v55/apigateway"
 
@@ -85,7 +83,7 @@ func (s *ApigatewayApisDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.ListApis(context.Background(), request)
+	response, err := s.Client.ListApis(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *ApigatewayApisDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApis(context.Background(), request)
+		listResponse, err := s.Client.ListApis(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package apigateway
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -221,7 +220,7 @@ func (s *ApigatewayCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *ApigatewayCertificateResourceCrud) getCertificateFromWorkRequest(workId
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, certificateId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(s.Context(),
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -300,7 +299,7 @@ func certificateWaitForWorkRequest(wId *string, entityType string, action oci_ap
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -336,7 +335,7 @@ func certificateWaitForWorkRequest(wId *string, entityType string, action oci_ap
 }
 
 func getErrorFromApigatewayCertificateWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -366,7 +365,7 @@ func (s *ApigatewayCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -409,7 +408,7 @@ func (s *ApigatewayCertificateResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,7 +425,7 @@ func (s *ApigatewayCertificateResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	_, err := s.Client.DeleteCertificate(context.Background(), request)
+	_, err := s.Client.DeleteCertificate(s.Context(), request)
 	return err
 }
 
@@ -531,7 +530,7 @@ func (s *ApigatewayCertificateResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	_, err := s.Client.ChangeCertificateCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package apigateway
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v55/apigateway"
 
@@ -50,7 +48,7 @@ func (s *ApigatewayDeploymentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
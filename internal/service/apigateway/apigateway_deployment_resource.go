@@ -5,7 +5,6 @@ package apigateway
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -1549,7 +1548,7 @@ func (s *ApigatewayDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateDeployment(context.Background(), request)
+	response, err := s.Client.CreateDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1568,7 +1567,7 @@ func (s *ApigatewayDeploymentResourceCrud) getDeploymentFromWorkRequest(workId *
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, deploymentId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(s.Context(),
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -1627,7 +1626,7 @@ func deploymentWaitForWorkRequest(wId *string, entityType string, action oci_api
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1663,7 +1662,7 @@ func deploymentWaitForWorkRequest(wId *string, entityType string, action oci_api
 }
 
 func getErrorFromApigatewayDeploymentWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1693,7 +1692,7 @@ func (s *ApigatewayDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1747,7 +1746,7 @@ func (s *ApigatewayDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateDeployment(context.Background(), request)
+	response, err := s.Client.UpdateDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1764,7 +1763,7 @@ func (s *ApigatewayDeploymentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteDeployment(context.Background(), request)
+	response, err := s.Client.DeleteDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4073,7 +4072,7 @@ func (s *ApigatewayDeploymentResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeDeploymentCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDeploymentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
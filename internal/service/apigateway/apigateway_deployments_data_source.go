@@ -4,8 +4,6 @@
 package apigateway
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v55/apigateway"
 
@@ -86,7 +84,7 @@ func (s *ApigatewayDeploymentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	listResponse, err := s.Client.ListDeployments(context.Background(), request)
+	listResponse, err := s.Client.ListDeployments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +93,7 @@ func (s *ApigatewayDeploymentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployments(context.Background(), request)
+		listResponse, err := s.Client.ListDeployments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package apigateway
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -400,7 +399,7 @@ func (s *ApigatewayGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateGateway(context.Background(), request)
+	response, err := s.Client.CreateGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -419,7 +418,7 @@ func (s *ApigatewayGatewayResourceCrud) getGatewayFromWorkRequest(workId *string
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, gatewayId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(s.Context(),
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -478,7 +477,7 @@ func gatewayWaitForWorkRequest(wId *string, entityType string, action oci_apigat
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -514,7 +513,7 @@ func gatewayWaitForWorkRequest(wId *string, entityType string, action oci_apigat
 }
 
 func getErrorFromApigatewayGatewayWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -544,7 +543,7 @@ func (s *ApigatewayGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetGateway(context.Background(), request)
+	response, err := s.Client.GetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -634,7 +633,7 @@ func (s *ApigatewayGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateGateway(context.Background(), request)
+	response, err := s.Client.UpdateGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -651,7 +650,7 @@ func (s *ApigatewayGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteGateway(context.Background(), request)
+	response, err := s.Client.DeleteGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1026,7 +1025,7 @@ func (s *ApigatewayGatewayResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeGatewayCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package apm
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *ApmApmDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
 
-	response, err := s.Client.GetApmDomain(context.Background(), request)
+	response, err := s.Client.GetApmDomain(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
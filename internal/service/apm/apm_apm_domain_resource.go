@@ -4,7 +4,6 @@
 package apm
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -193,7 +192,7 @@ func (s *ApmApmDomainResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.CreateApmDomain(context.Background(), request)
+	response, err := s.Client.CreateApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +258,7 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_apm.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -295,7 +294,7 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 }
 
 func getErrorFromApmControlPlaneWorkRequest(client *oci_apm.ApmDomainClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apm.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_apm.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -325,7 +324,7 @@ func (s *ApmApmDomainResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.GetApmDomain(context.Background(), request)
+	response, err := s.Client.GetApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -373,7 +372,7 @@ func (s *ApmApmDomainResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.UpdateApmDomain(context.Background(), request)
+	response, err := s.Client.UpdateApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -390,7 +389,7 @@ func (s *ApmApmDomainResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.DeleteApmDomain(context.Background(), request)
+	response, err := s.Client.DeleteApmDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -453,7 +452,7 @@ func (s *ApmApmDomainResourceCrud) updateCompartment(compartment interface{}) er
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.ChangeApmDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeApmDomainCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
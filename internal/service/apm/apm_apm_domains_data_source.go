@@ -4,8 +4,6 @@
 package apm
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -76,7 +74,7 @@ func (s *ApmApmDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
 
-	response, err := s.Client.ListApmDomains(context.Background(), request)
+	response, err := s.Client.ListApmDomains(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *ApmApmDomainsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApmDomains(context.Background(), request)
+		listResponse, err := s.Client.ListApmDomains(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
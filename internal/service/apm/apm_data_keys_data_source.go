@@ -4,8 +4,6 @@
 package apm
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -87,7 +85,7 @@ func (s *ApmDataKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
 
-	response, err := s.Client.ListDataKeys(context.Background(), request)
+	response, err := s.Client.ListDataKeys(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package apm_config
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -67,7 +66,7 @@ func (s *ApmConfigConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_config")
 
-	response, err := s.Client.GetConfig(context.Background(), request)
+	response, err := s.Client.GetConfig(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package apm_config
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -266,7 +265,7 @@ func (s *ApmConfigConfigResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.CreateConfig(context.Background(), request)
+	response, err := s.Client.CreateConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -288,7 +287,7 @@ func (s *ApmConfigConfigResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.GetConfig(context.Background(), request)
+	response, err := s.Client.GetConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -306,7 +305,7 @@ func (s *ApmConfigConfigResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.UpdateConfig(context.Background(), request)
+	response, err := s.Client.UpdateConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -330,7 +329,7 @@ func (s *ApmConfigConfigResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	_, err := s.Client.DeleteConfig(context.Background(), request)
+	_, err := s.Client.DeleteConfig(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package apm_config
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -86,7 +84,7 @@ func (s *ApmConfigConfigsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_config")
 
-	response, err := s.Client.ListConfigs(context.Background(), request)
+	response, err := s.Client.ListConfigs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +93,7 @@ func (s *ApmConfigConfigsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConfigs(context.Background(), request)
+		listResponse, err := s.Client.ListConfigs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -67,7 +66,7 @@ func (s *ApmSyntheticsMonitorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.GetMonitor(context.Background(), request)
+	response, err := s.Client.GetMonitor(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -582,7 +581,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateMonitor(context.Background(), request)
+	response, err := s.Client.CreateMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -604,7 +603,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetMonitor(context.Background(), request)
+	response, err := s.Client.GetMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -724,7 +723,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateMonitor(context.Background(), request)
+	response, err := s.Client.UpdateMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -750,7 +749,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteMonitor(context.Background(), request)
+	_, err := s.Client.DeleteMonitor(s.Context(), request)
 	return err
 }
 
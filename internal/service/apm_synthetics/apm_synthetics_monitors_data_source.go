@@ -4,7 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -110,7 +109,7 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListMonitors(context.Background(), request)
+	response, err := s.Client.ListMonitors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -119,7 +118,7 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListMonitors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v55/apmsynthetics"
 
@@ -132,7 +130,7 @@ func (s *ApmSyntheticsPublicVantagePointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListPublicVantagePoints(context.Background(), request)
+	response, err := s.Client.ListPublicVantagePoints(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -66,7 +65,7 @@ func (s *ApmSyntheticsScriptDataSourceCrud) Get() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.GetScript(context.Background(), request)
+	response, err := s.Client.GetScript(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -282,7 +281,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateScript(context.Background(), request)
+	response, err := s.Client.CreateScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -304,7 +303,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetScript(context.Background(), request)
+	response, err := s.Client.GetScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -381,7 +380,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateScript(context.Background(), request)
+	response, err := s.Client.UpdateScript(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -412,7 +411,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Delete() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteScript(context.Background(), request)
+	_, err := s.Client.DeleteScript(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package apm_synthetics
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v55/apmsynthetics"
 
@@ -86,7 +84,7 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListScripts(context.Background(), request)
+	response, err := s.Client.ListScripts(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +93,7 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListScripts(context.Background(), request)
+		listResponse, err := s.Client.ListScripts(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package appmgmt_control
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -102,7 +101,7 @@ func (s *AppmgmtControlMonitorPluginManagementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "appmgmt_control")
 
-	response, err := s.Client.ActivateMonitoringPlugin(context.Background(), request)
+	response, err := s.Client.ActivateMonitoringPlugin(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +167,7 @@ func monitorPluginManagementWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_appmgmt_control.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -204,7 +203,7 @@ func monitorPluginManagementWaitForWorkRequest(wId *string, entityType string, a
 }
 
 func getErrorFromAppmgmtControlMonitorPluginManagementWorkRequest(client *oci_appmgmt_control.AppmgmtControlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_appmgmt_control.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_appmgmt_control.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -234,7 +233,7 @@ func (s *AppmgmtControlMonitorPluginManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "appmgmt_control")
 
-	response, err := s.Client.GetMonitoredInstance(context.Background(), request)
+	response, err := s.Client.GetMonitoredInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package appmgmt_control
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -90,7 +88,7 @@ func (s *AppmgmtControlMonitoredInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "appmgmt_control")
 
-	response, err := s.Client.GetMonitoredInstance(context.Background(), request)
+	response, err := s.Client.GetMonitoredInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
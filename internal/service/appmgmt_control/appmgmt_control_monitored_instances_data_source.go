@@ -4,8 +4,6 @@
 package appmgmt_control
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -120,7 +118,7 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "appmgmt_control")
 
-	response, err := s.Client.ListMonitoredInstances(context.Background(), request)
+	response, err := s.Client.ListMonitoredInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +127,7 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMonitoredInstances(context.Background(), request)
+		listResponse, err := s.Client.ListMonitoredInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
 
@@ -50,7 +48,7 @@ func (s *ArtifactsContainerConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetContainerConfiguration(context.Background(), request)
+	response, err := s.Client.GetContainerConfiguration(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
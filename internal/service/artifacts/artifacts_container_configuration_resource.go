@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
@@ -97,7 +95,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateContainerConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -116,7 +114,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerConfiguration(context.Background(), request)
+	response, err := s.Client.GetContainerConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +138,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateContainerConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package artifacts
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -157,7 +156,7 @@ func (s *ArtifactsContainerImageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetContainerImage(context.Background(), request)
+	response, err := s.Client.GetContainerImage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
@@ -155,7 +153,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateContainerImageSignature(context.Background(), request)
+	response, err := s.Client.CreateContainerImageSignature(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +170,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerImageSignature(context.Background(), request)
+	response, err := s.Client.GetContainerImageSignature(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +187,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteContainerImageSignature(context.Background(), request)
+	_, err := s.Client.DeleteContainerImageSignature(s.Context(), request)
 	return err
 }
 
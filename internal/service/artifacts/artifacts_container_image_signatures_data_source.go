@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
 
@@ -153,7 +151,7 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListContainerImageSignatures(context.Background(), request)
+	response, err := s.Client.ListContainerImageSignatures(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +160,7 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerImageSignatures(context.Background(), request)
+		listResponse, err := s.Client.ListContainerImageSignatures(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
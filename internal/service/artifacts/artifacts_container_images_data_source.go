@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
 
@@ -258,7 +256,7 @@ func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListContainerImages(context.Background(), request)
+	response, err := s.Client.ListContainerImages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +265,7 @@ func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerImages(context.Background(), request)
+		listResponse, err := s.Client.ListContainerImages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package artifacts
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -139,7 +138,7 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListContainerRepositories(context.Background(), request)
+	response, err := s.Client.ListContainerRepositories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +147,7 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListContainerRepositories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
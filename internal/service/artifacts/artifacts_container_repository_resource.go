@@ -4,7 +4,6 @@
 package artifacts
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -213,7 +212,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateContainerRepository(context.Background(), request)
+	response, err := s.Client.CreateContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -230,7 +229,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerRepository(context.Background(), request)
+	response, err := s.Client.GetContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -277,7 +276,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerRepository(context.Background(), request)
+	response, err := s.Client.UpdateContainerRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +293,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteContainerRepository(context.Background(), request)
+	_, err := s.Client.DeleteContainerRepository(s.Context(), request)
 	return err
 }
 
@@ -437,7 +436,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.ChangeContainerRepositoryCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerRepositoryCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
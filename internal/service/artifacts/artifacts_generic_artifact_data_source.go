@@ -4,7 +4,6 @@
 package artifacts
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *ArtifactsGenericArtifactDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetGenericArtifact(context.Background(), request)
+	response, err := s.Client.GetGenericArtifact(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
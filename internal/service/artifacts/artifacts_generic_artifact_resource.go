@@ -4,7 +4,6 @@
 package artifacts
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -175,7 +174,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
+	response, err := s.Client.UpdateGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +193,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetGenericArtifact(context.Background(), request)
+	response, err := s.Client.GetGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +224,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
+	response, err := s.Client.UpdateGenericArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +243,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteGenericArtifact(context.Background(), request)
+	_, err := s.Client.DeleteGenericArtifact(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
 
@@ -131,7 +129,7 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListGenericArtifacts(context.Background(), request)
+	response, err := s.Client.ListGenericArtifacts(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +138,7 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGenericArtifacts(context.Background(), request)
+		listResponse, err := s.Client.ListGenericArtifacts(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package artifacts
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v55/artifacts"
 
@@ -104,7 +102,7 @@ func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListRepositories(context.Background(), request)
+	response, err := s.Client.ListRepositories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +111,7 @@ func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListRepositories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
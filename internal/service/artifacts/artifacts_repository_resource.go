@@ -4,7 +4,6 @@
 package artifacts
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -158,7 +157,7 @@ func (s *ArtifactsRepositoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateRepository(context.Background(), request)
+	response, err := s.Client.CreateRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +174,7 @@ func (s *ArtifactsRepositoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +201,7 @@ func (s *ArtifactsRepositoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateRepository(context.Background(), request)
+	response, err := s.Client.UpdateRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -219,7 +218,7 @@ func (s *ArtifactsRepositoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteRepository(context.Background(), request)
+	_, err := s.Client.DeleteRepository(s.Context(), request)
 	return err
 }
 
@@ -405,7 +404,7 @@ func (s *ArtifactsRepositoryResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.ChangeRepositoryCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRepositoryCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package audit
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
@@ -51,7 +49,7 @@ func (s *AuditConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
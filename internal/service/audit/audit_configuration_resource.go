@@ -4,7 +4,6 @@
 package audit
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -95,7 +94,7 @@ func (s *AuditConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -119,7 +118,7 @@ func (s *AuditConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "audit")
 
-	_, err := s.Client.UpdateConfiguration(context.Background(), request)
+	_, err := s.Client.UpdateConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
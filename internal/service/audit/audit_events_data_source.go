@@ -4,7 +4,6 @@
 package audit
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -335,7 +334,7 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "audit")
 
-	response, err := s.Client.ListEvents(context.Background(), request)
+	response, err := s.Client.ListEvents(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -344,7 +343,7 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEvents(context.Background(), request)
+		listResponse, err := s.Client.ListEvents(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
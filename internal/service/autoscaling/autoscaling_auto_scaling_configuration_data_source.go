@@ -4,8 +4,6 @@
 package autoscaling
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_auto_scaling "github.com/oracle/oci-go-sdk/v55/autoscaling"
 
@@ -50,7 +48,7 @@ func (s *AutoScalingAutoScalingConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "auto_scaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package autoscaling
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -473,7 +472,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.CreateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.CreateAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -490,7 +489,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -552,7 +551,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -569,7 +568,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	_, err := s.Client.DeleteAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteAutoScalingConfiguration(s.Context(), request)
 	return err
 }
 
@@ -1163,7 +1162,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	_, err := s.Client.ChangeAutoScalingConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutoScalingConfigurationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package autoscaling
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_auto_scaling "github.com/oracle/oci-go-sdk/v55/autoscaling"
 
@@ -68,7 +66,7 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "auto_scaling")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +75,7 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListAutoScalingConfigurations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package bastion
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *BastionBastionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
 
-	response, err := s.Client.GetBastion(context.Background(), request)
+	response, err := s.Client.GetBastion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
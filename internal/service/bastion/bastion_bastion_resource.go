@@ -4,7 +4,6 @@
 package bastion
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -275,7 +274,7 @@ func (s *BastionBastionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.CreateBastion(context.Background(), request)
+	response, err := s.Client.CreateBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -341,7 +340,7 @@ func bastionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_bastion.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -377,7 +376,7 @@ func bastionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 }
 
 func getErrorFromBastionBastionWorkRequest(client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_bastion.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -407,7 +406,7 @@ func (s *BastionBastionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.GetBastion(context.Background(), request)
+	response, err := s.Client.GetBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -476,7 +475,7 @@ func (s *BastionBastionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.UpdateBastion(context.Background(), request)
+	response, err := s.Client.UpdateBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -493,7 +492,7 @@ func (s *BastionBastionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.DeleteBastion(context.Background(), request)
+	response, err := s.Client.DeleteBastion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -583,7 +582,7 @@ func (s *BastionBastionResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	_, err := s.Client.ChangeBastionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBastionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
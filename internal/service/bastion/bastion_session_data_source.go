@@ -4,8 +4,6 @@
 package bastion
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *BastionSessionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
 
-	response, err := s.Client.GetSession(context.Background(), request)
+	response, err := s.Client.GetSession(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package bastion
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -284,7 +283,7 @@ func (s *BastionSessionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.CreateSession(context.Background(), request)
+	response, err := s.Client.CreateSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -350,7 +349,7 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_bastion.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -387,7 +386,7 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 }
 
 func getErrorFromBastionSessionWorkRequest(client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_bastion.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -417,7 +416,7 @@ func (s *BastionSessionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.GetSession(context.Background(), request)
+	response, err := s.Client.GetSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -439,7 +438,7 @@ func (s *BastionSessionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.UpdateSession(context.Background(), request)
+	response, err := s.Client.UpdateSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -456,7 +455,7 @@ func (s *BastionSessionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.DeleteSession(context.Background(), request)
+	response, err := s.Client.DeleteSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
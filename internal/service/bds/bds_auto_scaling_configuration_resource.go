@@ -4,7 +4,6 @@
 package bds
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -267,7 +266,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.AddAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -349,7 +348,7 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -379,7 +378,7 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 }
 
 func getErrorFromBdsAutoScalingConfigurationWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -420,7 +419,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) List(compartmentId *string) (*
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -451,7 +450,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -499,7 +498,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -526,7 +525,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	_, err := s.Client.RemoveAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.RemoveAutoScalingConfiguration(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package bds
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -81,7 +79,7 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -90,7 +88,7 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListAutoScalingConfigurations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
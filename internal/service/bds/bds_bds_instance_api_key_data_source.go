@@ -4,8 +4,6 @@
 package bds
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *BdsBdsInstanceApiKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.GetBdsApiKey(context.Background(), request)
+	response, err := s.Client.GetBdsApiKey(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package bds
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -178,7 +177,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsApiKey(context.Background(), request)
+	response, err := s.Client.CreateBdsApiKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +243,7 @@ func bdsInstanceApiKeyWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -284,7 +283,7 @@ func bdsInstanceApiKeyWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromBdsBdsInstanceApiKeyWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -327,7 +326,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsApiKey(context.Background(), request)
+	response, err := s.Client.GetBdsApiKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -349,7 +348,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsApiKey(context.Background(), request)
+	response, err := s.Client.DeleteBdsApiKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
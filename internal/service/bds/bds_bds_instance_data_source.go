@@ -4,8 +4,6 @@
 package bds
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *BdsBdsInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.GetBdsInstance(context.Background(), request)
+	response, err := s.Client.GetBdsInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
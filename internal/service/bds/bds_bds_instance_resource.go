@@ -4,7 +4,6 @@
 package bds
 
 import (
-	"context"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -724,7 +723,7 @@ func (s *BdsBdsInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsInstance(context.Background(), request)
+	response, err := s.Client.CreateBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -790,7 +789,7 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -826,7 +825,7 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 }
 
 func getErrorFromBdsBdsInstanceWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -856,7 +855,7 @@ func (s *BdsBdsInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsInstance(context.Background(), request)
+	response, err := s.Client.GetBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1015,7 +1014,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 			tmp := s.D.Id()
 			changeShapeRequest.BdsInstanceId = &tmp
 
-			response, err := s.Client.ChangeShape(context.Background(), changeShapeRequest)
+			response, err := s.Client.ChangeShape(s.Context(), changeShapeRequest)
 			if err != nil {
 				return err
 			}
@@ -1052,7 +1051,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateBdsInstance(context.Background(), request)
+	response, err := s.Client.UpdateBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1069,7 +1068,7 @@ func (s *BdsBdsInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsInstance(context.Background(), request)
+	response, err := s.Client.DeleteBdsInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1409,7 +1408,7 @@ func (s *BdsBdsInstanceResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.ChangeBdsInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBdsInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -1429,7 +1428,7 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerBlockStorage(id string, cluster
 
 	addBlockStorageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddBlockStorage(context.Background(), addBlockStorageRequest)
+	response, err := s.Client.AddBlockStorage(s.Context(), addBlockStorageRequest)
 	if err != nil {
 		return err
 	}
@@ -1449,7 +1448,7 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(id string, clusterAdminPas
 
 	addWorkerNodesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddWorkerNodes(context.Background(), addWorkerNodesRequest)
+	response, err := s.Client.AddWorkerNodes(s.Context(), addWorkerNodesRequest)
 	if err != nil {
 		return err
 	}
@@ -1458,7 +1457,7 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(id string, clusterAdminPas
 }
 
 func (s *BdsBdsInstanceResourceCrud) addCloudSql(request oci_bds.AddCloudSqlRequest) error {
-	response, err := s.Client.AddCloudSql(context.Background(), request)
+	response, err := s.Client.AddCloudSql(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1467,7 +1466,7 @@ func (s *BdsBdsInstanceResourceCrud) addCloudSql(request oci_bds.AddCloudSqlRequ
 }
 
 func (s *BdsBdsInstanceResourceCrud) deleteCloudSql(request oci_bds.RemoveCloudSqlRequest) error {
-	response, err := s.Client.RemoveCloudSql(context.Background(), request)
+	response, err := s.Client.RemoveCloudSql(s.Context(), request)
 	if err != nil {
 		return err
 	}
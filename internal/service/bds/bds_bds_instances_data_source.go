@@ -4,8 +4,6 @@
 package bds
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -77,7 +75,7 @@ func (s *BdsBdsInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListBdsInstances(context.Background(), request)
+	response, err := s.Client.ListBdsInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +94,7 @@ func (s *BdsBdsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBdsInstances(context.Background(), request)
+		listResponse, err := s.Client.ListBdsInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
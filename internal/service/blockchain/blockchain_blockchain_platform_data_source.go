@@ -4,8 +4,6 @@
 package blockchain
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *BlockchainBlockchainPlatformDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.GetBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.GetBlockchainPlatform(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
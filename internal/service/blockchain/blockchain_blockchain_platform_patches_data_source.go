@@ -4,8 +4,6 @@
 package blockchain
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -104,7 +102,7 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.ListBlockchainPlatformPatches(context.Background(), request)
+	response, err := s.Client.ListBlockchainPlatformPatches(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +111,7 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockchainPlatformPatches(context.Background(), request)
+		listResponse, err := s.Client.ListBlockchainPlatformPatches(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
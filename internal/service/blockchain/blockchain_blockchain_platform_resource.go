@@ -4,7 +4,6 @@
 package blockchain
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -468,7 +467,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.CreateBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -501,7 +500,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) getBlockchainPlatformFromWork
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -560,7 +559,7 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -598,7 +597,7 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromBlockchainPlatformWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -628,7 +627,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.GetBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -723,7 +722,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.UpdateBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -740,7 +739,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.DeleteBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.DeleteBlockchainPlatform(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1038,7 +1037,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.ChangeBlockchainPlatformCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBlockchainPlatformCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package blockchain
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -152,7 +151,7 @@ func (s *BlockchainOsnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreateOsn(context.Background(), request)
+	response, err := s.Client.CreateOsn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -171,7 +170,7 @@ func (s *BlockchainOsnResourceCrud) getOsnFromWorkRequest(blockchainPlatformId *
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -232,7 +231,7 @@ func osnWaitForWorkRequest(wId *string, entityType string, action oci_blockchain
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -273,7 +272,7 @@ func osnWaitForWorkRequest(wId *string, entityType string, action oci_blockchain
 }
 
 func getErrorFromBlockchainOsnWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -316,7 +315,7 @@ func (s *BlockchainOsnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetOsn(context.Background(), request)
+	response, err := s.Client.GetOsn(s.Context(), request)
 	if err != nil {
 		return err
 	}
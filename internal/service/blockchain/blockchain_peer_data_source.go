@@ -4,8 +4,6 @@
 package blockchain
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *BlockchainPeerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.GetPeer(context.Background(), request)
+	response, err := s.Client.GetPeer(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
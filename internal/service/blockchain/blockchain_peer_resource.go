@@ -4,7 +4,6 @@
 package blockchain
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -184,7 +183,7 @@ func (s *BlockchainPeerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreatePeer(context.Background(), request)
+	response, err := s.Client.CreatePeer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +202,7 @@ func (s *BlockchainPeerResourceCrud) getPeerFromWorkRequest(blockchainPlatformId
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -266,7 +265,7 @@ func peerWaitForWorkRequest(wId *string, entityType string, action oci_blockchai
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -306,7 +305,7 @@ func peerWaitForWorkRequest(wId *string, entityType string, action oci_blockchai
 }
 
 func getErrorFromBlockchainPeerWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -349,7 +348,7 @@ func (s *BlockchainPeerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetPeer(context.Background(), request)
+	response, err := s.Client.GetPeer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -382,7 +381,7 @@ func (s *BlockchainPeerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdatePeer(context.Background(), request)
+	response, err := s.Client.UpdatePeer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -411,7 +410,7 @@ func (s *BlockchainPeerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.DeletePeer(context.Background(), request)
+	response, err := s.Client.DeletePeer(s.Context(), request)
 	if err != nil {
 		return err
 	}
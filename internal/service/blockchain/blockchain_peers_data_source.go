@@ -4,8 +4,6 @@
 package blockchain
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -77,7 +75,7 @@ func (s *BlockchainPeersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.ListPeers(context.Background(), request)
+	response, err := s.Client.ListPeers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *BlockchainPeersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPeers(context.Background(), request)
+		listResponse, err := s.Client.ListPeers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package blockchain
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -34,7 +33,7 @@ func blockchainPlatformComputeShapeDiffSuppressFunction(key string, old string,
 func sendUpdateBlockchainPlatformRequest(s *BlockchainBlockchainPlatformResourceCrud, request oci_blockchain.UpdateBlockchainPlatformRequest) error {
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.UpdateBlockchainPlatform(tfresource.GetStopContext(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package budget
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v55/budget"
 
@@ -59,7 +57,7 @@ func (s *BudgetAlertRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
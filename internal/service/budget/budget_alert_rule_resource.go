@@ -4,7 +4,6 @@
 package budget
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -223,7 +222,7 @@ func (s *BudgetAlertRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateAlertRule(context.Background(), request)
+	response, err := s.Client.CreateAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -253,7 +252,7 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -320,7 +319,7 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateAlertRule(context.Background(), request)
+	response, err := s.Client.UpdateAlertRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,7 +341,7 @@ func (s *BudgetAlertRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteAlertRule(context.Background(), request)
+	_, err := s.Client.DeleteAlertRule(s.Context(), request)
 	return err
 }
 
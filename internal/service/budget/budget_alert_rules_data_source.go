@@ -4,8 +4,6 @@
 package budget
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v55/budget"
 
@@ -76,7 +74,7 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.ListAlertRules(context.Background(), request)
+	response, err := s.Client.ListAlertRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlertRules(context.Background(), request)
+		listResponse, err := s.Client.ListAlertRules(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package budget
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v55/budget"
 
@@ -50,7 +48,7 @@ func (s *BudgetBudgetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package budget
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v55/budget"
 
@@ -261,7 +259,7 @@ func (s *BudgetBudgetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateBudget(context.Background(), request)
+	response, err := s.Client.CreateBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -278,7 +276,7 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -331,7 +329,7 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateBudget(context.Background(), request)
+	response, err := s.Client.UpdateBudget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -348,7 +346,7 @@ func (s *BudgetBudgetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteBudget(context.Background(), request)
+	_, err := s.Client.DeleteBudget(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package budget
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v55/budget"
 
@@ -84,7 +82,7 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.ListBudgets(context.Background(), request)
+	response, err := s.Client.ListBudgets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +91,7 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBudgets(context.Background(), request)
+		listResponse, err := s.Client.ListBudgets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
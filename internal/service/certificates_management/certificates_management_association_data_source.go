@@ -4,8 +4,6 @@
 package certificates_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -82,7 +80,7 @@ func (s *CertificatesManagementAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetAssociation(context.Background(), request)
+	response, err := s.Client.GetAssociation(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
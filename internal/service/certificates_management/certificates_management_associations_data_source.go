@@ -4,8 +4,6 @@
 package certificates_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -159,7 +157,7 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListAssociations(context.Background(), request)
+	response, err := s.Client.ListAssociations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +166,7 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAssociations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package certificates_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *CertificatesManagementCaBundleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCaBundle(context.Background(), request)
+	response, err := s.Client.GetCaBundle(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package certificates_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -195,7 +193,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCaBundle(context.Background(), request)
+	response, err := s.Client.CreateCaBundle(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +210,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCaBundle(context.Background(), request)
+	response, err := s.Client.GetCaBundle(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -261,7 +259,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Update() error {
 	if request.CaBundlePem != nil || request.DefinedTags != nil || request.Description != nil || request.FreeformTags != nil {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-		response, err := s.Client.UpdateCaBundle(context.Background(), request)
+		response, err := s.Client.UpdateCaBundle(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -280,7 +278,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.DeleteCaBundle(context.Background(), request)
+	_, err := s.Client.DeleteCaBundle(s.Context(), request)
 	return err
 }
 
@@ -369,7 +367,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCaBundleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCaBundleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
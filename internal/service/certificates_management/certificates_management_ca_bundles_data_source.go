@@ -4,8 +4,6 @@
 package certificates_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -98,7 +96,7 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCaBundles(context.Background(), request)
+	response, err := s.Client.ListCaBundles(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -107,7 +105,7 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCaBundles(context.Background(), request)
+		listResponse, err := s.Client.ListCaBundles(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package certificates_management
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -709,7 +708,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCertificateAuthority(context.Background(), request)
+	response, err := s.Client.CreateCertificateAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -726,7 +725,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthority(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -796,7 +795,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error
 		tmp := s.D.Id()
 		request.CertificateAuthorityId = &tmp
 
-		response, err := s.Client.UpdateCertificateAuthority(context.Background(), request)
+		response, err := s.Client.UpdateCertificateAuthority(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -841,7 +840,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) UpdateRules() e
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.UpdateCertificateAuthority(context.Background(), request)
+	response, err := s.Client.UpdateCertificateAuthority(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -865,7 +864,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.CertificateAuthorityId = &tmp
 
-	_, err := s.Client.ScheduleCertificateAuthorityDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleCertificateAuthorityDeletion(s.Context(), request)
 	return err
 }
 
@@ -1425,7 +1424,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) updateCompartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCertificateAuthorityCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateAuthorityCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package certificates_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -167,7 +166,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthorityVersion(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthorityVersion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
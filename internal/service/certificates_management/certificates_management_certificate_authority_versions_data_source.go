@@ -4,7 +4,6 @@
 package certificates_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -169,7 +168,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificateAuthorityVersions(context.Background(), request)
+	response, err := s.Client.ListCertificateAuthorityVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +177,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get()
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateAuthorityVersions(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateAuthorityVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
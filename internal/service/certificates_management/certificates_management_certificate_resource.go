@@ -4,7 +4,6 @@
 package certificates_management
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -756,7 +755,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -773,7 +772,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -838,7 +837,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Update() error {
 		tmp := s.D.Id()
 		request.CertificateId = &tmp
 
-		response, err := s.Client.UpdateCertificate(context.Background(), request)
+		response, err := s.Client.UpdateCertificate(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -884,7 +883,7 @@ func (s *CertificatesManagementCertificateResourceCrud) UpdateRules() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -908,7 +907,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
 
-	_, err := s.Client.ScheduleCertificateDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleCertificateDeletion(s.Context(), request)
 	return err
 }
 
@@ -1404,7 +1403,7 @@ func (s *CertificatesManagementCertificateResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCertificateCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
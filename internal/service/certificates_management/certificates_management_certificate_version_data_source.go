@@ -4,7 +4,6 @@
 package certificates_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -163,7 +162,7 @@ func (s *CertificatesManagementCertificateVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCertificateVersion(context.Background(), request)
+	response, err := s.Client.GetCertificateVersion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package certificates_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -190,7 +189,7 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificateVersions(context.Background(), request)
+	response, err := s.Client.ListCertificateVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -199,7 +198,7 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateVersions(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
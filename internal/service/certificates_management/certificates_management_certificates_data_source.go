@@ -4,8 +4,6 @@
 package certificates_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -109,7 +107,7 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +116,7 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificates(context.Background(), request)
+		listResponse, err := s.Client.ListCertificates(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
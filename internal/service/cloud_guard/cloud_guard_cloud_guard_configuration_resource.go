@@ -4,7 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -116,7 +115,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -141,7 +140,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +173,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
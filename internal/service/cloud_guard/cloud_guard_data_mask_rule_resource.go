@@ -4,7 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -275,7 +274,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDataMaskRule(context.Background(), request)
+	response, err := s.Client.CreateDataMaskRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +291,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDataMaskRule(context.Background(), request)
+	response, err := s.Client.GetDataMaskRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -364,7 +363,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDataMaskRule(context.Background(), request)
+	response, err := s.Client.UpdateDataMaskRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -381,7 +380,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteDataMaskRule(context.Background(), request)
+	_, err := s.Client.DeleteDataMaskRule(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v55/cloudguard"
 
@@ -128,7 +126,7 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListDataMaskRules(context.Background(), request)
+	response, err := s.Client.ListDataMaskRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +135,7 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataMaskRules(context.Background(), request)
+		listResponse, err := s.Client.ListDataMaskRules(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -585,7 +584,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDetectorRecipe(context.Background(), request)
+	response, err := s.Client.CreateDetectorRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -602,7 +601,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDetectorRecipe(context.Background(), request)
+	response, err := s.Client.GetDetectorRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -667,7 +666,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDetectorRecipe(context.Background(), request)
+	response, err := s.Client.UpdateDetectorRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -684,7 +683,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteDetectorRecipe(context.Background(), request)
+	_, err := s.Client.DeleteDetectorRecipe(s.Context(), request)
 	return err
 }
 
@@ -1041,7 +1040,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeDetectorRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDetectorRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v55/cloudguard"
 
@@ -111,7 +109,7 @@ func (s *CloudGuardDetectorRecipesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListDetectorRecipes(context.Background(), request)
+	response, err := s.Client.ListDetectorRecipes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +118,7 @@ func (s *CloudGuardDetectorRecipesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDetectorRecipes(context.Background(), request)
+		listResponse, err := s.Client.ListDetectorRecipes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v55/cloudguard"
 
@@ -50,7 +48,7 @@ func (s *CloudGuardManagedListDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetManagedList(context.Background(), request)
+	response, err := s.Client.GetManagedList(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
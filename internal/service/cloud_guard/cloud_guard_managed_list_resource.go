@@ -4,8 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v55/cloudguard"
@@ -232,7 +230,7 @@ func (s *CloudGuardManagedListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateManagedList(context.Background(), request)
+	response, err := s.Client.CreateManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,7 +247,7 @@ func (s *CloudGuardManagedListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetManagedList(context.Background(), request)
+	response, err := s.Client.GetManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,7 +308,7 @@ func (s *CloudGuardManagedListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateManagedList(context.Background(), request)
+	response, err := s.Client.UpdateManagedList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +325,7 @@ func (s *CloudGuardManagedListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteManagedList(context.Background(), request)
+	_, err := s.Client.DeleteManagedList(s.Context(), request)
 	return err
 }
 
@@ -456,7 +454,7 @@ func (s *CloudGuardManagedListResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeManagedListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -447,7 +446,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateResponderRecipe(context.Background(), request)
+	response, err := s.Client.CreateResponderRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -464,7 +463,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetResponderRecipe(context.Background(), request)
+	response, err := s.Client.GetResponderRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -529,7 +528,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateResponderRecipe(context.Background(), request)
+	response, err := s.Client.UpdateResponderRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -546,7 +545,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteResponderRecipe(context.Background(), request)
+	_, err := s.Client.DeleteResponderRecipe(s.Context(), request)
 	return err
 }
 
@@ -799,7 +798,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeResponderRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeResponderRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package cloud_guard
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -940,7 +939,7 @@ func (s *CloudGuardTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateTarget(context.Background(), request)
+	response, err := s.Client.CreateTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -957,7 +956,7 @@ func (s *CloudGuardTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetTarget(context.Background(), request)
+	response, err := s.Client.GetTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1029,7 +1028,7 @@ func (s *CloudGuardTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateTarget(context.Background(), request)
+	response, err := s.Client.UpdateTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1046,7 +1045,7 @@ func (s *CloudGuardTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteTarget(context.Background(), request)
+	_, err := s.Client.DeleteTarget(s.Context(), request)
 	return err
 }
 
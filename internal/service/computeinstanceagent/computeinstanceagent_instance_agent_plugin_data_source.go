@@ -4,8 +4,6 @@
 package computeinstanceagent
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -88,7 +86,7 @@ func (s *ComputeinstanceagentInstanceAgentPluginDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
-	response, err := s.Client.GetInstanceAgentPlugin(context.Background(), request)
+	response, err := s.Client.GetInstanceAgentPlugin(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package computeinstanceagent
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -109,7 +107,7 @@ func (s *ComputeinstanceagentInstanceAgentPluginsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
-	response, err := s.Client.ListInstanceAgentPlugins(context.Background(), request)
+	response, err := s.Client.ListInstanceAgentPlugins(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package computeinstanceagent
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -110,7 +108,7 @@ func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
-	response, err := s.Client.ListInstanceagentAvailablePlugins(context.Background(), request)
+	response, err := s.Client.ListInstanceagentAvailablePlugins(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
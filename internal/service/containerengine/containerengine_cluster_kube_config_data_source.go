@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -86,7 +84,7 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.CreateKubeconfig(context.Background(), request)
+	response, err := s.Client.CreateKubeconfig(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -70,7 +68,7 @@ func (s *ContainerengineClusterOptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetClusterOptions(context.Background(), request)
+	response, err := s.Client.GetClusterOptions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
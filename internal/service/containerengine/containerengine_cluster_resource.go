@@ -4,7 +4,6 @@
 package containerengine
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -471,7 +470,7 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.CreateCluster(context.Background(), request)
+	response, err := s.Client.CreateCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -490,7 +489,7 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 			delReq.ClusterId = clusterID
 			delReq.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-			delRes, delErr := s.Client.DeleteCluster(context.Background(), delReq)
+			delRes, delErr := s.Client.DeleteCluster(s.Context(), delReq)
 			if delErr != nil {
 				return err
 			}
@@ -508,7 +507,7 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 	requestGet := oci_containerengine.GetClusterRequest{}
 	requestGet.ClusterId = clusterID
 	requestGet.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetCluster(context.Background(), requestGet)
+	responseGet, err := s.Client.GetCluster(s.Context(), requestGet)
 	if err != nil {
 		return err
 	}
@@ -572,7 +571,7 @@ func clusterWaitForWorkRequest(wId *string, entityType string, action oci_contai
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_containerengine.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -613,7 +612,7 @@ func clusterWaitForWorkRequest(wId *string, entityType string, action oci_contai
 }
 
 func getErrorFromContainerengineClusterWorkRequest(client *oci_containerengine.ContainerEngineClient, workId *string, compartmentId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_containerengine.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			CompartmentId: compartmentId,
@@ -644,7 +643,7 @@ func (s *ContainerengineClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.GetCluster(context.Background(), request)
+	response, err := s.Client.GetCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -709,7 +708,7 @@ func (s *ContainerengineClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.UpdateCluster(context.Background(), request)
+	response, err := s.Client.UpdateCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -729,7 +728,7 @@ func (s *ContainerengineClusterResourceCrud) updateClusterEndpointConfig(cluster
 		request.UpdateClusterEndpointConfigDetails = tmp
 	}
 
-	response, err := s.Client.UpdateClusterEndpointConfig(context.Background(), request)
+	response, err := s.Client.UpdateClusterEndpointConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -751,7 +750,7 @@ func (s *ContainerengineClusterResourceCrud) migrateClusterToNativeVCN(clusterID
 		request.ClusterMigrateToNativeVcnDetails = tmp
 	}
 
-	response, err := s.Client.ClusterMigrateToNativeVcn(context.Background(), request)
+	response, err := s.Client.ClusterMigrateToNativeVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -768,7 +767,7 @@ func (s *ContainerengineClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.DeleteCluster(context.Background(), request)
+	response, err := s.Client.DeleteCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
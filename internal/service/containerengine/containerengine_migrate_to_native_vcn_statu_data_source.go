@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -62,7 +60,7 @@ func (s *ContainerengineMigrateToNativeVcnStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetClusterMigrateToNativeVcnStatus(context.Background(), request)
+	response, err := s.Client.GetClusterMigrateToNativeVcnStatus(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *ContainerengineNodePoolDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetNodePool(context.Background(), request)
+	response, err := s.Client.GetNodePool(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -109,7 +107,7 @@ func (s *ContainerengineNodePoolOptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetNodePoolOptions(context.Background(), request)
+	response, err := s.Client.GetNodePoolOptions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package containerengine
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -538,7 +537,7 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.CreateNodePool(context.Background(), request)
+	response, err := s.Client.CreateNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -557,7 +556,7 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 			delReq.NodePoolId = nodePoolID
 			delReq.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-			delRes, delErr := s.Client.DeleteNodePool(context.Background(), delReq)
+			delRes, delErr := s.Client.DeleteNodePool(s.Context(), delReq)
 			if delErr != nil {
 				return err
 			}
@@ -576,7 +575,7 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 	requestGet := oci_containerengine.GetNodePoolRequest{}
 	requestGet.NodePoolId = nodePoolID
 	requestGet.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetNodePool(context.Background(), requestGet)
+	responseGet, err := s.Client.GetNodePool(s.Context(), requestGet)
 	if err != nil {
 		return err
 	}
@@ -639,7 +638,7 @@ func nodePoolWaitForWorkRequest(wId *string, entityType string, action oci_conta
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_containerengine.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -680,7 +679,7 @@ func nodePoolWaitForWorkRequest(wId *string, entityType string, action oci_conta
 }
 
 func getErrorFromContainerengineNodePoolWorkRequest(client *oci_containerengine.ContainerEngineClient, workId *string, compartmentId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_containerengine.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			CompartmentId: compartmentId,
@@ -711,7 +710,7 @@ func (s *ContainerengineNodePoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.GetNodePool(context.Background(), request)
+	response, err := s.Client.GetNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -823,7 +822,7 @@ func (s *ContainerengineNodePoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.UpdateNodePool(context.Background(), request)
+	response, err := s.Client.UpdateNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -840,7 +839,7 @@ func (s *ContainerengineNodePoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.DeleteNodePool(context.Background(), request)
+	response, err := s.Client.DeleteNodePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
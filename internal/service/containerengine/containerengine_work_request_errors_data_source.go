@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -88,7 +86,7 @@ func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListWorkRequestErrors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -84,7 +82,7 @@ func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListWorkRequestLogs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package containerengine
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -166,7 +164,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequests(context.Background(), request)
+	response, err := s.Client.ListWorkRequests(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +173,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWorkRequests(context.Background(), request)
+		listResponse, err := s.Client.ListWorkRequests(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -82,7 +80,7 @@ func (s *CoreAppCatalogListingDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListing(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListing(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -103,7 +102,7 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListingAgreements(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingAgreements(s.Context(), request)
 	if err != nil {
 		return err
 	}
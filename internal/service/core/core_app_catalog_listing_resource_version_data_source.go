@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListingResourceVersion(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingResourceVersion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
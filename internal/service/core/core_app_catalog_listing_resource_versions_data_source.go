@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -111,7 +109,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListingResourceVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +118,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
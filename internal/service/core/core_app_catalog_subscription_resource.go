@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -176,7 +175,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.CreateAppCatalogSubscription(context.Background(), request)
+	_, err := s.Client.CreateAppCatalogSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,7 +198,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -224,7 +223,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	for !isFound && response.OpcNextPage != nil {
 		request.Page = response.OpcNextPage
-		response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		response, err := s.Client.ListAppCatalogSubscriptions(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -262,7 +261,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Delete() error {
 	request.ResourceVersion = &listingResourceVersion
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.DeleteAppCatalogSubscription(context.Background(), request)
+	_, err = s.Client.DeleteAppCatalogSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
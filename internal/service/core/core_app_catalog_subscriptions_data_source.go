@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -68,7 +66,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +75,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogSubscriptions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
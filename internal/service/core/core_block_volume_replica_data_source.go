@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -101,7 +100,7 @@ func (s *CoreBlockVolumeReplicaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBlockVolumeReplica(context.Background(), request)
+	response, err := s.Client.GetBlockVolumeReplica(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
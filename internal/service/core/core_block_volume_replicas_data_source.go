@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -144,7 +143,7 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBlockVolumeReplicas(context.Background(), request)
+	response, err := s.Client.ListBlockVolumeReplicas(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -153,7 +152,7 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockVolumeReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListBlockVolumeReplicas(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
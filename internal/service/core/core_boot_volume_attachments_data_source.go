@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -136,7 +134,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -145,7 +143,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeAttachments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
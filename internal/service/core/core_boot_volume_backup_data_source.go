@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *CoreBootVolumeBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -306,7 +305,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackup() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -356,7 +355,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 		copyBootVolumeBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyBootVolumeBackup(context.Background(), copyBootVolumeBackupRequest)
+	response, err := s.SourceRegionClient.CopyBootVolumeBackup(s.Context(), copyBootVolumeBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -383,7 +382,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -438,7 +437,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateBootVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -455,7 +454,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteBootVolumeBackup(s.Context(), request)
 	return err
 }
 
@@ -534,7 +533,7 @@ func (s *CoreBootVolumeBackupResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeBootVolumeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBootVolumeBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
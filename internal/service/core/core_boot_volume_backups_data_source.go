@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -95,7 +94,7 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumeBackups(context.Background(), request)
+	response, err := s.Client.ListBootVolumeBackups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +103,7 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeBackups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
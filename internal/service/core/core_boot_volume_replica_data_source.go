@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -105,7 +104,7 @@ func (s *CoreBootVolumeReplicaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBootVolumeReplica(context.Background(), request)
+	response, err := s.Client.GetBootVolumeReplica(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -148,7 +147,7 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumeReplicas(context.Background(), request)
+	response, err := s.Client.ListBootVolumeReplicas(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -157,7 +156,7 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeReplicas(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -370,7 +369,7 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolume(context.Background(), request)
+	response, err := s.Client.CreateBootVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +386,7 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolume(context.Background(), request)
+	response, err := s.Client.GetBootVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -468,7 +467,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 
 		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateBootVolumeKmsKey(s.Context(), keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -494,7 +493,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolume(context.Background(), request)
+	response, err := s.Client.UpdateBootVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -511,7 +510,7 @@ func (s *CoreBootVolumeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolume(context.Background(), request)
+	_, err := s.Client.DeleteBootVolume(s.Context(), request)
 	return err
 }
 
@@ -718,7 +717,7 @@ func (s *CoreBootVolumeResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeBootVolumeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBootVolumeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
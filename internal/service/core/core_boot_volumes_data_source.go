@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -78,7 +77,7 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumes(context.Background(), request)
+	response, err := s.Client.ListBootVolumes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +86,7 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
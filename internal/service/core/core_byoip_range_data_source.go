@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -104,7 +102,7 @@ func (s *CoreByoipRangeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetByoipRange(context.Background(), request)
+	response, err := s.Client.GetByoipRange(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -150,7 +148,7 @@ func (s *CoreByoipRangesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListByoipRanges(context.Background(), request)
+	response, err := s.Client.ListByoipRanges(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -159,7 +157,7 @@ func (s *CoreByoipRangesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListByoipRanges(context.Background(), request)
+		listResponse, err := s.Client.ListByoipRanges(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *CoreClusterNetworkDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetClusterNetwork(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -397,7 +396,7 @@ func (s *CoreClusterNetworkResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateClusterNetwork(context.Background(), request)
+	response, err := s.Client.CreateClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -414,7 +413,7 @@ func (s *CoreClusterNetworkResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -474,7 +473,7 @@ func (s *CoreClusterNetworkResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateClusterNetwork(context.Background(), request)
+	response, err := s.Client.UpdateClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -491,7 +490,7 @@ func (s *CoreClusterNetworkResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateClusterNetwork(context.Background(), request)
+	_, err := s.Client.TerminateClusterNetwork(s.Context(), request)
 	return err
 }
 
@@ -749,7 +748,7 @@ func (s *CoreClusterNetworkResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeClusterNetworkCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeClusterNetworkCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
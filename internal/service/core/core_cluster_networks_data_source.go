@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -76,7 +74,7 @@ func (s *CoreClusterNetworksDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListClusterNetworks(context.Background(), request)
+	response, err := s.Client.ListClusterNetworks(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *CoreClusterNetworksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusterNetworks(context.Background(), request)
+		listResponse, err := s.Client.ListClusterNetworks(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -128,7 +126,7 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeCapacityReservationInstances(context.Background(), request)
+	response, err := s.Client.ListComputeCapacityReservationInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +135,7 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeCapacityReservationInstances(context.Background(), request)
+		listResponse, err := s.Client.ListComputeCapacityReservationInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -276,7 +275,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.CreateComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -304,7 +303,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.GetComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -369,7 +368,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.UpdateComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -393,7 +392,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.DeleteComputeCapacityReservation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -564,7 +563,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeComputeCapacityReservationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeComputeCapacityReservationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
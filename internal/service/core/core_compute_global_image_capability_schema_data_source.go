@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -80,7 +78,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeGlobalImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeGlobalImageCapabilitySchema(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -106,7 +104,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeGlobalImageCapabilitySchemas(context.Background(), request)
+	response, err := s.Client.ListComputeGlobalImageCapabilitySchemas(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +113,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemas(context.Background(), request)
+		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemas(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -80,7 +78,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeGlobalImageCapabilitySchemaVersion(context.Background(), request)
+	response, err := s.Client.GetComputeGlobalImageCapabilitySchemaVersion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -97,7 +95,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(context.Background(), request)
+	response, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +104,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(context.Background(), request)
+		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -63,7 +62,7 @@ func (s *CoreComputeImageCapabilitySchemaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeImageCapabilitySchema(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -172,7 +171,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.CreateComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +193,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +244,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.UpdateComputeImageCapabilitySchema(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +261,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteComputeImageCapabilitySchema(context.Background(), request)
+	_, err := s.Client.DeleteComputeImageCapabilitySchema(s.Context(), request)
 	return err
 }
 
@@ -315,7 +314,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeComputeImageCapabilitySchemaCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeComputeImageCapabilitySchemaCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -76,7 +74,7 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetConsoleHistoryContent(context.Background(), request)
+	response, err := s.Client.GetConsoleHistoryContent(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -158,7 +156,7 @@ func (s *CoreConsoleHistoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CaptureConsoleHistory(context.Background(), request)
+	response, err := s.Client.CaptureConsoleHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +173,7 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetConsoleHistory(context.Background(), request)
+	response, err := s.Client.GetConsoleHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +207,7 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
+	response, err := s.Client.UpdateConsoleHistory(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -226,7 +224,7 @@ func (s *CoreConsoleHistoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
+	_, err := s.Client.DeleteConsoleHistory(s.Context(), request)
 	return err
 }
 
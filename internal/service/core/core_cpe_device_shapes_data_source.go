@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -89,7 +87,7 @@ func (s *CoreCpeDeviceShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListCpeDeviceShapes(context.Background(), request)
+	response, err := s.Client.ListCpeDeviceShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -98,7 +96,7 @@ func (s *CoreCpeDeviceShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCpeDeviceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListCpeDeviceShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -152,7 +150,7 @@ func (s *CoreCpeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCpe(context.Background(), request)
+	response, err := s.Client.CreateCpe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +167,7 @@ func (s *CoreCpeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCpe(context.Background(), request)
+	response, err := s.Client.GetCpe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +215,7 @@ func (s *CoreCpeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCpe(context.Background(), request)
+	response, err := s.Client.UpdateCpe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -234,7 +232,7 @@ func (s *CoreCpeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCpe(context.Background(), request)
+	_, err := s.Client.DeleteCpe(s.Context(), request)
 	return err
 }
 
@@ -279,7 +277,7 @@ func (s *CoreCpeResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCpeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCpeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
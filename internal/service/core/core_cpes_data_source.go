@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -59,7 +57,7 @@ func (s *CoreCpesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListCpes(context.Background(), request)
+	response, err := s.Client.ListCpes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +66,7 @@ func (s *CoreCpesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCpes(context.Background(), request)
+		listResponse, err := s.Client.ListCpes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
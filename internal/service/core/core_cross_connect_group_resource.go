@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -252,7 +251,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.CreateCrossConnectGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -269,7 +268,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.GetCrossConnectGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +327,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnectGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -345,7 +344,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnectGroup(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnectGroup(s.Context(), request)
 	return err
 }
 
@@ -494,7 +493,7 @@ func (s *CoreCrossConnectGroupResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCrossConnectGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCrossConnectGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -75,7 +73,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+	response, err := s.Client.ListCrossConnectLocations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +82,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+		listResponse, err := s.Client.ListCrossConnectLocations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -335,7 +334,7 @@ func (s *CoreCrossConnectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnect(context.Background(), request)
+	response, err := s.Client.CreateCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +351,7 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -420,7 +419,7 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnect(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -437,7 +436,7 @@ func (s *CoreCrossConnectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnect(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnect(s.Context(), request)
 	return err
 }
 
@@ -638,7 +637,7 @@ func (s *CoreCrossConnectResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCrossConnectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCrossConnectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -93,7 +91,7 @@ func (s *CoreDedicatedVmHostInstanceShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostInstanceShapes(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostInstanceShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +100,7 @@ func (s *CoreDedicatedVmHostInstanceShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostInstanceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostInstanceShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -214,7 +212,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.CreateDedicatedVmHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -243,7 +241,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.GetDedicatedVmHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +284,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.UpdateDedicatedVmHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +301,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.DeleteDedicatedVmHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -382,7 +380,7 @@ func (s *CoreDedicatedVmHostResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeDedicatedVmHostCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDedicatedVmHostCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -112,7 +110,7 @@ func (s *CoreDedicatedVmHostsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDedicatedVmHosts(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHosts(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +119,7 @@ func (s *CoreDedicatedVmHostsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHosts(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHosts(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
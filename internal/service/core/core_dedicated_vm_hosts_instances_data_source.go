@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -105,7 +103,7 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostInstances(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +112,7 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostInstances(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -73,7 +71,7 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -254,7 +253,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDhcpOptions(context.Background(), request)
+	response, err := s.Client.CreateDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -266,7 +265,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 	updateRequest.DhcpId = s.Res.Id
 	updateRequest.Options = request.Options
 	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	updateResponse, err := s.Client.UpdateDhcpOptions(context.Background(), updateRequest)
+	updateResponse, err := s.Client.UpdateDhcpOptions(s.Context(), updateRequest)
 	if err != nil {
 		log.Printf("[ERROR] Could not perform an Update right after the Create of the dhcpOptions: %v", err)
 	}
@@ -283,7 +282,7 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDhcpOptions(context.Background(), request)
+	response, err := s.Client.GetDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -348,7 +347,7 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -365,7 +364,7 @@ func (s *CoreDhcpOptionsResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDhcpOptions(context.Background(), request)
+	_, err := s.Client.DeleteDhcpOptions(s.Context(), request)
 	return err
 }
 
@@ -513,7 +512,7 @@ func (s *CoreDhcpOptionsResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeDhcpOptionsCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDhcpOptionsCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
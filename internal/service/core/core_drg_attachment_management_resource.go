@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -241,7 +240,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -311,7 +310,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -449,7 +448,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) removeExportDrgRouteDistributi
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveExportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveExportDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
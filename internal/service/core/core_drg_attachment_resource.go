@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -283,7 +282,7 @@ func (s *CoreDrgAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgAttachment(context.Background(), request)
+	response, err := s.Client.CreateDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +300,7 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgAttachment(context.Background(), request)
+	response, err := s.Client.GetDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -363,7 +362,7 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -380,7 +379,7 @@ func (s *CoreDrgAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgAttachment(context.Background(), request)
+	_, err := s.Client.DeleteDrgAttachment(s.Context(), request)
 	return err
 }
 
@@ -524,7 +523,7 @@ func (s *CoreDrgAttachmentResourceCrud) removeExportDrgRouteDistribution() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveExportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveExportDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
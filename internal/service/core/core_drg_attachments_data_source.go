@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -120,7 +118,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +127,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListDrgAttachments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
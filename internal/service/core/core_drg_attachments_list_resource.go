@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -103,7 +101,7 @@ func (s *CoreDrgAttachmentsListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetAllDrgAttachments(context.Background(), request)
+	response, err := s.Client.GetAllDrgAttachments(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +111,7 @@ func (s *CoreDrgAttachmentsListResourceCrud) Create() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetAllDrgAttachments(context.Background(), request)
+		listResponse, err := s.Client.GetAllDrgAttachments(s.Context(), request)
 		if err != nil {
 			return err
 		}
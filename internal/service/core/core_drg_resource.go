@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -207,7 +206,7 @@ func (s *CoreDrgResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrg(context.Background(), request)
+	response, err := s.Client.CreateDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -224,7 +223,7 @@ func (s *CoreDrgResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrg(context.Background(), request)
+	response, err := s.Client.GetDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -234,7 +233,7 @@ func (s *CoreDrgResourceCrud) Get() error {
 	statusRequest := oci_core.GetDrgRedundancyStatusRequest{}
 	statusRequest.DrgId = &tmp
 
-	if redundancyStatusResponse, err := s.Client.GetDrgRedundancyStatus(context.Background(), statusRequest); err == nil {
+	if redundancyStatusResponse, err := s.Client.GetDrgRedundancyStatus(s.Context(), statusRequest); err == nil {
 		s.RedundancyStatus = &redundancyStatusResponse.DrgRedundancyStatus
 	}
 
@@ -286,7 +285,7 @@ func (s *CoreDrgResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrg(context.Background(), request)
+	response, err := s.Client.UpdateDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +302,7 @@ func (s *CoreDrgResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrg(context.Background(), request)
+	_, err := s.Client.DeleteDrg(s.Context(), request)
 	return err
 }
 
@@ -404,7 +403,7 @@ func (s *CoreDrgResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeDrgCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDrgCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -416,7 +415,7 @@ func (s *CoreDrgResourceCrud) updateCompartment(compartment interface{}) error {
 	tmp := s.D.Id()
 	request.DrgId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	_, err = s.Client.GetDrg(context.Background(), request)
+	_, err = s.Client.GetDrg(s.Context(), request)
 	if err != nil {
 		return err
 	}
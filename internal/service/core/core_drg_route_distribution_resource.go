@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -174,7 +172,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.CreateDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -191,7 +189,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.GetDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +223,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +240,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgRouteDistribution(context.Background(), request)
+	_, err := s.Client.DeleteDrgRouteDistribution(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -161,7 +160,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.AddDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +199,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -210,7 +209,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -269,7 +268,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Update() error {
 	request.Statements = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.UpdateDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return fmt.Errorf("failed to Update distribution statements, error: %v", err)
 	}
@@ -302,7 +301,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Delete() error {
 	request.StatementIds = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	_, err = s.Client.RemoveDrgRouteDistributionStatements(context.Background(), request)
+	_, err = s.Client.RemoveDrgRouteDistributionStatements(s.Context(), request)
 	if err != nil {
 		return err
 	}
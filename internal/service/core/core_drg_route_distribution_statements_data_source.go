@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -104,7 +102,7 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributionStatements(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +111,7 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -207,7 +205,7 @@ func (s *CoreDrgRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgRouteTable(context.Background(), request)
+	response, err := s.Client.CreateDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -224,7 +222,7 @@ func (s *CoreDrgRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgRouteTable(context.Background(), request)
+	response, err := s.Client.GetDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -270,7 +268,7 @@ func (s *CoreDrgRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -287,7 +285,7 @@ func (s *CoreDrgRouteTableResourceCrud) RemoveImportRouteDistribution() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveImportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveImportDrgRouteDistribution(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,10 +308,10 @@ func (s *CoreDrgRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
+	response, err := s.Client.DeleteDrgRouteTable(s.Context(), request)
 	if response.HTTPResponse().StatusCode == 400 {
 		retryDeleteFunc := func() bool {
-			response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
+			response, err := s.Client.DeleteDrgRouteTable(s.Context(), request)
 			return err == nil && response.HTTPResponse().StatusCode == 200
 		}
 		return tfresource.WaitForResourceCondition(s, retryDeleteFunc, s.D.Timeout(schema.TimeoutDelete))
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -144,7 +143,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddDrgRouteRules(context.Background(), request)
+	response, err := s.Client.AddDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -226,7 +225,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListDrgRouteRules(context.Background(), request)
+	response, err := s.Client.ListDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +234,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteRules(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -271,7 +270,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Delete() error {
 	request.RouteRuleIds = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	_, err = s.Client.RemoveDrgRouteRules(context.Background(), request)
+	_, err = s.Client.RemoveDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,7 +309,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Update() error {
 	request.RouteRules = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateDrgRouteRules(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteRules(s.Context(), request)
 	if err != nil {
 		return fmt.Errorf("failed to Update route rules, error: %v", err)
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -85,7 +83,7 @@ func (s *CoreDrgRouteTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteTables(context.Background(), request)
+	response, err := s.Client.ListDrgRouteTables(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *CoreDrgRouteTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteTables(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteTables(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -118,7 +116,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderServices(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +125,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+		listResponse, err := s.Client.ListFastConnectProviderServices(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
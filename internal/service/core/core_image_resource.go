@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -356,7 +355,7 @@ func (s *CoreImageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateImage(context.Background(), request)
+	response, err := s.Client.CreateImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +384,7 @@ func (s *CoreImageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetImage(context.Background(), request)
+	response, err := s.Client.GetImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -428,7 +427,7 @@ func (s *CoreImageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateImage(context.Background(), request)
+	response, err := s.Client.UpdateImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -445,7 +444,7 @@ func (s *CoreImageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteImage(context.Background(), request)
+	_, err := s.Client.DeleteImage(s.Context(), request)
 	return err
 }
 
@@ -599,7 +598,7 @@ func (s *CoreImageResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeImageCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeImageCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
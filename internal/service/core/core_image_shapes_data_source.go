@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -121,7 +119,7 @@ func (s *CoreImageShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListImageShapeCompatibilityEntries(context.Background(), request)
+	response, err := s.Client.ListImageShapeCompatibilityEntries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +128,7 @@ func (s *CoreImageShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImageShapeCompatibilityEntries(context.Background(), request)
+		listResponse, err := s.Client.ListImageShapeCompatibilityEntries(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -129,7 +128,7 @@ func (s *CoreImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListImages(context.Background(), request)
+	response, err := s.Client.ListImages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +137,7 @@ func (s *CoreImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImages(context.Background(), request)
+		listResponse, err := s.Client.ListImages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
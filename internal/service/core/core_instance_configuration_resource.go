@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -1015,7 +1014,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.CreateInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1032,7 +1031,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1075,7 +1074,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1092,7 +1091,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConfiguration(s.Context(), request)
 	return err
 }
 
@@ -2540,7 +2539,7 @@ func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstanceConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstanceConfigurationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
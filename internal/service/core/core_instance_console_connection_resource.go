@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -177,7 +175,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateInstanceConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +192,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetInstanceConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -223,7 +221,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +238,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConsoleConnection(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -52,7 +50,7 @@ func (s *CoreInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstance(context.Background(), request)
+	response, err := s.Client.GetInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
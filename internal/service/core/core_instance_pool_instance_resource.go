@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -202,7 +201,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.AttachInstancePoolInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +234,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.GetInstancePoolInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -266,7 +265,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DetachInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.DetachInstancePoolInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
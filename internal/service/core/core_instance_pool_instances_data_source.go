@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -77,7 +75,7 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListInstancePoolInstances(context.Background(), request)
+	response, err := s.Client.ListInstancePoolInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstancePoolInstances(context.Background(), request)
+		listResponse, err := s.Client.ListInstancePoolInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
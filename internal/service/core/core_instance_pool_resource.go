@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -354,7 +353,7 @@ func (s *CoreInstancePoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstancePool(context.Background(), request)
+	response, err := s.Client.CreateInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -371,7 +370,7 @@ func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolI
 		startRequest.InstancePoolId = instancePoolId
 		startRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		startResponse, err := s.Client.StartInstancePool(context.Background(), startRequest)
+		startResponse, err := s.Client.StartInstancePool(s.Context(), startRequest)
 
 		return &startResponse.InstancePool, err
 	case instancePoolStoppedState:
@@ -379,7 +378,7 @@ func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolI
 		stopRequest.InstancePoolId = instancePoolId
 		stopRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		stopResponse, err := s.Client.StopInstancePool(context.Background(), stopRequest)
+		stopResponse, err := s.Client.StopInstancePool(s.Context(), stopRequest)
 
 		return &stopResponse.InstancePool, err
 	default:
@@ -396,7 +395,7 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -474,7 +473,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstancePool(context.Background(), request)
+	response, err := s.Client.UpdateInstancePool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -508,7 +507,7 @@ func (s *CoreInstancePoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstancePool(context.Background(), request)
+	_, err := s.Client.TerminateInstancePool(s.Context(), request)
 	return err
 }
 
@@ -783,7 +782,7 @@ func (s *CoreInstancePoolResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstancePoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstancePoolCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -845,7 +844,7 @@ func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, n
 		attachLoadBalancerRequest.InstancePoolId = &id
 		attachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		attachLoadBalancerRequest.AttachLoadBalancerDetails = newLoadBalancer
-		_, err := s.Client.AttachLoadBalancer(context.Background(), attachLoadBalancerRequest)
+		_, err := s.Client.AttachLoadBalancer(s.Context(), attachLoadBalancerRequest)
 
 		if err != nil {
 			return err
@@ -864,7 +863,7 @@ func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, n
 		detachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		detachLoadBalancerRequest.InstancePoolId = &id
 		detachLoadBalancerRequest.BackendSetName = oldLoadbalancer.BackendSetName
-		_, err := s.Client.DetachLoadBalancer(context.Background(), detachLoadBalancerRequest)
+		_, err := s.Client.DetachLoadBalancer(s.Context(), detachLoadBalancerRequest)
 
 		if err != nil {
 			return err
@@ -908,7 +907,7 @@ func (s *CoreInstancePoolResourceCrud) pollForLbOperationCompletion(poolId *stri
 		Refresh: func() (interface{}, string, error) {
 			var err error
 
-			response, err = s.Client.GetInstancePool(context.Background(),
+			response, err = s.Client.GetInstancePool(s.Context(),
 				oci_core.GetInstancePoolRequest{
 					InstancePoolId: poolId,
 				})
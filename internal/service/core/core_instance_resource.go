@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1007,7 +1006,7 @@ func (s *CoreInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.LaunchInstance(context.Background(), request)
+	response, err := s.Client.LaunchInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1032,7 +1031,7 @@ func (s *CoreInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstance(context.Background(), request)
+	response, err := s.Client.GetInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1143,7 +1142,7 @@ func (s *CoreInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstance(context.Background(), request)
+	response, err := s.Client.UpdateInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1183,7 +1182,7 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		},
 	}
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), vnicOpts)
+	_, err = s.VirtualNetworkClient.UpdateVnic(s.Context(), vnicOpts)
 
 	if err != nil {
 		log.Printf("[ERROR] Primary VNIC could not be updated during instance Update: %q (Instance ID: \"%v\", State: %q)", err, s.Res.Id, s.Res.LifecycleState)
@@ -1202,7 +1201,7 @@ func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceAction
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.InstanceAction(context.Background(), request)
+	_, err := s.Client.InstanceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1225,7 +1224,7 @@ func (s *CoreInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstance(context.Background(), request)
+	_, err := s.Client.TerminateInstance(s.Context(), request)
 	return err
 }
 
@@ -1644,7 +1643,7 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 
 		if assignPublicIpBoolVal {
 
-			listPrivateIpsResponse, err := s.VirtualNetworkClient.ListPrivateIps(context.Background(), oci_core.ListPrivateIpsRequest{
+			listPrivateIpsResponse, err := s.VirtualNetworkClient.ListPrivateIps(s.Context(), oci_core.ListPrivateIpsRequest{
 				VnicId: vnic.Id,
 				RequestMetadata: common.RequestMetadata{
 					RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
@@ -1657,7 +1656,7 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 
 			for _, privateIp := range listPrivateIpsResponse.Items {
 				if strings.EqualFold(*privateIp.IpAddress, *vnic.PrivateIp) {
-					_, err = s.VirtualNetworkClient.CreatePublicIp(context.Background(), oci_core.CreatePublicIpRequest{
+					_, err = s.VirtualNetworkClient.CreatePublicIp(s.Context(), oci_core.CreatePublicIpRequest{
 						CreatePublicIpDetails: oci_core.CreatePublicIpDetails{
 							CompartmentId: vnic.CompartmentId,
 							Lifetime:      oci_core.CreatePublicIpDetailsLifetimeEphemeral,
@@ -1674,7 +1673,7 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 			return fmt.Errorf("unable to assign Ephemeral public ip for the vnic private ip: %s", *vnic.PrivateIp)
 
 		} else {
-			publicIpByIpAddressResponse, err := s.VirtualNetworkClient.GetPublicIpByIpAddress(context.Background(), oci_core.GetPublicIpByIpAddressRequest{
+			publicIpByIpAddressResponse, err := s.VirtualNetworkClient.GetPublicIpByIpAddress(s.Context(), oci_core.GetPublicIpByIpAddressRequest{
 				GetPublicIpByIpAddressDetails: oci_core.GetPublicIpByIpAddressDetails{
 					IpAddress: vnic.PublicIp,
 				},
@@ -1684,7 +1683,7 @@ func (s *CoreInstanceResourceCrud) updateVnicAssignPublicIp(vnic *oci_core.Vnic,
 			})
 
 			if err == nil {
-				_, err = s.VirtualNetworkClient.DeletePublicIp(context.Background(), oci_core.DeletePublicIpRequest{
+				_, err = s.VirtualNetworkClient.DeletePublicIp(s.Context(), oci_core.DeletePublicIpRequest{
 					PublicIpId: publicIpByIpAddressResponse.Id,
 					RequestMetadata: common.RequestMetadata{
 						RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
@@ -2227,7 +2226,7 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 	var attachments []oci_core.VnicAttachment
 
 	for {
-		result, err := s.Client.ListVnicAttachments(context.Background(), request)
+		result, err := s.Client.ListVnicAttachments(s.Context(), request)
 		if err != nil {
 			return nil, err
 		}
@@ -2252,7 +2251,7 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 					RetryPolicy: tfresource.GetRetryPolicy(true, "core"),
 				},
 			}
-			response, _ := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+			response, _ := s.VirtualNetworkClient.GetVnic(s.Context(), request)
 			vnic := &response.Vnic
 
 			// Ignore errors on GetVnic, since we might not have permissions to view some secondary VNICs.
@@ -2275,7 +2274,7 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 		},
 	}
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -2295,7 +2294,7 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 			RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
 		},
 	}
-	bootVolumeResponse, err := s.BlockStorageClient.GetBootVolume(context.Background(), bootVolumeRequest)
+	bootVolumeResponse, err := s.BlockStorageClient.GetBootVolume(s.Context(), bootVolumeRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -2386,7 +2385,7 @@ func (s *CoreInstanceResourceCrud) updateCompartment(compartment interface{}) er
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstanceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -2421,7 +2420,7 @@ func (s *CoreInstanceResourceCrud) updateBootVolumeSizeInGbs(bootVolumeSizeInGBs
 
 	changeBootVolumeDetailsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.BlockStorageClient.UpdateBootVolume(context.Background(), changeBootVolumeDetailsRequest)
+	response, err := s.BlockStorageClient.UpdateBootVolume(s.Context(), changeBootVolumeDetailsRequest)
 	if err != nil {
 		return err
 	}
@@ -2448,7 +2447,7 @@ func waitForBootVolumeIfItIsUpdating(bootVolumeID *string, client *oci_core.Bloc
 	}
 
 	getBootVolumeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyWithAdditionalRetryCondition(timeout, bootVolumeUpdating, "core")
-	getBootVolumeResponse, err := client.GetBootVolume(context.Background(), getBootVolumeRequest)
+	getBootVolumeResponse, err := client.GetBootVolume(tfresource.GetStopContext(), getBootVolumeRequest)
 	return &getBootVolumeResponse, err
 }
 
@@ -2512,7 +2511,7 @@ func (s *CoreInstanceResourceCrud) updateOptionsViaWorkRequest() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstance(context.Background(), request)
+	response, err := s.Client.UpdateInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -180,7 +178,7 @@ func (s *CoreInternetGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInternetGateway(context.Background(), request)
+	response, err := s.Client.CreateInternetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -197,7 +195,7 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInternetGateway(context.Background(), request)
+	response, err := s.Client.GetInternetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +243,7 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInternetGateway(context.Background(), request)
+	response, err := s.Client.UpdateInternetGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +260,7 @@ func (s *CoreInternetGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInternetGateway(context.Background(), request)
+	_, err := s.Client.DeleteInternetGateway(s.Context(), request)
 	return err
 }
 
@@ -309,7 +307,7 @@ func (s *CoreInternetGatewayResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInternetGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInternetGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
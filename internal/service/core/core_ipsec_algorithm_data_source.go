@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -178,7 +176,7 @@ func (s *CoreIpsecAlgorithmDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAllowedIkeIPSecParameters(context.Background(), request)
+	response, err := s.Client.GetAllowedIkeIPSecParameters(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
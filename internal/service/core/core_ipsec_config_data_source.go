@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -88,7 +86,7 @@ func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceConfig(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceConfig(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -387,7 +386,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -400,7 +399,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 
 	secretRequest.TunnelId = request.TunnelId
 
-	secretResponse, err := s.Client.GetIPSecConnectionTunnelSharedSecret(context.Background(), secretRequest)
+	secretResponse, err := s.Client.GetIPSecConnectionTunnelSharedSecret(s.Context(), secretRequest)
 	if err != nil {
 		return err
 	}
@@ -426,7 +425,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 				tmp := tunnelId.(string)
 				secretUpdateRequest.TunnelId = &tmp
 			}
-			_, err := s.Client.UpdateIPSecConnectionTunnelSharedSecret(context.Background(), secretUpdateRequest)
+			_, err := s.Client.UpdateIPSecConnectionTunnelSharedSecret(s.Context(), secretUpdateRequest)
 			if err != nil {
 				return err
 			}
@@ -601,7 +600,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.UpdateIPSecConnectionTunnel(s.Context(), request)
 	if err != nil {
 		return err
 	}
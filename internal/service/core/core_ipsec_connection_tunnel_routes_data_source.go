@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -108,7 +107,7 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnelRoutes(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnelRoutes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -117,7 +116,7 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnelRoutes(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnelRoutes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -326,7 +325,7 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnels(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnels(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -335,7 +334,7 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnels(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnels(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
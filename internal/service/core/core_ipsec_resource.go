@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -230,7 +228,7 @@ func (s *CoreIpSecConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIPSecConnection(context.Background(), request)
+	response, err := s.Client.CreateIPSecConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -247,7 +245,7 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIPSecConnection(context.Background(), request)
+	response, err := s.Client.GetIPSecConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -311,7 +309,7 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIPSecConnection(context.Background(), request)
+	response, err := s.Client.UpdateIPSecConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +326,7 @@ func (s *CoreIpSecConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIPSecConnection(context.Background(), request)
+	_, err := s.Client.DeleteIPSecConnection(s.Context(), request)
 	return err
 }
 
@@ -383,7 +381,7 @@ func (s *CoreIpSecConnectionResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeIPSecConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeIPSecConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -179,7 +177,7 @@ func (s *CoreIpv6ResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIpv6(context.Background(), request)
+	response, err := s.Client.CreateIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +194,7 @@ func (s *CoreIpv6ResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIpv6(context.Background(), request)
+	response, err := s.Client.GetIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +233,7 @@ func (s *CoreIpv6ResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIpv6(context.Background(), request)
+	response, err := s.Client.UpdateIpv6(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,7 +250,7 @@ func (s *CoreIpv6ResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIpv6(context.Background(), request)
+	_, err := s.Client.DeleteIpv6(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -78,7 +76,7 @@ func (s *CoreLetterOfAuthorityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectLetterOfAuthority(context.Background(), request)
+	response, err := s.Client.GetCrossConnectLetterOfAuthority(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"time"
@@ -198,7 +197,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.ConnectLocalPeeringGateways(context.Background(), connectRequest)
+		_, err := s.Client.ConnectLocalPeeringGateways(s.Context(), connectRequest)
 		if err != nil {
 			// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 			s.D.Set("peer_id", "")
@@ -212,7 +211,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		request.RequestMetadata.RetryPolicy = getLocalPeeringGatewayRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-		response, getError := s.Client.GetLocalPeeringGateway(context.Background(), request)
+		response, getError := s.Client.GetLocalPeeringGateway(s.Context(), request)
 		if getError != nil {
 			log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 			return getError
@@ -267,7 +266,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.CreateLocalPeeringGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -284,7 +283,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.GetLocalPeeringGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +331,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.UpdateLocalPeeringGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -349,7 +348,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteLocalPeeringGateway(context.Background(), request)
+	_, err := s.Client.DeleteLocalPeeringGateway(s.Context(), request)
 	return err
 }
 
@@ -416,7 +415,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeLocalPeeringGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLocalPeeringGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -68,7 +66,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+	response, err := s.Client.ListLocalPeeringGateways(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +75,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+		listResponse, err := s.Client.ListLocalPeeringGateways(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -195,7 +193,7 @@ func (s *CoreNatGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNatGateway(context.Background(), request)
+	response, err := s.Client.CreateNatGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +210,7 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNatGateway(context.Background(), request)
+	response, err := s.Client.GetNatGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -260,7 +258,7 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNatGateway(context.Background(), request)
+	response, err := s.Client.UpdateNatGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -277,7 +275,7 @@ func (s *CoreNatGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNatGateway(context.Background(), request)
+	_, err := s.Client.DeleteNatGateway(s.Context(), request)
 	return err
 }
 
@@ -332,7 +330,7 @@ func (s *CoreNatGatewayResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeNatGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNatGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
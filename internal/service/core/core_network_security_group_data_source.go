@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -50,7 +48,7 @@ func (s *CoreNetworkSecurityGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.GetNetworkSecurityGroup(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
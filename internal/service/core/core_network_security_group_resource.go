@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -170,7 +168,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.CreateNetworkSecurityGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,7 +185,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.GetNetworkSecurityGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -230,7 +228,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSecurityGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -247,7 +245,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNetworkSecurityGroup(context.Background(), request)
+	_, err := s.Client.DeleteNetworkSecurityGroup(s.Context(), request)
 	return err
 }
 
@@ -290,7 +288,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeNetworkSecurityGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNetworkSecurityGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -364,7 +363,7 @@ func (s *CoreSecurityRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.AddNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -398,7 +397,7 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -407,7 +406,7 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -515,7 +514,7 @@ func (s *CoreSecurityRuleResourceCrud) Update() error {
 	request.SecurityRules = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.UpdateNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return fmt.Errorf("failed to update security rules, error: %v", err)
 	}
@@ -538,7 +537,7 @@ func (s *CoreSecurityRuleResourceCrud) Delete() error {
 	request.SecurityRuleIds = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	_, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(context.Background(), request)
+	_, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -264,7 +262,7 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +271,7 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -79,7 +77,7 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupVnics(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupVnics(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +86,7 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupVnics(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupVnics(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -94,7 +92,7 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroups(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroups(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
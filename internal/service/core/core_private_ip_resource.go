@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -186,7 +184,7 @@ func (s *CorePrivateIpResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePrivateIp(context.Background(), request)
+	response, err := s.Client.CreatePrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +201,7 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -247,7 +245,7 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePrivateIp(context.Background(), request)
+	response, err := s.Client.UpdatePrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -264,7 +262,7 @@ func (s *CorePrivateIpResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePrivateIp(context.Background(), request)
+	_, err := s.Client.DeletePrivateIp(s.Context(), request)
 	return err
 }
 
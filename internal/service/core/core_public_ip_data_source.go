@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -133,7 +132,7 @@ func (s *CorePublicIpDataSourceCrud) getById(id string) error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +148,7 @@ func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(privateIpId string) error
 		request.PrivateIpId = &tmp
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByPrivateIpId(context.Background(), request)
+	response, err := s.Client.GetPublicIpByPrivateIpId(tfresource.GetResourceDataContext(s.D), request)
 
 	if err != nil {
 		return err
@@ -167,7 +166,7 @@ func (s *CorePublicIpDataSourceCrud) getByPublicIp(ipAddress string) error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByIpAddress(context.Background(), request)
+	response, err := s.Client.GetPublicIpByIpAddress(tfresource.GetResourceDataContext(s.D), request)
 
 	if err != nil {
 		return err
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -111,7 +110,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddPublicIpPoolCapacity(context.Background(), request)
+	response, err := s.Client.AddPublicIpPoolCapacity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -132,7 +131,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +184,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.RemovePublicIpPoolCapacity(context.Background(), request)
+	_, err := s.Client.RemovePublicIpPoolCapacity(s.Context(), request)
 	return err
 }
 
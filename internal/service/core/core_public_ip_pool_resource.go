@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -165,7 +163,7 @@ func (s *CorePublicIpPoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIpPool(context.Background(), request)
+	response, err := s.Client.CreatePublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -182,7 +180,7 @@ func (s *CorePublicIpPoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +223,7 @@ func (s *CorePublicIpPoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIpPool(context.Background(), request)
+	response, err := s.Client.UpdatePublicIpPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +240,7 @@ func (s *CorePublicIpPoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIpPool(context.Background(), request)
+	_, err := s.Client.DeletePublicIpPool(s.Context(), request)
 	return err
 }
 
@@ -313,7 +311,7 @@ func (s *CorePublicIpPoolResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangePublicIpPoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicIpPoolCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -228,7 +226,7 @@ func (s *CorePublicIpResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIp(context.Background(), request)
+	response, err := s.Client.CreatePublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +243,7 @@ func (s *CorePublicIpResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -298,7 +296,7 @@ func (s *CorePublicIpResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIp(context.Background(), request)
+	response, err := s.Client.UpdatePublicIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -315,7 +313,7 @@ func (s *CorePublicIpResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIp(context.Background(), request)
+	_, err := s.Client.DeletePublicIp(s.Context(), request)
 	return err
 }
 
@@ -380,7 +378,7 @@ func (s *CorePublicIpResourceCrud) updateCompartment(compartment interface{}) er
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangePublicIpCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicIpCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
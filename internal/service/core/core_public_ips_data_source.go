@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -93,7 +91,7 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListPublicIps(context.Background(), request)
+	response, err := s.Client.ListPublicIps(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +100,7 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicIps(context.Background(), request)
+		listResponse, err := s.Client.ListPublicIps(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
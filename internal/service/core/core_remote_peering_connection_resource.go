@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"time"
@@ -199,7 +198,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ConnectRemotePeeringConnections(context.Background(), connectRequest)
+	_, err := s.Client.ConnectRemotePeeringConnections(s.Context(), connectRequest)
 	if err != nil {
 		// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 		s.D.Set("peer_id", "")
@@ -213,7 +212,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	request.RequestMetadata.RetryPolicy = getRemotePeeringConnectionRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-	response, getError := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, getError := s.Client.GetRemotePeeringConnection(s.Context(), request)
 	if getError != nil {
 		log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 		return getError
@@ -263,7 +262,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.CreateRemotePeeringConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -280,7 +279,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.GetRemotePeeringConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +322,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.UpdateRemotePeeringConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +339,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRemotePeeringConnection(context.Background(), request)
+	_, err := s.Client.DeleteRemotePeeringConnection(s.Context(), request)
 	return err
 }
 
@@ -424,7 +423,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeRemotePeeringConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRemotePeeringConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
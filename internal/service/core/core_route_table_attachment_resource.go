@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -105,7 +104,7 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -123,7 +122,7 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	request := oci_core.GetSubnetRequest{}
 	request.SubnetId = &subnetId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +139,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	subnetRequest := oci_core.GetSubnetRequest{}
 	subnetRequest.SubnetId = &subnetIdStr
 	subnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	subnetResponse, err := s.Client.GetSubnet(context.Background(), subnetRequest)
+	subnetResponse, err := s.Client.GetSubnet(s.Context(), subnetRequest)
 	if err != nil {
 		return err
 	}
@@ -148,7 +147,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	vcnRequest := oci_core.GetVcnRequest{}
 	vcnRequest.VcnId = subnetResponse.VcnId
 	vcnRequest.RequestMetadata.RetryPolicy = retryPolicy
-	vcnResponse, err := s.Client.GetVcn(context.Background(), vcnRequest)
+	vcnResponse, err := s.Client.GetVcn(s.Context(), vcnRequest)
 	if err != nil {
 		return err
 	}
@@ -157,7 +156,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	updateSubnetRequest.RouteTableId = vcnResponse.DefaultRouteTableId
 	updateSubnetRequest.SubnetId = &subnetIdStr
 	updateSubnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	updateSubnetResponse, err := s.Client.UpdateSubnet(context.Background(), updateSubnetRequest)
+	updateSubnetResponse, err := s.Client.UpdateSubnet(s.Context(), updateSubnetRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -68,7 +66,7 @@ func (s *DefaultRouteTableResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
@@ -232,7 +231,7 @@ func (s *CoreRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRouteTable(context.Background(), request)
+	response, err := s.Client.CreateRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,7 +248,7 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRouteTable(context.Background(), request)
+	response, err := s.Client.GetRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -309,7 +308,7 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateRouteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -326,7 +325,7 @@ func (s *CoreRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRouteTable(context.Background(), request)
+	_, err := s.Client.DeleteRouteTable(s.Context(), request)
 	return err
 }
 
@@ -482,7 +481,7 @@ func (s *CoreRouteTableResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeRouteTableCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRouteTableCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
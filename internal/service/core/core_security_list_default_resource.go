@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -70,7 +68,7 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	response, err := s.Client.UpdateSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
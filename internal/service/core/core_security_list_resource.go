@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
@@ -532,7 +531,7 @@ func (s *CoreSecurityListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSecurityList(context.Background(), request)
+	response, err := s.Client.CreateSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -549,7 +548,7 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSecurityList(context.Background(), request)
+	response, err := s.Client.GetSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -626,7 +625,7 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	response, err := s.Client.UpdateSecurityList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -643,7 +642,7 @@ func (s *CoreSecurityListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteSecurityList(context.Background(), request)
+	_, err := s.Client.DeleteSecurityList(s.Context(), request)
 	return err
 }
 
@@ -1226,7 +1225,7 @@ func (s *CoreSecurityListResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSecurityListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
@@ -227,7 +226,7 @@ func (s *CoreServiceGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateServiceGateway(context.Background(), request)
+	response, err := s.Client.CreateServiceGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +243,7 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetServiceGateway(context.Background(), request)
+	response, err := s.Client.GetServiceGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -315,7 +314,7 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateServiceGateway(context.Background(), request)
+	response, err := s.Client.UpdateServiceGateway(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +331,7 @@ func (s *CoreServiceGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteServiceGateway(context.Background(), request)
+	_, err := s.Client.DeleteServiceGateway(s.Context(), request)
 	return err
 }
 
@@ -422,7 +421,7 @@ func (s *CoreServiceGatewayResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeServiceGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeServiceGatewayCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -74,7 +72,7 @@ func (s *CoreServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListServices(context.Background(), request)
+	response, err := s.Client.ListServices(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -83,7 +81,7 @@ func (s *CoreServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServices(context.Background(), request)
+		listResponse, err := s.Client.ListServices(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -96,7 +95,7 @@ func (s *CoreShapeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddImageShapeCompatibilityEntry(context.Background(), request)
+	response, err := s.Client.AddImageShapeCompatibilityEntry(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -119,7 +118,7 @@ func (s *CoreShapeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +148,7 @@ func (s *CoreShapeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.RemoveImageShapeCompatibilityEntry(context.Background(), request)
+	_, err = s.Client.RemoveImageShapeCompatibilityEntry(s.Context(), request)
 	return err
 }
 
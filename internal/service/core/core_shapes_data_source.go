@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -380,7 +378,7 @@ func (s *CoreShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -389,7 +387,7 @@ func (s *CoreShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListShapes(context.Background(), request)
+		listResponse, err := s.Client.ListShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
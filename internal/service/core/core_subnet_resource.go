@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -304,7 +302,7 @@ func (s *CoreSubnetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSubnet(context.Background(), request)
+	response, err := s.Client.CreateSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -321,7 +319,7 @@ func (s *CoreSubnetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -401,7 +399,7 @@ func (s *CoreSubnetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -418,7 +416,7 @@ func (s *CoreSubnetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, globalvar.CoreService, globalvar.SubnetService, globalvar.DeleteResource)
 
-	_, err := s.Client.DeleteSubnet(context.Background(), request)
+	_, err := s.Client.DeleteSubnet(s.Context(), request)
 	return err
 }
 
@@ -515,7 +513,7 @@ func (s *CoreSubnetResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSubnetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSubnetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -96,7 +94,7 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -105,7 +103,7 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -62,7 +60,7 @@ func (s *CoreVcnDnsResolverAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVcnDnsResolverAssociation(context.Background(), request)
+	response, err := s.Client.GetVcnDnsResolverAssociation(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -238,7 +237,7 @@ func (s *CoreVcnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVcn(context.Background(), request)
+	response, err := s.Client.CreateVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +254,7 @@ func (s *CoreVcnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVcn(context.Background(), request)
+	response, err := s.Client.GetVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,7 +280,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 		enableIPv6Request.VcnId = &tmp
 		enableIPv6Request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), enableIPv6Request)
+		_, err := s.Client.AddIpv6VcnCidr(s.Context(), enableIPv6Request)
 		if err != nil {
 			return err
 		}
@@ -321,7 +320,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVcn(context.Background(), request)
+	response, err := s.Client.UpdateVcn(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -338,7 +337,7 @@ func (s *CoreVcnResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVcn(context.Background(), request)
+	_, err := s.Client.DeleteVcn(s.Context(), request)
 	return err
 }
 
@@ -411,7 +410,7 @@ func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVcnCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVcnCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -448,7 +447,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		addVcnCidrRequest.VcnId = &idTmp
 		addVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addVcnCidrRequest.CidrBlock = &newCidr
-		_, err := s.Client.AddVcnCidr(context.Background(), addVcnCidrRequest)
+		_, err := s.Client.AddVcnCidr(s.Context(), addVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -459,7 +458,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		removeVcnCidrRequest.VcnId = &idTmp
 		removeVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		removeVcnCidrRequest.CidrBlock = &oldCidr
-		_, err := s.Client.RemoveVcnCidr(context.Background(), removeVcnCidrRequest)
+		_, err := s.Client.RemoveVcnCidr(s.Context(), removeVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -471,7 +470,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		modifyVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		modifyVcnCidrRequest.OriginalCidrBlock = &oldCidr
 		modifyVcnCidrRequest.NewCidrBlock = &newCidr
-		_, err := s.Client.ModifyVcnCidr(context.Background(), modifyVcnCidrRequest)
+		_, err := s.Client.ModifyVcnCidr(s.Context(), modifyVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -75,7 +73,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +82,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *CoreVirtualCircuitDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
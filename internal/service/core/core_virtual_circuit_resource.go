@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -438,7 +437,7 @@ func (s *CoreVirtualCircuitResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.CreateVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -455,7 +454,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -466,7 +465,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	ppRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 	ppRequest.VirtualCircuitId = request.VirtualCircuitId
 
-	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), ppRequest)
+	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(s.Context(), ppRequest)
 	if ppErr != nil {
 		return ppErr
 	}
@@ -598,7 +597,7 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.UpdateVirtualCircuit(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -645,7 +644,7 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 		addRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addRequest.PublicPrefixes = publicPrefixesToAdd
 		addRequest.VirtualCircuitId = &virtualCircuitId
-		_, addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(context.Background(), addRequest)
+		_, addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(s.Context(), addRequest)
 		if addErr != nil {
 			return fmt.Errorf("failed to add public prefixes, error: %v", addErr)
 		}
@@ -662,7 +661,7 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 		deleteRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		deleteRequest.PublicPrefixes = publicPrefixesToDelete
 		deleteRequest.VirtualCircuitId = &virtualCircuitId
-		_, deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(context.Background(), deleteRequest)
+		_, deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(s.Context(), deleteRequest)
 		if deleteErr != nil {
 			return fmt.Errorf("failed to delete public prefixes, error: %v", deleteErr)
 		}
@@ -683,7 +682,7 @@ func (s *CoreVirtualCircuitResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVirtualCircuit(context.Background(), request)
+	_, err := s.Client.DeleteVirtualCircuit(s.Context(), request)
 	return err
 }
 
@@ -922,7 +921,7 @@ func (s *CoreVirtualCircuitResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVirtualCircuitCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVirtualCircuitCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
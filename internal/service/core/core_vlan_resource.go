@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -235,7 +233,7 @@ func (s *CoreVlanResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVlan(context.Background(), request)
+	response, err := s.Client.CreateVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,7 +250,7 @@ func (s *CoreVlanResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVlan(context.Background(), request)
+	response, err := s.Client.GetVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -319,7 +317,7 @@ func (s *CoreVlanResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVlan(context.Background(), request)
+	response, err := s.Client.UpdateVlan(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +334,7 @@ func (s *CoreVlanResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVlan(context.Background(), request)
+	_, err := s.Client.DeleteVlan(s.Context(), request)
 	return err
 }
 
@@ -401,7 +399,7 @@ func (s *CoreVlanResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVlanCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVlanCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
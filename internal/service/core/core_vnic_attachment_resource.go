@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -286,7 +285,7 @@ func (s *CoreVnicAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVnic(context.Background(), request)
+	response, err := s.Client.AttachVnic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -329,7 +328,7 @@ func (s *CoreVnicAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), request)
+	_, err = s.VirtualNetworkClient.UpdateVnic(s.Context(), request)
 	return err
 }
 
@@ -341,7 +340,7 @@ func (s *CoreVnicAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVnicAttachment(context.Background(), request)
+	response, err := s.Client.GetVnicAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +357,7 @@ func (s *CoreVnicAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVnic(context.Background(), request)
+	_, err := s.Client.DetachVnic(s.Context(), request)
 	return err
 }
 
@@ -409,7 +408,7 @@ func (s *CoreVnicAttachmentResourceCrud) SetData() error {
 	request := oci_core.GetVnicRequest{}
 	request.VnicId = s.Res.VnicId
 
-	response, err := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+	response, err := s.VirtualNetworkClient.GetVnic(s.Context(), request)
 	if err != nil {
 		// VNIC might not be found when attaching or detaching; or if it is in a different compartment
 		if request.VnicId != nil {
@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v55/core"
 
@@ -86,7 +84,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVnicAttachments(context.Background(), request)
+	response, err := s.Client.ListVnicAttachments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +93,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVnicAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListVnicAttachments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
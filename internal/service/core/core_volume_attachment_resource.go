@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -242,7 +241,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVolume(context.Background(), request)
+	response, err := s.Client.AttachVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +258,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+	response, err := s.Client.GetVolumeAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -276,7 +275,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVolume(context.Background(), request)
+	_, err := s.Client.DetachVolume(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -87,7 +86,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListVolumeAttachments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +95,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeAttachments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
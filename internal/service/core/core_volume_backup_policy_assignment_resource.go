@@ -4,8 +4,6 @@
 package core
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -98,7 +96,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicyAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +113,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -132,7 +130,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(s.Context(), request)
 	return err
 }
 
@@ -5,7 +5,6 @@ package core
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
@@ -221,7 +220,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -238,7 +237,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -295,7 +294,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.UpdateVolumeBackupPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,7 +311,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicy(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicy(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -313,7 +312,7 @@ func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
 		copyVolumeBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyVolumeBackup(context.Background(), copyVolumeBackupRequest)
+	response, err := s.SourceRegionClient.CopyVolumeBackup(s.Context(), copyVolumeBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -369,7 +368,7 @@ func (s *CoreVolumeBackupResourceCrud) CreateVolumeBackup() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,7 +385,7 @@ func (s *CoreVolumeBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -429,7 +428,7 @@ func (s *CoreVolumeBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *CoreVolumeBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackup(s.Context(), request)
 	return err
 }
 
@@ -529,7 +528,7 @@ func (s *CoreVolumeBackupResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
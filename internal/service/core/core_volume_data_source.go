@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *CoreVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -308,7 +307,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) CreateVolumeGroupBackup() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroupBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +357,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) createVolumeGroupBackupCopy() error
 		copyVolumeGroupBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyVolumeGroupBackup(context.Background(), copyVolumeGroupBackupRequest)
+	response, err := s.SourceRegionClient.CopyVolumeGroupBackup(s.Context(), copyVolumeGroupBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -375,7 +374,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -428,7 +427,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroupBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -445,7 +444,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroupBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroupBackup(s.Context(), request)
 	return err
 }
 
@@ -522,7 +521,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeGroupBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeGroupBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -114,7 +113,7 @@ func (s *CoreVolumeGroupReplicaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVolumeGroupReplica(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupReplica(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -157,7 +156,7 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeGroupReplicas(context.Background(), request)
+	response, err := s.Client.ListVolumeGroupReplicas(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +165,7 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroupReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroupReplicas(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -323,7 +322,7 @@ func (s *CoreVolumeGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -341,7 +340,7 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -429,7 +428,7 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *CoreVolumeGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroup(s.Context(), request)
 	return err
 }
 
@@ -648,7 +647,7 @@ func (s *CoreVolumeGroupResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
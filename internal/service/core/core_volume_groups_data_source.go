@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -86,7 +85,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeGroups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +94,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
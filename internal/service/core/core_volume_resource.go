@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -397,7 +396,7 @@ func (s *CoreVolumeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolume(context.Background(), request)
+	response, err := s.Client.CreateVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -414,7 +413,7 @@ func (s *CoreVolumeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -492,7 +491,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 
 		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateVolumeKmsKey(s.Context(), keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -521,7 +520,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolume(context.Background(), request)
+	response, err := s.Client.UpdateVolume(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -538,7 +537,7 @@ func (s *CoreVolumeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolume(context.Background(), request)
+	_, err := s.Client.DeleteVolume(s.Context(), request)
 	return err
 }
 
@@ -741,7 +740,7 @@ func (s *CoreVolumeResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
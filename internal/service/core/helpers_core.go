@@ -4,7 +4,6 @@
 package core
 
 import (
-	"context"
 	"fmt"
 	"net"
 	"strings"
@@ -76,7 +75,7 @@ func getBackupPolicyId(assetId *string, client *oci_core.BlockstorageClient) (*s
 	request.AssetId = assetId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := client.GetVolumeBackupPolicyAssetAssignment(tfresource.GetStopContext(), request)
 	if err != nil {
 		return nil, err
 	}
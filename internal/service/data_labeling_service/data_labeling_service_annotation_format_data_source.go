@@ -4,8 +4,6 @@
 package data_labeling_service
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -71,7 +69,7 @@ func (s *DataLabelingServiceAnnotationFormatDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
 
-	response, err := s.Client.ListAnnotationFormats(context.Background(), request)
+	response, err := s.Client.ListAnnotationFormats(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
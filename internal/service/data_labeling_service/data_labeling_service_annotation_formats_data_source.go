@@ -4,8 +4,6 @@
 package data_labeling_service
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -79,7 +77,7 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
 
-	response, err := s.Client.ListAnnotationFormats(context.Background(), request)
+	response, err := s.Client.ListAnnotationFormats(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +86,7 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnnotationFormats(context.Background(), request)
+		listResponse, err := s.Client.ListAnnotationFormats(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
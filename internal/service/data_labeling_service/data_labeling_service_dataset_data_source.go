@@ -4,8 +4,6 @@
 package data_labeling_service
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DataLabelingServiceDatasetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
 
-	response, err := s.Client.GetDataset(context.Background(), request)
+	response, err := s.Client.GetDataset(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package data_labeling_service
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -361,7 +360,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.CreateDataset(context.Background(), request)
+	response, err := s.Client.CreateDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -427,7 +426,7 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_labeling_service.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -463,7 +462,7 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 }
 
 func getErrorFromDataLabelingServiceDatasetWorkRequest(client *oci_data_labeling_service.DataLabelingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_labeling_service.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_labeling_service.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -493,7 +492,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.GetDataset(context.Background(), request)
+	response, err := s.Client.GetDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -564,7 +563,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.UpdateDataset(context.Background(), request)
+	response, err := s.Client.UpdateDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -581,7 +580,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.DeleteDataset(context.Background(), request)
+	response, err := s.Client.DeleteDataset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -923,7 +922,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.ChangeDatasetCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatasetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -956,7 +955,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, new
 		addDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 		addDatasetLabelsRequest.LabelSet = &oci_data_labeling_service.LabelSet{Items: labelSetDiffAdd}
 
-		response, err := s.Client.AddDatasetLabels(context.Background(), addDatasetLabelsRequest)
+		response, err := s.Client.AddDatasetLabels(s.Context(), addDatasetLabelsRequest)
 		if err != nil {
 			return err
 		}
@@ -990,7 +989,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, new
 		removeDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 		removeDatasetLabelsRequest.LabelSet = &oci_data_labeling_service.LabelSet{Items: labelSetDiffRemove}
 
-		response, err := s.Client.RemoveDatasetLabels(context.Background(), removeDatasetLabelsRequest)
+		response, err := s.Client.RemoveDatasetLabels(s.Context(), removeDatasetLabelsRequest)
 		if err != nil {
 			return err
 		}
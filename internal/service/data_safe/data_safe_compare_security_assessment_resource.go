@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -90,7 +89,7 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +113,7 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CompareSecurityAssessment(context.Background(), request)
+	response, err := s.Client.CompareSecurityAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +177,7 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -214,7 +213,7 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 }
 
 func getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -90,7 +89,7 @@ func (s *DataSafeCompareUserAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetUserAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetUserAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +113,7 @@ func (s *DataSafeCompareUserAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CompareUserAssessment(context.Background(), request)
+	response, err := s.Client.CompareUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +179,7 @@ func compareUserAssessmentWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -216,7 +215,7 @@ func compareUserAssessmentWaitForWorkRequest(wId *string, entityType string, act
 }
 
 func getErrorFromDataSafeCompareUserAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -136,7 +135,7 @@ func (s *DataSafeDataSafeConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.EnableDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.EnableDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +200,7 @@ func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -235,7 +234,7 @@ func dataSafeConfigurationWaitForWorkRequest(wId *string, entityType string, act
 }
 
 func getErrorFromDataSafeDataSafeConfigurationWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -267,7 +266,7 @@ func (s *DataSafeDataSafeConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.GetDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -291,7 +290,7 @@ func (s *DataSafeDataSafeConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.EnableDataSafeConfiguration(context.Background(), request)
+	response, err := s.Client.EnableDataSafeConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
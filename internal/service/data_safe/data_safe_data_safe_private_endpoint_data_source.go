@@ -4,8 +4,6 @@
 package data_safe
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DataSafeDataSafePrivateEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDataSafePrivateEndpoint(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
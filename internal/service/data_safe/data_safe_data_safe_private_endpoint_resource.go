@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -242,7 +241,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -307,7 +306,7 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -343,7 +342,7 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 }
 
 func getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -373,7 +372,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -435,7 +434,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -452,7 +451,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteDataSafePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -533,7 +532,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) updateCompartment(compartm
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.ChangeDataSafePrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDataSafePrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package data_safe
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DataSafeOnPremConnectorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetOnPremConnector(context.Background(), request)
+	response, err := s.Client.GetOnPremConnector(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
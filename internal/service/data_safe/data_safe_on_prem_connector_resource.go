@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -193,7 +192,7 @@ func (s *DataSafeOnPremConnectorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateOnPremConnector(context.Background(), request)
+	response, err := s.Client.CreateOnPremConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +258,7 @@ func onPremConnectorWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -295,7 +294,7 @@ func onPremConnectorWaitForWorkRequest(wId *string, entityType string, action oc
 }
 
 func getErrorFromDataSafeOnPremConnectorWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -325,7 +324,7 @@ func (s *DataSafeOnPremConnectorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetOnPremConnector(context.Background(), request)
+	response, err := s.Client.GetOnPremConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -373,7 +372,7 @@ func (s *DataSafeOnPremConnectorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateOnPremConnector(context.Background(), request)
+	response, err := s.Client.UpdateOnPremConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -390,7 +389,7 @@ func (s *DataSafeOnPremConnectorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteOnPremConnector(context.Background(), request)
+	response, err := s.Client.DeleteOnPremConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -455,7 +454,7 @@ func (s *DataSafeOnPremConnectorResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeOnPremConnectorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOnPremConnectorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package data_safe
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -102,7 +100,7 @@ func (s *DataSafeOnPremConnectorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListOnPremConnectors(context.Background(), request)
+	response, err := s.Client.ListOnPremConnectors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +109,7 @@ func (s *DataSafeOnPremConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOnPremConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListOnPremConnectors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
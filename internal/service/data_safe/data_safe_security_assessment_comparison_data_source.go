@@ -4,8 +4,6 @@
 package data_safe
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -1356,7 +1354,7 @@ func (s *DataSafeSecurityAssessmentComparisonDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessmentComparison(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package data_safe
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -165,7 +163,7 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListFindings(context.Background(), request)
+	response, err := s.Client.ListFindings(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +172,7 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFindings(context.Background(), request)
+		listResponse, err := s.Client.ListFindings(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -564,7 +563,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateSecurityAssessment(context.Background(), request)
+	response, err := s.Client.CreateSecurityAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -628,7 +627,7 @@ func securityAssessmentWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -664,7 +663,7 @@ func securityAssessmentWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromDataSafeSecurityAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -694,7 +693,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessment(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -747,7 +746,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateSecurityAssessment(context.Background(), request)
+	response, err := s.Client.UpdateSecurityAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -764,7 +763,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteSecurityAssessment(context.Background(), request)
+	response, err := s.Client.DeleteSecurityAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -951,7 +950,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeSecurityAssessmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityAssessmentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -171,7 +170,7 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListSecurityAssessments(context.Background(), request)
+	response, err := s.Client.ListSecurityAssessments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +179,7 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecurityAssessments(context.Background(), request)
+		listResponse, err := s.Client.ListSecurityAssessments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
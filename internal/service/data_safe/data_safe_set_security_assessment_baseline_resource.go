@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -104,7 +103,7 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetSecurityAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +136,7 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetSecurityAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +200,7 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -237,7 +236,7 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 }
 
 func getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
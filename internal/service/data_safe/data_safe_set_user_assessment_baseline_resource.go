@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -105,7 +104,7 @@ func (s *DataSafeSetUserAssessmentBaselineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetUserAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetUserAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +139,7 @@ func (s *DataSafeSetUserAssessmentBaselineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetUserAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetUserAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +203,7 @@ func setUserAssessmentBaselineWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -240,7 +239,7 @@ func setUserAssessmentBaselineWaitForWorkRequest(wId *string, entityType string,
 }
 
 func getErrorFromDataSafeSetUserAssessmentBaselineWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
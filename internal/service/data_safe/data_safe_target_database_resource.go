@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -402,7 +401,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateTargetDatabase(context.Background(), request)
+	response, err := s.Client.CreateTargetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -466,7 +465,7 @@ func targetDatabaseWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -502,7 +501,7 @@ func targetDatabaseWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromDataSafeTargetDatabaseWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -532,7 +531,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetTargetDatabase(context.Background(), request)
+	response, err := s.Client.GetTargetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -624,7 +623,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateTargetDatabase(context.Background(), request)
+	response, err := s.Client.UpdateTargetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -641,7 +640,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteTargetDatabase(context.Background(), request)
+	response, err := s.Client.DeleteTargetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -979,7 +978,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeTargetDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTargetDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -81,7 +80,7 @@ func (s *DataSafeUnsetUserAssessmentBaselineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetUserAssessment(context.Background(), request)
+	response, err := s.Client.GetUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -100,7 +99,7 @@ func (s *DataSafeUnsetUserAssessmentBaselineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UnsetUserAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.UnsetUserAssessmentBaseline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +163,7 @@ func unsetUserAssessmentBaselineWaitForWorkRequest(wId *string, entityType strin
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -200,7 +199,7 @@ func unsetUserAssessmentBaselineWaitForWorkRequest(wId *string, entityType strin
 }
 
 func getErrorFromDataSafeUnsetUserAssessmentBaselineWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
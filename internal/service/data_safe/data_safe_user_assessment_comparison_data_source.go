@@ -4,8 +4,6 @@
 package data_safe
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -117,7 +115,7 @@ func (s *DataSafeUserAssessmentComparisonDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetUserAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetUserAssessmentComparison(s.Context(), request)
 	if err != nil {
 		return err
 	}
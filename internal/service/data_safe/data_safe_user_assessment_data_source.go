@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -52,7 +51,7 @@ func (s *DataSafeUserAssessmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetUserAssessment(context.Background(), request)
+	response, err := s.Client.GetUserAssessment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
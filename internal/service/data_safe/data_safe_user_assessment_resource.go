@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -273,7 +272,7 @@ func (s *DataSafeUserAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateUserAssessment(context.Background(), request)
+	response, err := s.Client.CreateUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +336,7 @@ func userAssessmentWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -373,7 +372,7 @@ func userAssessmentWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromDataSafeUserAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -403,7 +402,7 @@ func (s *DataSafeUserAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetUserAssessment(context.Background(), request)
+	response, err := s.Client.GetUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -456,7 +455,7 @@ func (s *DataSafeUserAssessmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateUserAssessment(context.Background(), request)
+	response, err := s.Client.UpdateUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -473,7 +472,7 @@ func (s *DataSafeUserAssessmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteUserAssessment(context.Background(), request)
+	response, err := s.Client.DeleteUserAssessment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -582,7 +581,7 @@ func (s *DataSafeUserAssessmentResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeUserAssessmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeUserAssessmentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
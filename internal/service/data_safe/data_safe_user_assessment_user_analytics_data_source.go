@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -230,7 +229,7 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListUserAnalytics(context.Background(), request)
+	response, err := s.Client.ListUserAnalytics(tfresource.GetResourceDataContext(s.D), request)
 	fmt.Println("response in get ****************", response)
 
 	if err != nil {
@@ -242,7 +241,7 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserAnalytics(context.Background(), request)
+		listResponse, err := s.Client.ListUserAnalytics(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
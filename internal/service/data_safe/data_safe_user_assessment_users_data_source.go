@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -266,7 +265,7 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +274,7 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+		listResponse, err := s.Client.ListUsers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package data_safe
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -193,7 +192,7 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListUserAssessments(context.Background(), request)
+	response, err := s.Client.ListUserAssessments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +201,7 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserAssessments(context.Background(), request)
+		listResponse, err := s.Client.ListUserAssessments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
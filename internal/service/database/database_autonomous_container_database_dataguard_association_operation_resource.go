@@ -1,7 +1,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -112,7 +111,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 			switchoverRequest.AutonomousContainerDatabaseId = &tmpId
 			switchoverRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 			switchoverRequest.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 2
-			response, err := s.Client.SwitchoverAutonomousContainerDatabaseDataguardAssociation(context.Background(), switchoverRequest)
+			response, err := s.Client.SwitchoverAutonomousContainerDatabaseDataguardAssociation(s.Context(), switchoverRequest)
 			if err != nil {
 				return err
 			}
@@ -130,7 +129,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 			failoverRequest.AutonomousContainerDatabaseId = &tmpId
 			failoverRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 			failoverRequest.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 2
-			response, err := s.Client.FailoverAutonomousContainerDatabaseDataguardAssociation(context.Background(), failoverRequest)
+			response, err := s.Client.FailoverAutonomousContainerDatabaseDataguardAssociation(s.Context(), failoverRequest)
 			if err != nil {
 				return err
 			}
@@ -148,7 +147,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationOperationResourc
 			reinstateRequest.AutonomousContainerDatabaseId = &tmpId
 			reinstateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 			reinstateRequest.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 2
-			response, err := s.Client.ReinstateAutonomousContainerDatabaseDataguardAssociation(context.Background(), reinstateRequest)
+			response, err := s.Client.ReinstateAutonomousContainerDatabaseDataguardAssociation(s.Context(), reinstateRequest)
 			if err != nil {
 				return err
 			}
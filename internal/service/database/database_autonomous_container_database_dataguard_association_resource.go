@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -198,7 +197,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Cr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +240,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -270,7 +269,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Up
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(context.Background(), request)
+	response, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +66,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
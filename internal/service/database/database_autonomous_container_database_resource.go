@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -787,7 +786,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -811,7 +810,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -885,7 +884,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -910,7 +909,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateAutonomousContainerDatabase(context.Background(), request)
+	_, err := s.Client.TerminateAutonomousContainerDatabase(s.Context(), request)
 	return err
 }
 
@@ -1293,7 +1292,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousContainerDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -1317,7 +1316,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) RotateContainerDatabas
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateAutonomousContainerDatabaseEncryptionKey(context.Background(), request)
+	response, err := s.Client.RotateAutonomousContainerDatabaseEncryptionKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -175,7 +174,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabaseBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +199,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
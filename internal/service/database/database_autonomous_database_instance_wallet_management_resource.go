@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -142,7 +140,7 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +164,7 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Update(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateAutonomousDatabaseWallet(context.Background(), request)
+	_, err := s.Client.UpdateAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -41,7 +39,7 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -135,7 +133,7 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +152,7 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Update(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateAutonomousDatabaseRegionalWallet(context.Background(), request)
+	_, err := s.Client.UpdateAutonomousDatabaseRegionalWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -955,7 +954,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -980,7 +979,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1255,7 +1254,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1280,7 +1279,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousDatabase(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousDatabase(s.Context(), request)
 	return err
 }
 
@@ -2748,7 +2747,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeAutonomousDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAutonomousDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -2773,7 +2772,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.RegisterAutonomousDatabaseDataSafe(context.Background(), request)
+		response, err := s.Client.RegisterAutonomousDatabaseDataSafe(s.Context(), request)
 
 		if err != nil {
 			return err
@@ -2794,7 +2793,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.DeregisterAutonomousDatabaseDataSafe(context.Background(), request)
+		response, err := s.Client.DeregisterAutonomousDatabaseDataSafe(s.Context(), request)
 
 		if err != nil {
 			return err
@@ -2821,7 +2820,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDbVersion(dbVersion strin
 
 	changeDbVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), changeDbVersionRequest)
+	response, err := s.Client.UpdateAutonomousDatabase(s.Context(), changeDbVersionRequest)
 	if err != nil {
 		return err
 	}
@@ -2861,7 +2860,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateNsgIds(nsgIds []string) e
 
 	changeNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), changeNsgIdsRequest)
+	response, err := s.Client.UpdateAutonomousDatabase(s.Context(), changeNsgIdsRequest)
 	if err != nil {
 		return err
 	}
@@ -2940,7 +2939,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.SwitchoverAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.SwitchoverAutonomousDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -2963,7 +2962,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.EnableAutonomousDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.EnableAutonomousDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -2980,7 +2979,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.DisableAutonomousDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.DisableAutonomousDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -3003,7 +3002,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.EnableAutonomousDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableAutonomousDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -3019,7 +3018,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 		request := oci_database.DisableAutonomousDatabaseManagementRequest{}
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.DisableAutonomousDatabaseManagement(context.Background(), request)
+		response, err := s.Client.DisableAutonomousDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -3050,7 +3049,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase(state o
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.StartAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.StartAutonomousDatabase(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
@@ -3066,7 +3065,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase(state oc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.StopAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.StopAutonomousDatabase(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
@@ -3102,7 +3101,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(aut
 	}
 	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	updateResponse, err := s.Client.UpdateAutonomousDatabase(context.Background(), updateRequest)
+	updateResponse, err := s.Client.UpdateAutonomousDatabase(s.Context(), updateRequest)
 	if err != nil {
 		return err
 	}
@@ -3129,7 +3128,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) RotateAutonomousDatabaseEncrypt
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateAutonomousDatabaseEncryptionKey(context.Background(), request)
+	response, err := s.Client.RotateAutonomousDatabaseEncryptionKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -3158,7 +3157,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) ConfigureAutonomousDatabaseVaul
 
 	request.VaultId = &vautlId
 
-	response, err := s.Client.ConfigureAutonomousDatabaseVaultKey(context.Background(), request)
+	response, err := s.Client.ConfigureAutonomousDatabaseVaultKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
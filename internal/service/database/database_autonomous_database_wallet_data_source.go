@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"encoding/base64"
 	"io/ioutil"
 
@@ -87,7 +86,7 @@ func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GenerateAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDatabaseWallet(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"encoding/base64"
 	"io/ioutil"
 
@@ -106,7 +105,7 @@ func (s *DatabaseAutonomousDatabaseWalletResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GenerateAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDatabaseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -643,7 +641,7 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseClones(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseClones(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -652,7 +650,7 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseClones(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabaseClones(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
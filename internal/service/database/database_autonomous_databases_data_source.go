@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -137,7 +135,7 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabases(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabases(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +144,7 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabases(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -87,7 +85,7 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDbPreviewVersions(context.Background(), request)
+	response, err := s.Client.ListAutonomousDbPreviewVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +94,7 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDbPreviewVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDbPreviewVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -107,7 +105,7 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDbVersions(context.Background(), request)
+	response, err := s.Client.ListAutonomousDbVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -116,7 +114,7 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDbVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDbVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -83,7 +81,7 @@ func (s *DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExadataInfrastructureOcpus(context.Background(), request)
+	response, err := s.Client.GetExadataInfrastructureOcpus(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
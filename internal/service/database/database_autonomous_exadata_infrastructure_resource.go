@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -467,7 +466,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LaunchAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.LaunchAutonomousExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -491,7 +490,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetAutonomousExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -559,7 +558,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -584,7 +583,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateAutonomousExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.TerminateAutonomousExadataInfrastructure(s.Context(), request)
 	return err
 }
 
@@ -824,7 +823,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) updateCompartment(
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousExadataInfrastructureCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
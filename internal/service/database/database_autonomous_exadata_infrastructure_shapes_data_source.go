@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -100,7 +98,7 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousExadataInfrastructureShapes(context.Background(), request)
+	response, err := s.Client.ListAutonomousExadataInfrastructureShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +107,7 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousExadataInfrastructureShapes(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousExadataInfrastructureShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
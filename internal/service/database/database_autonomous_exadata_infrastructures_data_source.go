@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *DatabaseAutonomousExadataInfrastructuresDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousExadataInfrastructures(context.Background(), request)
+	response, err := s.Client.ListAutonomousExadataInfrastructures(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *DatabaseAutonomousExadataInfrastructuresDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousExadataInfrastructures(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousExadataInfrastructures(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -90,7 +88,7 @@ func (s *DatabaseAutonomousPatchDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousPatch(context.Background(), request)
+	response, err := s.Client.GetAutonomousPatch(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
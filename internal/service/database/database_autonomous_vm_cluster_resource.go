@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -261,7 +259,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.CreateAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -278,7 +276,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -320,7 +318,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +335,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousVmCluster(s.Context(), request)
 	return err
 }
 
@@ -430,7 +428,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
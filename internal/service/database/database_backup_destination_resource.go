@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -259,7 +258,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackupDestination(context.Background(), request)
+	response, err := s.Client.CreateBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -276,7 +275,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackupDestination(context.Background(), request)
+	response, err := s.Client.GetBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,7 +341,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateBackupDestination(context.Background(), request)
+	response, err := s.Client.UpdateBackupDestination(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -359,7 +358,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackupDestination(context.Background(), request)
+	_, err := s.Client.DeleteBackupDestination(s.Context(), request)
 	return err
 }
 
@@ -649,7 +648,7 @@ func (s *DatabaseBackupDestinationResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeBackupDestinationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBackupDestinationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -172,7 +171,7 @@ func (s *DatabaseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +188,7 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +205,7 @@ func (s *DatabaseBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -69,7 +68,7 @@ func (s *DatabaseBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListBackups(context.Background(), request)
+	response, err := s.Client.ListBackups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -78,7 +77,7 @@ func (s *DatabaseBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBackups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
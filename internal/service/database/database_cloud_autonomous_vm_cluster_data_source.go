@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatabaseCloudAutonomousVmClusterDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudAutonomousVmCluster(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -338,7 +336,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = nil //tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.CreateCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -367,7 +365,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -433,7 +431,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -458,7 +456,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.DeleteCloudAutonomousVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -589,7 +587,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeCloudAutonomousVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCloudAutonomousVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -612,7 +610,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateCloudAutonomousVmClusterOrdsCerts(context.Background(), request)
+	response, err := s.Client.RotateCloudAutonomousVmClusterOrdsCerts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -634,7 +632,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateCloudAutonomousVmClusterSslCerts(context.Background(), request)
+	response, err := s.Client.RotateCloudAutonomousVmClusterSslCerts(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"time"
@@ -156,7 +155,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Create() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -181,7 +180,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +238,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Update() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.ModifyDatabaseManagement(context.Background(), request)
+		response, err := s.Client.ModifyDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -264,7 +263,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -300,7 +299,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +357,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -366,7 +365,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.CreateCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,7 +382,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -464,7 +463,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateCloudExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -486,7 +485,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteCloudExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.DeleteCloudExadataInfrastructure(s.Context(), request)
 	return err
 }
 
@@ -683,7 +682,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) updateCompartment(compa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeCloudExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCloudExadataInfrastructureCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
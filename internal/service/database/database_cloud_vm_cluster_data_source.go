@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *DatabaseCloudVmClusterDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetCloudVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudVmCluster(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -539,7 +538,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudVmCluster(context.Background(), request)
+	response, err := s.Client.CreateCloudVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -556,7 +555,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -672,7 +671,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateCloudVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -689,7 +688,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteCloudVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteCloudVmCluster(s.Context(), request)
 	return err
 }
 
@@ -860,7 +859,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeCloudVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCloudVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -290,7 +289,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.CreateDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,7 +311,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +351,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateDataGuardAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -641,14 +640,14 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		deleteDBrequest.DatabaseId = standbyDatabaseId
 		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		if _, err = s.Client.DeleteDatabase(context.Background(), deleteDBrequest); err != nil {
+		if _, err = s.Client.DeleteDatabase(s.Context(), deleteDBrequest); err != nil {
 			return fmt.Errorf("failed to delete the standby database")
 		}
 
 		getDatabaseRequest := oci_database.GetDatabaseRequest{}
 		getDatabaseRequest.DatabaseId = standbyDatabaseId
 		getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseToTerminateRetryPolicy(2 * time.Hour)
-		getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+		getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 
 		if getDatabaseResponse.LifecycleState == oci_database.DatabaseLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the standby database could not be deleted")
@@ -675,7 +674,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		request := oci_database.TerminateDbSystemRequest{}
 		request.DbSystemId = standbyDbSystemId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		_, err := s.Client.TerminateDbSystem(context.Background(), request)
+		_, err := s.Client.TerminateDbSystem(s.Context(), request)
 		if err != nil {
 			return fmt.Errorf("could not delete standby DB System to delete the data guard association: %v", err)
 		}
@@ -683,7 +682,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		getDbSystemRequest := oci_database.GetDbSystemRequest{}
 		getDbSystemRequest.DbSystemId = standbyDbSystemId
 		getDbSystemRequest.RequestMetadata.RetryPolicy = waitForDbSystemToTerminateRetryPolicy(2 * time.Hour)
-		getDbSystemResponse, err := s.Client.GetDbSystem(context.Background(), getDbSystemRequest)
+		getDbSystemResponse, err := s.Client.GetDbSystem(s.Context(), getDbSystemRequest)
 
 		if getDbSystemResponse.LifecycleState == oci_database.DbSystemLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the dbSystem could not be deleted")
@@ -709,14 +708,14 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		deleteDBrequest.DatabaseId = standbyDatabaseId
 		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		if _, err = s.Client.DeleteDatabase(context.Background(), deleteDBrequest); err != nil {
+		if _, err = s.Client.DeleteDatabase(s.Context(), deleteDBrequest); err != nil {
 			return fmt.Errorf("failed to delete the standby database")
 		}
 
 		getDatabaseRequest := oci_database.GetDatabaseRequest{}
 		getDatabaseRequest.DatabaseId = standbyDatabaseId
 		getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseToTerminateRetryPolicy(2 * time.Hour)
-		getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+		getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 
 		if getDatabaseResponse.LifecycleState == oci_database.DatabaseLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the standby database could not be deleted")
@@ -742,7 +741,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) GetDbHomeIdFromDatabaseId(dat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -764,7 +763,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDbHomeId(dbH
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -127,7 +125,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListPdbConversionHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListPdbConversionHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +134,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPdbConversionHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListPdbConversionHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
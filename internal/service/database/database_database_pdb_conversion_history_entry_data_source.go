@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetPdbConversionHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetPdbConversionHistoryEntry(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
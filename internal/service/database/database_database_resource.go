@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -518,7 +517,7 @@ func (s *DatabaseDatabaseResourceCrud) Create() error {
 	createDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutCreate))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", createDatabaseRetryDurationFn)
 
-	response, err := s.Client.CreateDatabase(context.Background(), request)
+	response, err := s.Client.CreateDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -542,7 +541,7 @@ func (s *DatabaseDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -565,7 +564,7 @@ func (s *DatabaseDatabaseResourceCrud) Delete() error {
 	deleteDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutDelete))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", deleteDatabaseRetryDurationFn)
 
-	_, err := s.Client.DeleteDatabase(context.Background(), request)
+	_, err := s.Client.DeleteDatabase(s.Context(), request)
 	return err
 }
 
@@ -1009,7 +1008,7 @@ func (s *DatabaseDatabaseResourceCrud) Update() error {
 	updateDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutUpdate))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", updateDatabaseRetryDurationFn)
 
-	response, err := s.Client.UpdateDatabase(context.Background(), request)
+	response, err := s.Client.UpdateDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1157,7 +1156,7 @@ func (s *DatabaseDatabaseResourceCrud) kmsRotation(databaseId string) error {
 		rotateKeyRequest := oci_database.RotateVaultKeyRequest{}
 		rotateKeyRequest.DatabaseId = &databaseId
 		rotateKeyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.RotateVaultKey(context.Background(), rotateKeyRequest)
+		response, err := s.Client.RotateVaultKey(s.Context(), rotateKeyRequest)
 		if err != nil {
 			return err
 		}
@@ -1194,7 +1193,7 @@ func (s *DatabaseDatabaseResourceCrud) kmsMigration(databaseId string) error {
 				migrationKeyRequest.KmsKeyVersionId = &temp
 			}
 		}
-		response, err := s.Client.MigrateVaultKey(context.Background(), migrationKeyRequest)
+		response, err := s.Client.MigrateVaultKey(s.Context(), migrationKeyRequest)
 		if err != nil {
 			return err
 		}
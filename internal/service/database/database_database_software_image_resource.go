@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -270,7 +268,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.CreateDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +290,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.GetDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -335,7 +333,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseSoftwareImage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -357,7 +355,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteDatabaseSoftwareImage(context.Background(), request)
+	_, err := s.Client.DeleteDatabaseSoftwareImage(s.Context(), request)
 	return err
 }
 
@@ -428,7 +426,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) updateCompartment(compartmen
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeDatabaseSoftwareImageCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDatabaseSoftwareImageCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -101,7 +99,7 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDatabaseSoftwareImages(context.Background(), request)
+	response, err := s.Client.ListDatabaseSoftwareImages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +108,7 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseSoftwareImages(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseSoftwareImages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -131,7 +129,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDatabaseUpgradeHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDatabaseUpgradeHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +138,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseUpgradeHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseUpgradeHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -107,7 +105,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDatabaseUpgradeHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetDatabaseUpgradeHistoryEntry(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
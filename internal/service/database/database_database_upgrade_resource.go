@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -333,7 +332,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpgradeDatabase(context.Background(), request)
+	response, err := s.Client.UpgradeDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -458,7 +457,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
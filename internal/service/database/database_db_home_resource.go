@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -454,7 +453,7 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", createDbHomeRetryDurationFn)
 
-	response, err := s.Client.CreateDbHome(context.Background(), request)
+	response, err := s.Client.CreateDbHome(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -485,7 +484,7 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -528,7 +527,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 			}
 		}
 	}
-	response, err := s.Client.UpdateDbHome(context.Background(), updateDbHomeRequest)
+	response, err := s.Client.UpdateDbHome(s.Context(), updateDbHomeRequest)
 	if err != nil {
 		return err
 	}
@@ -569,7 +568,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), request)
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -587,7 +586,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	getDatabaseRequest.DatabaseId = s.Database.Id
 
 	getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseUpdateRetryPolicy(s.D.Timeout(schema.TimeoutUpdate))
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		s.Database = &updateDatabaseResponse.Database
 		err = s.SetData()
@@ -623,7 +622,7 @@ func (s *DatabaseDbHomeResourceCrud) Delete() error {
 		log.Printf("[WARN] Could not delete nested database in DbHome. Will proceed to delete dbHome: %v", dbErr)
 	}
 
-	_, err := s.Client.DeleteDbHome(context.Background(), request)
+	_, err := s.Client.DeleteDbHome(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1151,7 +1150,7 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 	listDBRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDBRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 	listDBRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDBRequest)
+	listDatabasesResponse, err := s.Client.ListDatabases(s.Context(), listDBRequest)
 	if err != nil {
 		return err
 	}
@@ -1180,7 +1179,7 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteDatabase(context.Background(), request)
+	response, err := s.Client.DeleteDatabase(s.Context(), request)
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
@@ -1201,7 +1200,7 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 	listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+	listDatabasesResponse, err := s.Client.ListDatabases(s.Context(), listDatabasesRequest)
 	if err != nil {
 		return err
 	}
@@ -1214,7 +1213,7 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
 	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		return err
 	}
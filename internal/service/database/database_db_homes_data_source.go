@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -112,7 +110,7 @@ func (s *DatabaseDbHomesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbHomes(context.Background(), request)
+	response, err := s.Client.ListDbHomes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +119,7 @@ func (s *DatabaseDbHomesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomes(context.Background(), request)
+		listResponse, err := s.Client.ListDbHomes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
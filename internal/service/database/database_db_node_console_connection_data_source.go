@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -68,7 +67,7 @@ func (s *DatabaseDbNodeConsoleConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetConsoleConnection(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
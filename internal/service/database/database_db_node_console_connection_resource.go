@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -139,7 +138,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +173,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetConsoleConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +200,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteConsoleConnection(s.Context(), request)
 	return err
 }
 
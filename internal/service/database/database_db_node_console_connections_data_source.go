@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v55/database"
 
@@ -59,7 +57,7 @@ func (s *DatabaseDbNodeConsoleConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListConsoleConnections(context.Background(), request)
+	response, err := s.Client.ListConsoleConnections(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
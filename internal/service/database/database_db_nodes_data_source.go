@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -190,7 +188,7 @@ func (s *DatabaseDbNodesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbNodes(context.Background(), request)
+	response, err := s.Client.ListDbNodes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +198,7 @@ func (s *DatabaseDbNodesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbNodes(context.Background(), request)
+		listResponse, err := s.Client.ListDbNodes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
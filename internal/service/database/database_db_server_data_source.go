@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -131,7 +129,7 @@ func (s *DatabaseDbServerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDbServer(context.Background(), request)
+	response, err := s.Client.GetDbServer(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
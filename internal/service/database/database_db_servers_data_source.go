@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -169,7 +167,7 @@ func (s *DatabaseDbServersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbServers(context.Background(), request)
+	response, err := s.Client.ListDbServers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +176,7 @@ func (s *DatabaseDbServersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbServers(context.Background(), request)
+		listResponse, err := s.Client.ListDbServers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
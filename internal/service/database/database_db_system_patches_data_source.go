@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -102,7 +100,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemPatches(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatches(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +109,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatches(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemPatches(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
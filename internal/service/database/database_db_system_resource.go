@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -932,7 +931,7 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LaunchDbSystem(context.Background(), request)
+	response, err := s.Client.LaunchDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -963,7 +962,7 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1086,7 +1085,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1129,7 +1128,7 @@ func (s *DatabaseDbSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateDbSystem(context.Background(), request)
+	_, err := s.Client.TerminateDbSystem(s.Context(), request)
 	return err
 }
 
@@ -2755,7 +2754,7 @@ func (s *DatabaseDbSystemResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeDbSystemCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDbSystemCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -2834,7 +2833,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDbHomeRequest.SortBy = oci_database.ListDbHomesSortByTimecreated
 			listDbHomeRequest.SortOrder = oci_database.ListDbHomesSortOrderAsc
 			listDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-			listDbHomeResponse, err := s.Client.ListDbHomes(context.Background(), listDbHomeRequest)
+			listDbHomeResponse, err := s.Client.ListDbHomes(s.Context(), listDbHomeRequest)
 			if err != nil {
 				return err
 			}
@@ -2854,7 +2853,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	getDbHomeRequest := oci_database.GetDbHomeRequest{}
 	getDbHomeRequest.DbHomeId = dbHomeId
 	getDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+	getDbHomeResponse, err := s.Client.GetDbHome(s.Context(), getDbHomeRequest)
 	if err != nil {
 		return err
 	}
@@ -2876,7 +2875,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 			listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 			listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-			listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+			listDatabasesResponse, err := s.Client.ListDatabases(s.Context(), listDatabasesRequest)
 			if err != nil {
 				return err
 			}
@@ -2894,7 +2893,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
 	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -2928,7 +2927,7 @@ func waitForDbSystemIfItIsUpdating(dbSystemID *string, client *oci_database.Data
 	}
 
 	getDbSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyWithAdditionalRetryCondition(timeout, dbSystemUpdating, "database")
-	getDbSystemResponse, err := client.GetDbSystem(context.Background(), getDbSystemRequest)
+	getDbSystemResponse, err := client.GetDbSystem(tfresource.GetStopContext(), getDbSystemRequest)
 	return &getDbSystemResponse, err
 }
 
@@ -2949,7 +2948,7 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 	}
 
 	updateDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), updateDatabaseRequest)
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(s.Context(), updateDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -2967,7 +2966,7 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 	getDatabaseRequest.DatabaseId = s.Database.Id
 
 	getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseUpdateRetryPolicy(s.D.Timeout(schema.TimeoutUpdate))
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(s.Context(), getDatabaseRequest)
 	if err != nil {
 		s.Database = &updateDatabaseResponse.Database
 		err = s.SetData()
@@ -2989,7 +2988,7 @@ func (s *DatabaseDbSystemResourceCrud) sendUpdateForLicenseModel(dbSystemId stri
 	request.DbSystemId = &dbSystemId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -161,7 +159,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemShapes(context.Background(), request)
+	response, err := s.Client.ListDbSystemShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +168,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
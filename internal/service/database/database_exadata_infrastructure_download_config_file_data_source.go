@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"encoding/base64"
 	"io/ioutil"
 	"log"
@@ -66,7 +65,7 @@ func (s *DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.DownloadExadataInfrastructureConfigFile(context.Background(), request)
+	response, err := s.Client.DownloadExadataInfrastructureConfigFile(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
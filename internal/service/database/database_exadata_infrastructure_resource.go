@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -554,7 +553,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.CreateExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -585,7 +584,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -739,7 +738,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 		}
 	}
 
-	response, err := s.Client.UpdateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -761,7 +760,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.DeleteExadataInfrastructure(s.Context(), request)
 	return err
 }
 
@@ -1109,7 +1108,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) updateCompartment(compartmen
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExadataInfrastructureCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -1136,7 +1135,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) activateExadataInfrastructur
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ActivateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.ActivateExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
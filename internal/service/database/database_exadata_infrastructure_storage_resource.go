@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -335,7 +333,7 @@ func (s *DatabaseExadataInfrastructureStorageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.AddStorageCapacityExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.AddStorageCapacityExadataInfrastructure(s.Context(), request)
 	if err != nil {
 		return err
 	}
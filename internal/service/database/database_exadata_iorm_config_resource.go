@@ -5,7 +5,6 @@ package database
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -174,7 +173,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.GetExadataIormConfig(context.Background(), request); err != nil {
+	if _, err := s.Client.GetExadataIormConfig(s.Context(), request); err != nil {
 		return err
 	}
 
@@ -198,7 +197,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExadataIormConfig(context.Background(), request)
+	response, err := s.Client.GetExadataIormConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +238,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExadataIormConfig(context.Background(), request)
+	response, err := s.Client.UpdateExadataIormConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -341,7 +340,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExadataIormConfig(context.Background(), request)
+	response, err := s.Client.UpdateExadataIormConfig(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -129,7 +127,7 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Create() error
 		}
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -154,7 +152,7 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +194,7 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Update() error
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -221,7 +219,7 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Update() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +255,7 @@ func (s *DatabaseExternalContainerDatabaseManagementResourceCrud) Delete() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
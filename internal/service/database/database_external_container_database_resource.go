@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -232,7 +230,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateExternalContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -258,7 +256,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +299,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateExternalContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -325,7 +323,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.DeleteExternalContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -418,7 +416,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExternalContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExternalContainerDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
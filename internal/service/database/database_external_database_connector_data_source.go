@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *DatabaseExternalDatabaseConnectorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.GetExternalDatabaseConnector(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
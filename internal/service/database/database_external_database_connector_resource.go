@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -259,7 +258,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.CreateExternalDatabaseConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +284,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.GetExternalDatabaseConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +302,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.UpdateExternalDatabaseConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +326,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.DeleteExternalDatabaseConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -86,7 +85,7 @@ func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListExternalDatabaseConnectors(context.Background(), request)
+	response, err := s.Client.ListExternalDatabaseConnectors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +94,7 @@ func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExternalDatabaseConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListExternalDatabaseConnectors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
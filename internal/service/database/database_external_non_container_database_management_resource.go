@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -128,7 +126,7 @@ func (s *DatabaseExternalNonContainerDatabaseManagementResourceCrud) Create() er
 		}
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalNonContainerDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalNonContainerDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -153,7 +151,7 @@ func (s *DatabaseExternalNonContainerDatabaseManagementResourceCrud) Create() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalNonContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalNonContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +193,7 @@ func (s *DatabaseExternalNonContainerDatabaseManagementResourceCrud) Update() er
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalNonContainerDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalNonContainerDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -220,7 +218,7 @@ func (s *DatabaseExternalNonContainerDatabaseManagementResourceCrud) Update() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalNonContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalNonContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +253,7 @@ func (s *DatabaseExternalNonContainerDatabaseManagementResourceCrud) Delete() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalNonContainerDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalNonContainerDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
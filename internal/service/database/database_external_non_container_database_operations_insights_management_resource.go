@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -120,7 +118,7 @@ func (s *DatabaseExternalNonContainerDatabaseOperationsInsightsManagementResourc
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalNonContainerDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.EnableExternalNonContainerDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -146,7 +144,7 @@ func (s *DatabaseExternalNonContainerDatabaseOperationsInsightsManagementResourc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalNonContainerDatabaseOperationsInsights(context.Background(), request)
+	response, err := s.Client.DisableExternalNonContainerDatabaseOperationsInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +183,7 @@ func (s *DatabaseExternalNonContainerDatabaseOperationsInsightsManagementResourc
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalNonContainerDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.EnableExternalNonContainerDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -211,7 +209,7 @@ func (s *DatabaseExternalNonContainerDatabaseOperationsInsightsManagementResourc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalNonContainerDatabaseOperationsInsights(context.Background(), request)
+	response, err := s.Client.DisableExternalNonContainerDatabaseOperationsInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +244,7 @@ func (s *DatabaseExternalNonContainerDatabaseOperationsInsightsManagementResourc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalNonContainerDatabaseOperationsInsights(context.Background(), request)
+	response, err := s.Client.DisableExternalNonContainerDatabaseOperationsInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -256,7 +254,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateExternalNonContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +281,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalNonContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -326,7 +324,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateExternalNonContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -350,7 +348,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.DeleteExternalNonContainerDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -475,7 +473,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) updateCompartment(com
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExternalNonContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExternalNonContainerDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
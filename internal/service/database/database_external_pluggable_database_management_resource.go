@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -118,7 +116,7 @@ func (s *DatabaseExternalPluggableDatabaseManagementResourceCrud) Create() error
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalPluggableDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalPluggableDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -143,7 +141,7 @@ func (s *DatabaseExternalPluggableDatabaseManagementResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalPluggableDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalPluggableDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +179,7 @@ func (s *DatabaseExternalPluggableDatabaseManagementResourceCrud) Update() error
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalPluggableDatabaseDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableExternalPluggableDatabaseDatabaseManagement(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -206,7 +204,7 @@ func (s *DatabaseExternalPluggableDatabaseManagementResourceCrud) Update() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalPluggableDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalPluggableDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +239,7 @@ func (s *DatabaseExternalPluggableDatabaseManagementResourceCrud) Delete() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalPluggableDatabaseDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableExternalPluggableDatabaseDatabaseManagement(s.Context(), request)
 	if err != nil {
 		return err
 	}
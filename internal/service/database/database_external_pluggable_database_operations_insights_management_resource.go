@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -120,7 +118,7 @@ func (s *DatabaseExternalPluggableDatabaseOperationsInsightsManagementResourceCr
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalPluggableDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.EnableExternalPluggableDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -146,7 +144,7 @@ func (s *DatabaseExternalPluggableDatabaseOperationsInsightsManagementResourceCr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalPluggableDatabaseOperationsInsights(context.Background(), request)
+	response, err := s.Client.DisableExternalPluggableDatabaseOperationsInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +182,7 @@ func (s *DatabaseExternalPluggableDatabaseOperationsInsightsManagementResourceCr
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalPluggableDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.EnableExternalPluggableDatabaseOperationsInsights(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -210,7 +208,7 @@ func (s *DatabaseExternalPluggableDatabaseOperationsInsightsManagementResourceCr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalPluggableDatabaseOperationsInsights(context.Background(), request)
+	response, err := s.Client.DisableExternalPluggableDatabaseOperationsInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +243,7 @@ func (s *DatabaseExternalPluggableDatabaseOperationsInsightsManagementResourceCr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalPluggableDatabaseOperationsInsights(context.Background(), request)
+	response, err := s.Client.DisableExternalPluggableDatabaseOperationsInsights(s.Context(), request)
 	if err != nil {
 		return err
 	}
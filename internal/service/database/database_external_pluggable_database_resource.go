@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -276,7 +274,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.CreateExternalPluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -302,7 +300,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalPluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -345,7 +343,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.UpdateExternalPluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -369,7 +367,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.DeleteExternalPluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -502,7 +500,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExternalPluggableDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExternalPluggableDatabaseCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
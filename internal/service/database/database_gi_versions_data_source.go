@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -80,7 +78,7 @@ func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListGiVersions(context.Background(), request)
+	response, err := s.Client.ListGiVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -89,7 +87,7 @@ func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGiVersions(context.Background(), request)
+		listResponse, err := s.Client.ListGiVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
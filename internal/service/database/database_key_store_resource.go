@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -239,7 +238,7 @@ func (s *DatabaseKeyStoreResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateKeyStore(context.Background(), request)
+	response, err := s.Client.CreateKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +255,7 @@ func (s *DatabaseKeyStoreResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetKeyStore(context.Background(), request)
+	response, err := s.Client.GetKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -305,7 +304,7 @@ func (s *DatabaseKeyStoreResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateKeyStore(context.Background(), request)
+	response, err := s.Client.UpdateKeyStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -322,7 +321,7 @@ func (s *DatabaseKeyStoreResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteKeyStore(context.Background(), request)
+	_, err := s.Client.DeleteKeyStore(s.Context(), request)
 	return err
 }
 
@@ -467,7 +466,7 @@ func (s *DatabaseKeyStoreResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeKeyStoreCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeKeyStoreCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
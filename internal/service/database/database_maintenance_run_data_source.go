@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *DatabaseMaintenanceRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetMaintenanceRun(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRun(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -228,7 +227,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
+	response, err := s.Client.UpdateMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -250,7 +249,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetMaintenanceRun(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -295,7 +294,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
+	_, err := s.Client.UpdateMaintenanceRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
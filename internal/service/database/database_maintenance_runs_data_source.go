@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -102,7 +101,7 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListMaintenanceRuns(context.Background(), request)
+	response, err := s.Client.ListMaintenanceRuns(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +110,7 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaintenanceRuns(context.Background(), request)
+		listResponse, err := s.Client.ListMaintenanceRuns(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v55/database"
 	oci_work_requests "github.com/oracle/oci-go-sdk/v55/workrequests"
@@ -107,7 +105,7 @@ func (s *DatabaseMigrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.MigrateExadataDbSystemResourceModel(context.Background(), request)
+	response, err := s.Client.MigrateExadataDbSystemResourceModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -240,7 +238,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreatePluggableDatabase(context.Background(), request)
+	response, err := s.Client.CreatePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +255,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetPluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +284,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdatePluggableDatabase(context.Background(), request)
+	response, err := s.Client.UpdatePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +301,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeletePluggableDatabase(context.Background(), request)
+	_, err := s.Client.DeletePluggableDatabase(s.Context(), request)
 	return err
 }
 
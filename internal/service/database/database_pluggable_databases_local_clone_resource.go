@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -211,7 +209,7 @@ func (s *DatabasePluggableDatabasesLocalCloneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LocalClonePluggableDatabase(context.Background(), request)
+	response, err := s.Client.LocalClonePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -232,7 +230,7 @@ func (s *DatabasePluggableDatabasesRemoteCloneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RemoteClonePluggableDatabase(context.Background(), request)
+	response, err := s.Client.RemoteClonePluggableDatabase(s.Context(), request)
 	if err != nil {
 		return err
 	}
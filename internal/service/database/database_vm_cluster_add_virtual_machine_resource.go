@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -237,7 +236,7 @@ func (s *DatabaseVmClusterAddVirtualMachineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.AddVirtualMachineToVmCluster(context.Background(), request)
+	response, err := s.Client.AddVirtualMachineToVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
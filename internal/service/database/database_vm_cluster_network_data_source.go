@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *DatabaseVmClusterNetworkDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetVmClusterNetwork(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"encoding/base64"
 	"io/ioutil"
 	"log"
@@ -75,7 +74,7 @@ func (s *DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.DownloadVmClusterNetworkConfigFile(context.Background(), request)
+	response, err := s.Client.DownloadVmClusterNetworkConfigFile(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package database
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -397,7 +396,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.CreateVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -442,7 +441,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -548,7 +547,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.UpdateVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -585,7 +584,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteVmClusterNetwork(context.Background(), request)
+	_, err := s.Client.DeleteVmClusterNetwork(s.Context(), request)
 	return err
 }
 
@@ -944,7 +943,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) validateVmClusterNetwork(vmCluste
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ValidateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.ValidateVmClusterNetwork(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -94,7 +92,7 @@ func (s *DatabaseVmClusterPatchDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterPatch(context.Background(), request)
+	response, err := s.Client.GetVmClusterPatch(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -95,7 +93,7 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterPatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListVmClusterPatchHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +102,7 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterPatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterPatchHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -87,7 +85,7 @@ func (s *DatabaseVmClusterPatchHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterPatchHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetVmClusterPatchHistoryEntry(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -319,7 +318,7 @@ func (s *DatabaseVmClusterRecommendedNetworkDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GenerateRecommendedVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GenerateRecommendedVmClusterNetwork(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -229,7 +228,7 @@ func (s *DatabaseVmClusterRemoveVirtualMachineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RemoveVirtualMachineFromVmCluster(context.Background(), request)
+	response, err := s.Client.RemoveVirtualMachineFromVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -379,7 +377,7 @@ func (s *DatabaseVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateVmCluster(context.Background(), request)
+	response, err := s.Client.CreateVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -396,7 +394,7 @@ func (s *DatabaseVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetVmCluster(context.Background(), request)
+	response, err := s.Client.GetVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -481,7 +479,7 @@ func (s *DatabaseVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateVmCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -498,7 +496,7 @@ func (s *DatabaseVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteVmCluster(s.Context(), request)
 	return err
 }
 
@@ -612,7 +610,7 @@ func (s *DatabaseVmClusterResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVmClusterCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
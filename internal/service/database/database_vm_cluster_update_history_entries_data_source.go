@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v55/database"
 
@@ -115,7 +113,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterUpdateHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListVmClusterUpdateHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +122,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterUpdateHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterUpdateHistoryEntries(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v55/database"
 
@@ -122,7 +120,7 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterUpdates(context.Background(), request)
+	response, err := s.Client.ListVmClusterUpdates(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +129,7 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterUpdates(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterUpdates(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
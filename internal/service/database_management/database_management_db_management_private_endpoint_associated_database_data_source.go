@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -92,7 +90,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSour
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+	response, err := s.Client.ListAssociatedDatabases(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDbManagementPrivateEndpoint(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -202,7 +201,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Create() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.CreateDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -269,7 +268,7 @@ func dbManagementPrivateEndpointWaitForWorkRequest(wId *string, entityType strin
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_database_management.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -306,7 +305,7 @@ func dbManagementPrivateEndpointWaitForWorkRequest(wId *string, entityType strin
 }
 
 func getErrorFromDatabaseManagementDbManagementPrivateEndpointWorkRequest(client *oci_database_management.DbManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_management.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_database_management.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -336,7 +335,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,7 +385,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Update() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.UpdateDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -403,7 +402,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Delete() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.DeleteDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteDbManagementPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -506,7 +505,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) updateCompar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	_, err := s.Client.ChangeDbManagementPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDbManagementPrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDbManagementPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDbManagementPrivateEndpoints(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() err
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbManagementPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDbManagementPrivateEndpoints(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
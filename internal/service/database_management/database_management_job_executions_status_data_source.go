@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -129,7 +127,7 @@ func (s *DatabaseManagementJobExecutionsStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeJobExecutionsStatuses(context.Background(), request)
+	response, err := s.Client.SummarizeJobExecutionsStatuses(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -137,7 +135,7 @@ func (s *DatabaseManagementJobExecutionsStatusesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeJobExecutionsStatuses(context.Background(), request)
+	response, err := s.Client.SummarizeJobExecutionsStatuses(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -128,7 +126,7 @@ func (s *DatabaseManagementManagedDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetManagedDatabase(context.Background(), request)
+	response, err := s.Client.GetManagedDatabase(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
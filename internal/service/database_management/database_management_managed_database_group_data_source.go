@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatabaseManagementManagedDatabaseGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package database_management
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 
@@ -226,7 +225,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.CreateManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.CreateManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +266,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) addManagedDatabaseT
 	request := oci_database_management.AddManagedDatabaseToManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 	request.AddManagedDatabaseToManagedDatabaseGroupDetails = managedDatabaseDetails
-	_, err := s.Client.AddManagedDatabaseToManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.AddManagedDatabaseToManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -278,7 +277,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeManagedDataba
 	request := oci_database_management.RemoveManagedDatabaseFromManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 	request.RemoveManagedDatabaseFromManagedDatabaseGroupDetails = managedDatabaseDetails
-	_, err := s.Client.RemoveManagedDatabaseFromManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.RemoveManagedDatabaseFromManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +291,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) GetManagedDatabaseG
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -309,7 +308,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +351,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.UpdateManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.UpdateManagedDatabaseGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -430,7 +429,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Delete() error {
 		return mderr
 	}
 
-	_, err := s.Client.DeleteManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.DeleteManagedDatabaseGroup(s.Context(), request)
 	return err
 }
 
@@ -541,7 +540,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateCompartment(c
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	_, err := s.Client.ChangeManagedDatabaseGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedDatabaseGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
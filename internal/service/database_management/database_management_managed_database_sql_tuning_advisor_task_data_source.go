@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"strconv"
 	"time"
 
@@ -159,7 +158,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTasks(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
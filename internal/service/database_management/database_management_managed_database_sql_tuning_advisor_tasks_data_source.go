@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -167,7 +165,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) G
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTasks(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +174,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) G
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTasks(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
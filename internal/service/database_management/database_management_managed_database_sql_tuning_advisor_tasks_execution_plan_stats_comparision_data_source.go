@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -147,7 +146,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetExecutionPlanStatsComparision(context.Background(), request)
+	response, err := s.Client.GetExecutionPlanStatsComparision(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
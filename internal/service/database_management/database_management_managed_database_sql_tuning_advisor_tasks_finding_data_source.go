@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -210,7 +209,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
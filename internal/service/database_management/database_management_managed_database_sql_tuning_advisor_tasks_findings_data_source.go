@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -218,7 +217,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +226,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTaskFindings(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
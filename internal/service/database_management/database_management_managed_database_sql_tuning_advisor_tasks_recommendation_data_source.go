@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -144,7 +143,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
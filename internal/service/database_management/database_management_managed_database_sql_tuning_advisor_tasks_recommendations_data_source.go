@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -152,7 +151,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +160,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -219,7 +218,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanD
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetSqlExecutionPlan(context.Background(), request)
+	response, err := s.Client.GetSqlExecutionPlan(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
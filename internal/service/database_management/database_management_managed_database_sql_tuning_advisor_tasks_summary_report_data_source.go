@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -365,7 +364,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportData
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetSqlTuningAdvisorTaskSummaryReport(context.Background(), request)
+	response, err := s.Client.GetSqlTuningAdvisorTaskSummaryReport(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -89,7 +87,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDataAccessContainers(context.Background(), request)
+	response, err := s.Client.ListDataAccessContainers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
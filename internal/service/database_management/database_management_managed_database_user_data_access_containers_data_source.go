@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -97,7 +95,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDataAccessContainers(context.Background(), request)
+	response, err := s.Client.ListDataAccessContainers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +104,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAccessContainers(context.Background(), request)
+		listResponse, err := s.Client.ListDataAccessContainers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -159,7 +157,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -121,7 +119,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud) Get
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListObjectPrivileges(context.Background(), request)
+	response, err := s.Client.ListObjectPrivileges(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -129,7 +127,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListObjectPrivileges(context.Background(), request)
+	response, err := s.Client.ListObjectPrivileges(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +136,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Ge
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListObjectPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListObjectPrivileges(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
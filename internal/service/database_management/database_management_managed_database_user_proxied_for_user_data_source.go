@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -97,7 +95,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListProxiedForUsers(context.Background(), request)
+	response, err := s.Client.ListProxiedForUsers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -109,7 +107,7 @@ func (s *DatabaseManagementManagedDatabaseUserRoleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListRoles(context.Background(), request)
+	response, err := s.Client.ListRoles(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -117,7 +115,7 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListRoles(context.Background(), request)
+	response, err := s.Client.ListRoles(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +124,7 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRoles(context.Background(), request)
+		listResponse, err := s.Client.ListRoles(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -180,7 +178,7 @@ func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +187,7 @@ func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+		listResponse, err := s.Client.ListUsers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
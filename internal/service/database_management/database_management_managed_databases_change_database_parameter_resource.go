@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -181,7 +180,7 @@ func (s *DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.ChangeDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ChangeDatabaseParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
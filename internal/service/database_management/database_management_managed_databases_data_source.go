@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -187,7 +185,7 @@ func (s *DatabaseManagementManagedDatabasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListManagedDatabases(context.Background(), request)
+	response, err := s.Client.ListManagedDatabases(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +194,7 @@ func (s *DatabaseManagementManagedDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListManagedDatabases(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -235,7 +233,7 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud) Get
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ListDatabaseParameters(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
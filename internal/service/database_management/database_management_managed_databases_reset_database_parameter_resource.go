@@ -4,7 +4,6 @@
 package database_management
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -152,7 +151,7 @@ func (s *DatabaseManagementManagedDatabasesResetDatabaseParameterResourceCrud) C
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.ResetDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ResetDatabaseParameters(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -105,7 +103,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListProxyUsers(context.Background(), request)
+	response, err := s.Client.ListProxyUsers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +112,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProxyUsers(context.Background(), request)
+		listResponse, err := s.Client.ListProxyUsers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
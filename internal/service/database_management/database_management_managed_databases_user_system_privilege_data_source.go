@@ -4,8 +4,6 @@
 package database_management
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -101,7 +99,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSystemPrivileges(context.Background(), request)
+	response, err := s.Client.ListSystemPrivileges(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
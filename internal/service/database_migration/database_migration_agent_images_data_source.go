@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -79,7 +77,7 @@ func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListAgentImages(context.Background(), request)
+	response, err := s.Client.ListAgentImages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +86,7 @@ func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgentImages(context.Background(), request)
+		listResponse, err := s.Client.ListAgentImages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -214,7 +212,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateAgent(context.Background(), request)
+	response, err := s.Client.UpdateAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +229,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetAgent(context.Background(), request)
+	response, err := s.Client.GetAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +292,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateAgent(context.Background(), request)
+	response, err := s.Client.UpdateAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -311,7 +309,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.DeleteAgent(context.Background(), request)
+	_, err := s.Client.DeleteAgent(s.Context(), request)
 	return err
 }
 
@@ -424,7 +422,7 @@ func (s *DatabaseMigrationAgentResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeAgentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAgentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
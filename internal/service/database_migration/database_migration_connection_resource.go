@@ -4,7 +4,6 @@
 package database_migration
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -443,7 +442,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -512,7 +511,7 @@ func connectionWaitForWorkRequest(wId *string, entityType string, action oci_dat
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_database_migration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -546,7 +545,7 @@ func connectionWaitForWorkRequest(wId *string, entityType string, action oci_dat
 }
 
 func getErrorFromDatabaseMigrationConnectionWorkRequest(client *oci_database_migration.DatabaseMigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_database_migration.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -576,7 +575,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -695,7 +694,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -711,7 +710,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) Delete() error {
 	request.ConnectionId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.DeleteConnection(context.Background(), request)
+	response, err := s.Client.DeleteConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1272,7 +1271,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -118,7 +116,7 @@ func (s *DatabaseMigrationJobAdvisorReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.GetAdvisorReport(context.Background(), request)
+	response, err := s.Client.GetAdvisorReport(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -71,7 +69,7 @@ func (s *DatabaseMigrationJobOutputDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListJobOutputs(context.Background(), request)
+	response, err := s.Client.ListJobOutputs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
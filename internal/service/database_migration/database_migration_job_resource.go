@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -307,7 +305,7 @@ func (s *DatabaseMigrationJobResourceCrud) Create() error {
 		request.JobId = &tmp
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -324,7 +322,7 @@ func (s *DatabaseMigrationJobResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +356,7 @@ func (s *DatabaseMigrationJobResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -375,7 +373,7 @@ func (s *DatabaseMigrationJobResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.DeleteJob(context.Background(), request)
+	_, err := s.Client.DeleteJob(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -75,7 +73,7 @@ func (s *DatabaseMigrationMigrationObjectTypesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListMigrationObjectTypes(context.Background(), request)
+	response, err := s.Client.ListMigrationObjectTypes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +82,7 @@ func (s *DatabaseMigrationMigrationObjectTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMigrationObjectTypes(context.Background(), request)
+		listResponse, err := s.Client.ListMigrationObjectTypes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
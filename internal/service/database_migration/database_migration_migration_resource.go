@@ -5,7 +5,6 @@ package database_migration
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -1004,7 +1003,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.CreateMigration(context.Background(), request)
+	response, err := s.Client.CreateMigration(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -1070,7 +1069,7 @@ func migrationWaitForWorkRequest(wId *string, entityType string, action oci_data
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_database_migration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1106,7 +1105,7 @@ func migrationWaitForWorkRequest(wId *string, entityType string, action oci_data
 }
 
 func getErrorFromDatabaseMigrationMigrationWorkRequest(client *oci_database_migration.DatabaseMigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_database_migration.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1136,7 +1135,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "migration")
 
-	response, err := s.Client.GetMigration(context.Background(), request)
+	response, err := s.Client.GetMigration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1304,7 +1303,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateMigration(context.Background(), request)
+	response, err := s.Client.UpdateMigration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1319,7 +1318,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) Delete() error {
 	request.MigrationId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.DeleteMigration(context.Background(), request)
+	response, err := s.Client.DeleteMigration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -2923,7 +2922,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeMigrationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMigrationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_migration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -108,7 +106,7 @@ func (s *DatabaseMigrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.MigrateExadataDbSystemResourceModel(context.Background(), request)
+	response, err := s.Client.MigrateExadataDbSystemResourceModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database_tools
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsConnection(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
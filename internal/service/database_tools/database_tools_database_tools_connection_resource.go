@@ -4,7 +4,6 @@
 package database_tools
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -321,7 +320,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.CreateDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.CreateDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +386,7 @@ func databaseToolsConnectionWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_database_tools.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -423,7 +422,7 @@ func databaseToolsConnectionWaitForWorkRequest(wId *string, entityType string, a
 }
 
 func getErrorFromDatabaseToolsDatabaseToolsConnectionWorkRequest(client *oci_database_tools.DatabaseToolsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_tools.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_database_tools.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -453,7 +452,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -480,7 +479,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.UpdateDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -497,7 +496,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.DeleteDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseToolsConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1487,7 +1486,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) updateCompartment(com
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.ChangeDatabaseToolsConnectionCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseToolsConnectionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_tools
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -108,7 +106,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsConnections(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsConnections(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -117,7 +115,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsConnections(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsConnections(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package database_tools
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -101,7 +99,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsEndpointService(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsEndpointService(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
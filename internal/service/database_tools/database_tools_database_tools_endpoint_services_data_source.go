@@ -4,8 +4,6 @@
 package database_tools
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -152,7 +150,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsEndpointServices(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsEndpointServices(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +159,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsEndpointServices(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsEndpointServices(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
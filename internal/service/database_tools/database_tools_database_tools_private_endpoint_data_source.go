@@ -4,8 +4,6 @@
 package database_tools
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsPrivateEndpoint(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package database_tools
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -292,7 +291,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.CreateDatabaseToolsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateDatabaseToolsPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +357,7 @@ func databaseToolsPrivateEndpointWaitForWorkRequest(wId *string, entityType stri
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_database_tools.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -394,7 +393,7 @@ func databaseToolsPrivateEndpointWaitForWorkRequest(wId *string, entityType stri
 }
 
 func getErrorFromDatabaseToolsDatabaseToolsPrivateEndpointWorkRequest(client *oci_database_tools.DatabaseToolsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_tools.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_database_tools.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -424,7 +423,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -486,7 +485,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.UpdateDatabaseToolsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseToolsPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -503,7 +502,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.DeleteDatabaseToolsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseToolsPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -712,7 +711,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) updateCompartmen
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
-	response, err := s.Client.ChangeDatabaseToolsPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseToolsPrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package database_tools
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsPrivateEndpoints(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsPrivateEndpoints(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
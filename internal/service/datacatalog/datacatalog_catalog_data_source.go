@@ -4,8 +4,6 @@
 package datacatalog
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatacatalogCatalogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetCatalog(context.Background(), request)
+	response, err := s.Client.GetCatalog(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
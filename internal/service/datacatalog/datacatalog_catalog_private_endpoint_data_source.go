@@ -4,8 +4,6 @@
 package datacatalog
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatacatalogCatalogPrivateEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetCatalogPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetCatalogPrivateEndpoint(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
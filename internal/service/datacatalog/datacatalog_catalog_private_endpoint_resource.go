@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -210,7 +209,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateCatalogPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateCatalogPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -276,7 +275,7 @@ func catalogPrivateEndpointWaitForWorkRequest(wId *string, entityType string, ac
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -312,7 +311,7 @@ func catalogPrivateEndpointWaitForWorkRequest(wId *string, entityType string, ac
 }
 
 func getErrorFromDatacatalogCatalogPrivateEndpointWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -342,7 +341,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetCatalogPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetCatalogPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -398,7 +397,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateCatalogPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateCatalogPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -415,7 +414,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.DeleteCatalogPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteCatalogPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -478,7 +477,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.ChangeCatalogPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCatalogPrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -496,7 +495,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) detachCatalog(detachCata
 		request.CatalogId = &detachCatalogId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.DetachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.DetachCatalogPrivateEndpoint(s.Context(), request)
 		if err != nil {
 			return err
 		}
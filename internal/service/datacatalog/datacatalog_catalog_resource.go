@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -194,7 +193,7 @@ func (s *DatacatalogCatalogResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateCatalog(context.Background(), request)
+	response, err := s.Client.CreateCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +285,7 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -322,7 +321,7 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 }
 
 func getErrorFromDatacatalogCatalogWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -352,7 +351,7 @@ func (s *DatacatalogCatalogResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetCatalog(context.Background(), request)
+	response, err := s.Client.GetCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -437,7 +436,7 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateCatalog(context.Background(), request)
+	response, err := s.Client.UpdateCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -472,7 +471,7 @@ func (s *DatacatalogCatalogResourceCrud) Delete() error {
 		}
 	}
 
-	response, err := s.Client.DeleteCatalog(context.Background(), request)
+	response, err := s.Client.DeleteCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -542,7 +541,7 @@ func (s *DatacatalogCatalogResourceCrud) attachCatalogPrivateEndpoints(attachCat
 		request.CatalogId = &catalogId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.AttachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.AttachCatalogPrivateEndpoint(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -563,7 +562,7 @@ func (s *DatacatalogCatalogResourceCrud) detachCatalogPrivateEndpoints(detachCat
 		request.CatalogId = &catalogId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.DetachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.DetachCatalogPrivateEndpoint(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -587,7 +586,7 @@ func (s *DatacatalogCatalogResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.ChangeCatalogCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCatalogCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
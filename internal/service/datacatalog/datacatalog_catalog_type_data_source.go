@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -132,7 +131,7 @@ func (s *DatacatalogCatalogTypeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetType(context.Background(), request)
+	response, err := s.Client.GetType(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
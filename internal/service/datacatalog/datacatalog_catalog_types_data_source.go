@@ -4,8 +4,6 @@
 package datacatalog
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -187,7 +185,7 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListTypes(context.Background(), request)
+	response, err := s.Client.ListTypes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +194,7 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTypes(context.Background(), request)
+		listResponse, err := s.Client.ListTypes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
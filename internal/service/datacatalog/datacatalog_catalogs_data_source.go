@@ -4,8 +4,6 @@
 package datacatalog
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -76,7 +74,7 @@ func (s *DatacatalogCatalogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListCatalogs(context.Background(), request)
+	response, err := s.Client.ListCatalogs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *DatacatalogCatalogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCatalogs(context.Background(), request)
+		listResponse, err := s.Client.ListCatalogs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -236,7 +235,7 @@ func (s *DatacatalogConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +284,7 @@ func (s *DatacatalogConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -343,7 +342,7 @@ func (s *DatacatalogConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -375,7 +374,7 @@ func (s *DatacatalogConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteConnection(context.Background(), request)
+	_, err := s.Client.DeleteConnection(s.Context(), request)
 	return err
 }
 
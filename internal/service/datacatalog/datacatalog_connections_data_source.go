@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -205,7 +204,7 @@ func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListConnections(context.Background(), request)
+	response, err := s.Client.ListConnections(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -214,7 +213,7 @@ func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnections(context.Background(), request)
+		listResponse, err := s.Client.ListConnections(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package datacatalog
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -82,7 +80,7 @@ func (s *DatacatalogDataAssetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
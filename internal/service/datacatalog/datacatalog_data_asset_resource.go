@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -203,7 +202,7 @@ func (s *DatacatalogDataAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +245,7 @@ func (s *DatacatalogDataAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +285,7 @@ func (s *DatacatalogDataAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,7 +309,7 @@ func (s *DatacatalogDataAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(s.Context(), request)
 	return err
 }
 
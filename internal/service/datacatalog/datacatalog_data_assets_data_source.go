@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -172,7 +171,7 @@ func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListDataAssets(context.Background(), request)
+	response, err := s.Client.ListDataAssets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +180,7 @@ func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAssets(context.Background(), request)
+		listResponse, err := s.Client.ListDataAssets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
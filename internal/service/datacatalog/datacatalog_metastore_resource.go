@@ -4,7 +4,6 @@
 package datacatalog
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -193,7 +192,7 @@ func (s *DatacatalogMetastoreResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateMetastore(context.Background(), request)
+	response, err := s.Client.CreateMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -299,7 +298,7 @@ func catalogMetastoreWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 }
 
 func getErrorFromDatacatalogCatalogMetastoreWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -329,7 +328,7 @@ func (s *DatacatalogMetastoreResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetMetastore(context.Background(), request)
+	response, err := s.Client.GetMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -372,7 +371,7 @@ func (s *DatacatalogMetastoreResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateMetastore(context.Background(), request)
+	response, err := s.Client.UpdateMetastore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -389,7 +388,7 @@ func (s *DatacatalogMetastoreResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteMetastore(context.Background(), request)
+	_, err := s.Client.DeleteMetastore(s.Context(), request)
 	return err
 }
 
@@ -444,7 +443,7 @@ func (s *DatacatalogMetastoreResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.ChangeMetastoreCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMetastoreCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package dataflow
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DataflowApplicationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
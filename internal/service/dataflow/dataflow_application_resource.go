@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -369,7 +368,7 @@ func (s *DataflowApplicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreateApplication(context.Background(), request)
+	response, err := s.Client.CreateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,7 +385,7 @@ func (s *DataflowApplicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -532,7 +531,7 @@ func (s *DataflowApplicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdateApplication(context.Background(), request)
+	response, err := s.Client.UpdateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -549,7 +548,7 @@ func (s *DataflowApplicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.DeleteApplication(context.Background(), request)
+	_, err := s.Client.DeleteApplication(s.Context(), request)
 	return err
 }
 
@@ -694,7 +693,7 @@ func (s *DataflowApplicationResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.ChangeApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeApplicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *DataflowInvokeRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.GetRun(context.Background(), request)
+	response, err := s.Client.GetRun(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -453,7 +452,7 @@ func (s *DataflowInvokeRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreateRun(context.Background(), request)
+	response, err := s.Client.CreateRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -470,7 +469,7 @@ func (s *DataflowInvokeRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetRun(context.Background(), request)
+	response, err := s.Client.GetRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -508,7 +507,7 @@ func (s *DataflowInvokeRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdateRun(context.Background(), request)
+	response, err := s.Client.UpdateRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -525,7 +524,7 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 
 	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	getResponse, err := s.Client.GetRun(context.Background(), getRequest)
+	getResponse, err := s.Client.GetRun(s.Context(), getRequest)
 	if err != nil {
 		return err
 	}
@@ -540,7 +539,7 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err = s.Client.DeleteRun(context.Background(), request)
+	_, err = s.Client.DeleteRun(s.Context(), request)
 	return err
 }
 
@@ -708,7 +707,7 @@ func (s *DataflowInvokeRunResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.ChangeRunCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRunCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"strconv"
 	"time"
 
@@ -118,7 +117,7 @@ func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.ListRuns(context.Background(), request)
+	response, err := s.Client.ListRuns(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +126,7 @@ func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRuns(context.Background(), request)
+		listResponse, err := s.Client.ListRuns(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -255,7 +254,7 @@ func (s *DataflowPrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreatePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreatePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +322,7 @@ func privateEndpointWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_dataflow.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -359,7 +358,7 @@ func privateEndpointWaitForWorkRequest(wId *string, entityType string, action oc
 }
 
 func getErrorFromDataflowPrivateEndpointWorkRequest(client *oci_dataflow.DataFlowClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_dataflow.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_dataflow.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -389,7 +388,7 @@ func (s *DataflowPrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetPrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -469,7 +468,7 @@ func (s *DataflowPrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdatePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdatePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -486,7 +485,7 @@ func (s *DataflowPrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.DeletePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeletePrivateEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -631,7 +630,7 @@ func (s *DataflowPrivateEndpointResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.ChangePrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangePrivateEndpointCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"encoding/base64"
 	"io/ioutil"
 	"log"
@@ -79,7 +78,7 @@ func (s *DataflowRunLogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.GetRunLog(context.Background(), request)
+	response, err := s.Client.GetRunLog(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package dataflow
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -92,7 +91,7 @@ func (s *DataflowRunLogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.ListRunLogs(context.Background(), request)
+	response, err := s.Client.ListRunLogs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +100,7 @@ func (s *DataflowRunLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRunLogs(context.Background(), request)
+		listResponse, err := s.Client.ListRunLogs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package dataintegration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DataintegrationWorkspaceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataintegration")
 
-	response, err := s.Client.GetWorkspace(context.Background(), request)
+	response, err := s.Client.GetWorkspace(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
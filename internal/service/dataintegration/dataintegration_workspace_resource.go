@@ -4,7 +4,6 @@
 package dataintegration
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -252,7 +251,7 @@ func (s *DataintegrationWorkspaceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.CreateWorkspace(context.Background(), request)
+	response, err := s.Client.CreateWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -320,7 +319,7 @@ func workspaceWaitForWorkRequest(wId *string, entityType string, action oci_data
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_dataintegration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -356,7 +355,7 @@ func workspaceWaitForWorkRequest(wId *string, entityType string, action oci_data
 }
 
 func getErrorFromDataintegrationWorkspaceWorkRequest(client *oci_dataintegration.DataIntegrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_dataintegration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_dataintegration.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -386,7 +385,7 @@ func (s *DataintegrationWorkspaceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.GetWorkspace(context.Background(), request)
+	response, err := s.Client.GetWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -434,7 +433,7 @@ func (s *DataintegrationWorkspaceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.UpdateWorkspace(context.Background(), request)
+	response, err := s.Client.UpdateWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -465,7 +464,7 @@ func (s *DataintegrationWorkspaceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.DeleteWorkspace(context.Background(), request)
+	response, err := s.Client.DeleteWorkspace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -544,7 +543,7 @@ func (s *DataintegrationWorkspaceResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
-	response, err := s.Client.ChangeCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
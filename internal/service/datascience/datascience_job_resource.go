@@ -4,7 +4,6 @@
 package datascience
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -403,7 +402,7 @@ func (s *DatascienceJobResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateJob(context.Background(), request)
+	response, err := s.Client.CreateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -420,7 +419,7 @@ func (s *DatascienceJobResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -488,7 +487,7 @@ func (s *DatascienceJobResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -510,7 +509,7 @@ func (s *DatascienceJobResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteJob(context.Background(), request)
+	_, err := s.Client.DeleteJob(s.Context(), request)
 	return err
 }
 
@@ -754,7 +753,7 @@ func (s *DatascienceJobResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeJobCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeJobCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -792,7 +791,7 @@ func (s *DatascienceJobResourceCrud) CreateArtifact() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.CreateJobArtifact(context.Background(), request)
+	_, err := s.Client.CreateJobArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -807,7 +806,7 @@ func (s *DatascienceJobResourceCrud) GetArtifactHead() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
 
-	response, err := s.Client.HeadJobArtifact(context.Background(), request)
+	response, err := s.Client.HeadJobArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
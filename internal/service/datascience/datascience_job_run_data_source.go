@@ -4,8 +4,6 @@
 package datascience
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DatascienceJobRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetJobRun(context.Background(), request)
+	response, err := s.Client.GetJobRun(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package datascience
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -392,7 +391,7 @@ func (s *DatascienceJobRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateJobRun(context.Background(), request)
+	response, err := s.Client.CreateJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -409,7 +408,7 @@ func (s *DatascienceJobRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetJobRun(context.Background(), request)
+	response, err := s.Client.GetJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -452,7 +451,7 @@ func (s *DatascienceJobRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateJobRun(context.Background(), request)
+	response, err := s.Client.UpdateJobRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -469,7 +468,7 @@ func (s *DatascienceJobRunResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteJobRun(context.Background(), request)
+	_, err := s.Client.DeleteJobRun(s.Context(), request)
 	return err
 }
 
@@ -639,7 +638,7 @@ func (s *DatascienceJobRunResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeJobRunCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeJobRunCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package datascience
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *DatascienceJobRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.ListJobRuns(context.Background(), request)
+	response, err := s.Client.ListJobRuns(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *DatascienceJobRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJobRuns(context.Background(), request)
+		listResponse, err := s.Client.ListJobRuns(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
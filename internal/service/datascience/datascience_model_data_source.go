@@ -4,8 +4,6 @@
 package datascience
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -51,7 +49,7 @@ func (s *DatascienceModelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -66,7 +64,7 @@ func (s *DatascienceModelDataSourceCrud) Get() error {
 
 	headModelArtifactRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	headModelArtifactResponse, err := s.Client.HeadModelArtifact(context.Background(), headModelArtifactRequest)
+	headModelArtifactResponse, err := s.Client.HeadModelArtifact(tfresource.GetResourceDataContext(s.D), headModelArtifactRequest)
 	if err != nil {
 		return err
 	}
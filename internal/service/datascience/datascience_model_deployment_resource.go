@@ -4,7 +4,6 @@
 package datascience
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -400,7 +399,7 @@ func (s *DatascienceModelDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModelDeployment(context.Background(), request)
+	response, err := s.Client.CreateModelDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -419,7 +418,7 @@ func (s *DatascienceModelDeploymentResourceCrud) getModelDeploymentFromWorkReque
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, modelDeploymentId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_datascience.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -478,7 +477,7 @@ func modelDeploymentWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_datascience.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -514,7 +513,7 @@ func modelDeploymentWaitForWorkRequest(wId *string, entityType string, action oc
 }
 
 func getErrorFromDatascienceModelDeploymentWorkRequest(client *oci_datascience.DataScienceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datascience.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_datascience.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -544,7 +543,7 @@ func (s *DatascienceModelDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModelDeployment(context.Background(), request)
+	response, err := s.Client.GetModelDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -614,7 +613,7 @@ func (s *DatascienceModelDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModelDeployment(context.Background(), request)
+	response, err := s.Client.UpdateModelDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -631,7 +630,7 @@ func (s *DatascienceModelDeploymentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.DeleteModelDeployment(context.Background(), request)
+	response, err := s.Client.DeleteModelDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1051,7 +1050,7 @@ func (s *DatascienceModelDeploymentResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeModelDeploymentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelDeploymentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
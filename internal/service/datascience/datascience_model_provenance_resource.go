@@ -4,7 +4,6 @@
 package datascience
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -153,7 +152,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModelProvenance(context.Background(), request)
+	response, err := s.Client.CreateModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -179,7 +178,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModelProvenance(context.Background(), request)
+	response, err := s.Client.GetModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,7 +227,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModelProvenance(context.Background(), request)
+	response, err := s.Client.UpdateModelProvenance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package datascience
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -412,7 +411,7 @@ func (s *DatascienceModelResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -429,7 +428,7 @@ func (s *DatascienceModelResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -521,7 +520,7 @@ func (s *DatascienceModelResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -538,7 +537,7 @@ func (s *DatascienceModelResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteModel(context.Background(), request)
+	_, err := s.Client.DeleteModel(s.Context(), request)
 	return err
 }
 
@@ -676,7 +675,7 @@ func (s *DatascienceModelResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -696,7 +695,7 @@ func (s *DatascienceModelResourceCrud) ActivateModel() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateModel(context.Background(), request)
+	_, err := s.Client.ActivateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -713,7 +712,7 @@ func (s *DatascienceModelResourceCrud) DeactivateModel() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateModel(context.Background(), request)
+	_, err := s.Client.DeactivateModel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -753,7 +752,7 @@ func (s *DatascienceModelResourceCrud) CreateArtifact() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.CreateModelArtifact(context.Background(), request)
+	_, err := s.Client.CreateModelArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -768,7 +767,7 @@ func (s *DatascienceModelResourceCrud) GetArtifactHead() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
 
-	response, err := s.Client.HeadModelArtifact(context.Background(), request)
+	response, err := s.Client.HeadModelArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package datascience
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -307,7 +306,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateNotebookSession(context.Background(), request)
+	response, err := s.Client.CreateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -324,7 +323,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetNotebookSession(context.Background(), request)
+	response, err := s.Client.GetNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -378,7 +377,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateNotebookSession(context.Background(), request)
+	response, err := s.Client.UpdateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -395,7 +394,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteNotebookSession(context.Background(), request)
+	_, err := s.Client.DeleteNotebookSession(s.Context(), request)
 	return err
 }
 
@@ -540,7 +539,7 @@ func (s *DatascienceNotebookSessionResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeNotebookSessionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNotebookSessionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -560,7 +559,7 @@ func (s *DatascienceNotebookSessionResourceCrud) ActivateNotebookSession() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateNotebookSession(context.Background(), request)
+	_, err := s.Client.ActivateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -577,7 +576,7 @@ func (s *DatascienceNotebookSessionResourceCrud) DeactivateNotebookSession() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateNotebookSession(context.Background(), request)
+	_, err := s.Client.DeactivateNotebookSession(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package datascience
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -83,7 +81,7 @@ func (s *DatascienceNotebookSessionShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.ListNotebookSessionShapes(context.Background(), request)
+	response, err := s.Client.ListNotebookSessionShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +90,7 @@ func (s *DatascienceNotebookSessionShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNotebookSessionShapes(context.Background(), request)
+		listResponse, err := s.Client.ListNotebookSessionShapes(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
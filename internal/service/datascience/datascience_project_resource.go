@@ -4,8 +4,6 @@
 package datascience
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -172,7 +170,7 @@ func (s *DatascienceProjectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateProject(context.Background(), request)
+	response, err := s.Client.CreateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +187,7 @@ func (s *DatascienceProjectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -237,7 +235,7 @@ func (s *DatascienceProjectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateProject(context.Background(), request)
+	response, err := s.Client.UpdateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +252,7 @@ func (s *DatascienceProjectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteProject(context.Background(), request)
+	_, err := s.Client.DeleteProject(s.Context(), request)
 	return err
 }
 
@@ -301,7 +299,7 @@ func (s *DatascienceProjectResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeProjectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeProjectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DevopsBuildPipelineDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetBuildPipeline(context.Background(), request)
+	response, err := s.Client.GetBuildPipeline(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
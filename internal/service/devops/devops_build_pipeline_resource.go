@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -247,7 +246,7 @@ func (s *DevopsBuildPipelineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildPipeline(context.Background(), request)
+	response, err := s.Client.CreateBuildPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -313,7 +312,7 @@ func buildPipelineWaitForWorkRequest(wId *string, entityType string, action oci_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -349,7 +348,7 @@ func buildPipelineWaitForWorkRequest(wId *string, entityType string, action oci_
 }
 
 func getErrorFromDevopsBuildPipelineWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -379,7 +378,7 @@ func (s *DevopsBuildPipelineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildPipeline(context.Background(), request)
+	response, err := s.Client.GetBuildPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -429,7 +428,7 @@ func (s *DevopsBuildPipelineResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildPipeline(context.Background(), request)
+	response, err := s.Client.UpdateBuildPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *DevopsBuildPipelineResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteBuildPipeline(context.Background(), request)
+	response, err := s.Client.DeleteBuildPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
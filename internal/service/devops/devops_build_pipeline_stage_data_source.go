@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -52,7 +50,7 @@ func (s *DevopsBuildPipelineStageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.GetBuildPipelineStage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
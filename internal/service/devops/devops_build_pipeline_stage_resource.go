@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -383,7 +382,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.CreateBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -449,7 +448,7 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -485,7 +484,7 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromDevopsBuildPipelineStageWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -515,7 +514,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.GetBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -533,7 +532,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.UpdateBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -550,7 +549,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.DeleteBuildPipelineStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -319,7 +317,7 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListBuildPipelineStages(context.Background(), request)
+	response, err := s.Client.ListBuildPipelineStages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +326,7 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildPipelineStages(context.Background(), request)
+		listResponse, err := s.Client.ListBuildPipelineStages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
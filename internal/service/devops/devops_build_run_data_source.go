@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DevopsBuildRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetBuildRun(context.Background(), request)
+	response, err := s.Client.GetBuildRun(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -573,7 +572,7 @@ func (s *DevopsBuildRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildRun(context.Background(), request)
+	response, err := s.Client.CreateBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -590,7 +589,7 @@ func (s *DevopsBuildRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildRun(context.Background(), request)
+	response, err := s.Client.GetBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -624,7 +623,7 @@ func (s *DevopsBuildRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildRun(context.Background(), request)
+	response, err := s.Client.UpdateBuildRun(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -386,7 +384,7 @@ func (s *DevopsBuildRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListBuildRuns(context.Background(), request)
+	response, err := s.Client.ListBuildRuns(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -395,7 +393,7 @@ func (s *DevopsBuildRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildRuns(context.Background(), request)
+		listResponse, err := s.Client.ListBuildRuns(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
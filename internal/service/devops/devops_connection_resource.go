@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -176,7 +175,7 @@ func (s *DevopsConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +241,7 @@ func devopsConnectionWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -278,7 +277,7 @@ func devopsConnectionWaitForWorkRequest(wId *string, entityType string, action o
 }
 
 func getErrorFromDevopsConnectionWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -308,7 +307,7 @@ func (s *DevopsConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -326,7 +325,7 @@ func (s *DevopsConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -343,7 +342,7 @@ func (s *DevopsConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteConnection(context.Background(), request)
+	response, err := s.Client.DeleteConnection(s.Context(), request)
 	if err != nil {
 		return err
 	}
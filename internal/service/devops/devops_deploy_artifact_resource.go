@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -277,7 +276,7 @@ func (s *DevopsDeployArtifactResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployArtifact(context.Background(), request)
+	response, err := s.Client.CreateDeployArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -343,7 +342,7 @@ func deployArtifactWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -379,7 +378,7 @@ func deployArtifactWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromDevopsDeployArtifactWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -409,7 +408,7 @@ func (s *DevopsDeployArtifactResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployArtifact(context.Background(), request)
+	response, err := s.Client.GetDeployArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -467,7 +466,7 @@ func (s *DevopsDeployArtifactResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployArtifact(context.Background(), request)
+	response, err := s.Client.UpdateDeployArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -484,7 +483,7 @@ func (s *DevopsDeployArtifactResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteDeployArtifact(context.Background(), request)
+	response, err := s.Client.DeleteDeployArtifact(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *DevopsDeployEnvironmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetDeployEnvironment(context.Background(), request)
+	response, err := s.Client.GetDeployEnvironment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -250,7 +249,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployEnvironment(context.Background(), request)
+	response, err := s.Client.CreateDeployEnvironment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -316,7 +315,7 @@ func deployEnvironmentWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -352,7 +351,7 @@ func deployEnvironmentWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromDevopsDeployEnvironmentWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -382,7 +381,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployEnvironment(context.Background(), request)
+	response, err := s.Client.GetDeployEnvironment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -400,7 +399,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployEnvironment(context.Background(), request)
+	response, err := s.Client.UpdateDeployEnvironment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +416,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteDeployEnvironment(context.Background(), request)
+	response, err := s.Client.DeleteDeployEnvironment(s.Context(), request)
 	if err != nil {
 		return err
 	}
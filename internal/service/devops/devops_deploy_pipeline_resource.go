@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -391,7 +390,7 @@ func (s *DevopsDeployPipelineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployPipeline(context.Background(), request)
+	response, err := s.Client.CreateDeployPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -457,7 +456,7 @@ func deployPipelineWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -493,7 +492,7 @@ func deployPipelineWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromDevopsDeployPipelineWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -523,7 +522,7 @@ func (s *DevopsDeployPipelineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployPipeline(context.Background(), request)
+	response, err := s.Client.GetDeployPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -573,7 +572,7 @@ func (s *DevopsDeployPipelineResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployPipeline(context.Background(), request)
+	response, err := s.Client.UpdateDeployPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -590,7 +589,7 @@ func (s *DevopsDeployPipelineResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteDeployPipeline(context.Background(), request)
+	response, err := s.Client.DeleteDeployPipeline(s.Context(), request)
 	if err != nil {
 		return err
 	}
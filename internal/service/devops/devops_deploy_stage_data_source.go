@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"log"
 	"strconv"
 
@@ -52,7 +51,7 @@ func (s *DevopsDeployStageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetDeployStage(context.Background(), request)
+	response, err := s.Client.GetDeployStage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
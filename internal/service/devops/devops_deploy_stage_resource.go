@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -545,7 +544,7 @@ func (s *DevopsDeployStageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployStage(context.Background(), request)
+	response, err := s.Client.CreateDeployStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -611,7 +610,7 @@ func deployStageWaitForWorkRequest(wId *string, entityType string, action oci_de
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -647,7 +646,7 @@ func deployStageWaitForWorkRequest(wId *string, entityType string, action oci_de
 }
 
 func getErrorFromDevopsDeployStageWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -677,7 +676,7 @@ func (s *DevopsDeployStageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployStage(context.Background(), request)
+	response, err := s.Client.GetDeployStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -695,7 +694,7 @@ func (s *DevopsDeployStageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployStage(context.Background(), request)
+	response, err := s.Client.UpdateDeployStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -712,7 +711,7 @@ func (s *DevopsDeployStageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteDeployStage(context.Background(), request)
+	response, err := s.Client.DeleteDeployStage(s.Context(), request)
 	if err != nil {
 		return err
 	}
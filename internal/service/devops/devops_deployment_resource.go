@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -449,7 +448,7 @@ func (s *DevopsDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployment(context.Background(), request)
+	response, err := s.Client.CreateDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -466,7 +465,7 @@ func (s *DevopsDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -484,7 +483,7 @@ func (s *DevopsDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployment(context.Background(), request)
+	response, err := s.Client.UpdateDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
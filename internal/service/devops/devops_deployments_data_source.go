@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -138,7 +137,7 @@ func (s *DevopsDeploymentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListDeployments(context.Background(), request)
+	response, err := s.Client.ListDeployments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +146,7 @@ func (s *DevopsDeploymentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployments(context.Background(), request)
+		listResponse, err := s.Client.ListDeployments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -222,7 +221,7 @@ func (s *DevopsProjectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateProject(context.Background(), request)
+	response, err := s.Client.CreateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -288,7 +287,7 @@ func projectWaitForWorkRequest(wId *string, entityType string, action oci_devops
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -324,7 +323,7 @@ func projectWaitForWorkRequest(wId *string, entityType string, action oci_devops
 }
 
 func getErrorFromDevopsProjectWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -354,7 +353,7 @@ func (s *DevopsProjectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -408,7 +407,7 @@ func (s *DevopsProjectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateProject(context.Background(), request)
+	response, err := s.Client.UpdateProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -425,7 +424,7 @@ func (s *DevopsProjectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteProject(context.Background(), request)
+	response, err := s.Client.DeleteProject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -569,7 +568,7 @@ func (s *DevopsProjectResourceCrud) updateCompartment(compartment interface{}) e
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.ChangeProjectCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeProjectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
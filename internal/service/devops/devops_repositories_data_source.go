@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -121,7 +119,7 @@ func (s *DevopsRepositoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListRepositories(context.Background(), request)
+	response, err := s.Client.ListRepositories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +128,7 @@ func (s *DevopsRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListRepositories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -72,7 +70,7 @@ func (s *DevopsRepositoryArchiveContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRepositoryArchiveContent(context.Background(), request)
+	response, err := s.Client.GetRepositoryArchiveContent(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -90,7 +88,7 @@ func (s *DevopsRepositoryAuthorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListAuthors(context.Background(), request)
+	response, err := s.Client.ListAuthors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
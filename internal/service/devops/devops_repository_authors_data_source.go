@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -99,7 +97,7 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListAuthors(context.Background(), request)
+	response, err := s.Client.ListAuthors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +106,7 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuthors(context.Background(), request)
+		listResponse, err := s.Client.ListAuthors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
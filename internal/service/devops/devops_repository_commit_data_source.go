@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -98,7 +96,7 @@ func (s *DevopsRepositoryCommitDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetCommit(context.Background(), request)
+	response, err := s.Client.GetCommit(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
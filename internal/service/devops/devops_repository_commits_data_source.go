@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -195,7 +194,7 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListCommits(context.Background(), request)
+	response, err := s.Client.ListCommits(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +203,7 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommits(context.Background(), request)
+		listResponse, err := s.Client.ListCommits(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -71,7 +70,7 @@ func (s *DevopsRepositoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
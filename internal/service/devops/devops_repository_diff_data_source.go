@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -193,7 +191,7 @@ func (s *DevopsRepositoryDiffDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetFileDiff(context.Background(), request)
+	response, err := s.Client.GetFileDiff(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -210,7 +208,7 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListCommitDiffs(context.Background(), request)
+	response, err := s.Client.ListCommitDiffs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -219,7 +217,7 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommitDiffs(context.Background(), request)
+		listResponse, err := s.Client.ListCommitDiffs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
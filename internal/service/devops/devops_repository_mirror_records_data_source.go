@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -100,7 +98,7 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListMirrorRecords(context.Background(), request)
+	response, err := s.Client.ListMirrorRecords(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +107,7 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMirrorRecords(context.Background(), request)
+		listResponse, err := s.Client.ListMirrorRecords(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
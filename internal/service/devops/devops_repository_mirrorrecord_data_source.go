@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -82,7 +80,7 @@ func (s *DevopsRepositoryMirrorrecordDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetMirrorRecord(context.Background(), request)
+	response, err := s.Client.GetMirrorRecord(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
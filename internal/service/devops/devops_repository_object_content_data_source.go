@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -72,7 +70,7 @@ func (s *DevopsRepositoryObjectContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetObjectContent(context.Background(), request)
+	response, err := s.Client.GetObjectContent(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -89,7 +88,7 @@ func (s *DevopsRepositoryObjectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -138,7 +137,7 @@ func (s *DevopsRepositoryPathDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListPaths(context.Background(), request)
+	response, err := s.Client.ListPaths(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -140,7 +138,7 @@ func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListPaths(context.Background(), request)
+	response, err := s.Client.ListPaths(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +147,7 @@ func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPaths(context.Background(), request)
+		listResponse, err := s.Client.ListPaths(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
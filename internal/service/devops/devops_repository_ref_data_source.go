@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -60,7 +59,7 @@ func (s *DevopsRepositoryRefDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRef(context.Background(), request)
+	response, err := s.Client.GetRef(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
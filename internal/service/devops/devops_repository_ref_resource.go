@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -137,7 +136,7 @@ func (s *DevopsRepositoryRefResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.PutRepositoryRef(context.Background(), request)
+	response, err := s.Client.PutRepositoryRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +168,7 @@ func (s *DevopsRepositoryRefResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetRef(context.Background(), request)
+	response, err := s.Client.GetRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,7 +186,7 @@ func (s *DevopsRepositoryRefResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.PutRepositoryRef(context.Background(), request)
+	response, err := s.Client.PutRepositoryRef(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +210,7 @@ func (s *DevopsRepositoryRefResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	_, err := s.Client.DeleteRef(context.Background(), request)
+	_, err := s.Client.DeleteRef(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -94,7 +92,7 @@ func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListRefs(context.Background(), request)
+	response, err := s.Client.ListRefs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRefs(context.Background(), request)
+		listResponse, err := s.Client.ListRefs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -307,7 +306,7 @@ func (s *DevopsRepositoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateRepository(context.Background(), request)
+	response, err := s.Client.CreateRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -373,7 +372,7 @@ func repositoryWaitForWorkRequest(wId *string, entityType string, action oci_dev
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -409,7 +408,7 @@ func repositoryWaitForWorkRequest(wId *string, entityType string, action oci_dev
 }
 
 func getErrorFromDevopsRepositoryWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -452,7 +451,7 @@ func (s *DevopsRepositoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -511,7 +510,7 @@ func (s *DevopsRepositoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateRepository(context.Background(), request)
+	response, err := s.Client.UpdateRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -528,7 +527,7 @@ func (s *DevopsRepositoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteRepository(context.Background(), request)
+	response, err := s.Client.DeleteRepository(s.Context(), request)
 	if err != nil {
 		return err
 	}
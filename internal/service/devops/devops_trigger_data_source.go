@@ -4,8 +4,6 @@
 package devops
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v55/devops"
 
@@ -50,7 +48,7 @@ func (s *DevopsTriggerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetTrigger(context.Background(), request)
+	response, err := s.Client.GetTrigger(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package devops
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -271,7 +270,7 @@ func (s *DevopsTriggerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateTrigger(context.Background(), request)
+	response, err := s.Client.CreateTrigger(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +336,7 @@ func triggerWaitForWorkRequest(wId *string, entityType string, action oci_devops
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -373,7 +372,7 @@ func triggerWaitForWorkRequest(wId *string, entityType string, action oci_devops
 }
 
 func getErrorFromDevopsTriggerWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -403,7 +402,7 @@ func (s *DevopsTriggerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetTrigger(context.Background(), request)
+	response, err := s.Client.GetTrigger(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,7 +420,7 @@ func (s *DevopsTriggerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateTrigger(context.Background(), request)
+	response, err := s.Client.UpdateTrigger(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -438,7 +437,7 @@ func (s *DevopsTriggerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteTrigger(context.Background(), request)
+	response, err := s.Client.DeleteTrigger(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -11,7 +11,6 @@
 package dns
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -169,7 +168,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 	request.Items = []oci_dns.RecordOperation{ro}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	response, err := s.Client.PatchRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -226,7 +225,7 @@ func (s *DnsRecordResourceCrud) Get() error {
 
 	var err error
 	for true {
-		response, err := s.Client.GetRRSet(context.Background(), request)
+		response, err := s.Client.GetRRSet(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -275,7 +274,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 	request.Items = []oci_dns.RecordOperation{removeOp, addOp}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	response, err := s.Client.PatchRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -326,7 +325,7 @@ func (s *DnsRecordResourceCrud) Delete() error {
 	request.Items = []oci_dns.RecordOperation{ro}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	_, err := s.Client.PatchRRSet(context.Background(), request)
+	_, err := s.Client.PatchRRSet(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -129,7 +127,7 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetZoneRecords(context.Background(), request)
+	response, err := s.Client.GetZoneRecords(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +136,7 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetZoneRecords(context.Background(), request)
+		listResponse, err := s.Client.GetZoneRecords(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
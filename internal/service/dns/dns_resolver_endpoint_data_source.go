@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -68,7 +67,7 @@ func (s *DnsResolverEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetResolverEndpoint(context.Background(), request)
+	response, err := s.Client.GetResolverEndpoint(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
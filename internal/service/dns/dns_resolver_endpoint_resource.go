@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -198,7 +197,7 @@ func (s *DnsResolverEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateResolverEndpoint(context.Background(), request)
+	response, err := s.Client.CreateResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -237,7 +236,7 @@ func (s *DnsResolverEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetResolverEndpoint(context.Background(), request)
+	response, err := s.Client.GetResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +254,7 @@ func (s *DnsResolverEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolverEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateResolverEndpoint(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +282,7 @@ func (s *DnsResolverEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteResolverEndpoint(context.Background(), request)
+	_, err := s.Client.DeleteResolverEndpoint(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -85,7 +84,7 @@ func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListResolverEndpoints(context.Background(), request)
+	response, err := s.Client.ListResolverEndpoints(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +93,7 @@ func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResolverEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListResolverEndpoints(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
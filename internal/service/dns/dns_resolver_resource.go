@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -379,7 +378,7 @@ func (s *DnsResolverResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolver(context.Background(), request)
+	response, err := s.Client.UpdateResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -411,7 +410,7 @@ func (s *DnsResolverResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetResolver(context.Background(), request)
+	response, err := s.Client.GetResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -492,7 +491,7 @@ func (s *DnsResolverResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolver(context.Background(), request)
+	response, err := s.Client.UpdateResolver(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -737,7 +736,7 @@ func (s *DnsResolverResourceCrud) updateCompartment(compartment interface{}) err
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeResolverCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeResolverCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -93,7 +91,7 @@ func (s *DnsResolversDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListResolvers(context.Background(), request)
+	response, err := s.Client.ListResolvers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +100,7 @@ func (s *DnsResolversDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResolvers(context.Background(), request)
+		listResponse, err := s.Client.ListResolvers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *DnsRrsetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetRRSet(context.Background(), request)
+	response, err := s.Client.GetRRSet(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package dns
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -217,7 +216,7 @@ func (s *DnsRrsetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -282,7 +281,7 @@ func (s *DnsRrsetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetRRSet(context.Background(), request)
+	response, err := s.Client.GetRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -344,7 +343,7 @@ func (s *DnsRrsetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -395,7 +394,7 @@ func (s *DnsRrsetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteRRSet(context.Background(), request)
+	_, err := s.Client.DeleteRRSet(s.Context(), request)
 	return err
 }
 
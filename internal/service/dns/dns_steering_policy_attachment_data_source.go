@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
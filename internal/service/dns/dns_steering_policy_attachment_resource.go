@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -169,7 +167,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -186,7 +184,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +206,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicyAttachment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +223,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicyAttachment(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicyAttachment(s.Context(), request)
 	return err
 }
 
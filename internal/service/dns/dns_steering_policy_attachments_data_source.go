@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -147,7 +146,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicyAttachments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -156,7 +155,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListSteeringPolicyAttachments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
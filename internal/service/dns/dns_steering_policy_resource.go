@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -404,7 +403,7 @@ func (s *DnsSteeringPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,7 +425,7 @@ func (s *DnsSteeringPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -483,7 +482,7 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -506,7 +505,7 @@ func (s *DnsSteeringPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicy(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicy(s.Context(), request)
 	return err
 }
 
@@ -1178,7 +1177,7 @@ func (s *DnsSteeringPolicyResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeSteeringPolicyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSteeringPolicyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -189,7 +187,7 @@ func (s *DnsTsigKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateTsigKey(context.Background(), request)
+	response, err := s.Client.CreateTsigKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +204,7 @@ func (s *DnsTsigKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetTsigKey(context.Background(), request)
+	response, err := s.Client.GetTsigKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +242,7 @@ func (s *DnsTsigKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateTsigKey(context.Background(), request)
+	response, err := s.Client.UpdateTsigKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -261,7 +259,7 @@ func (s *DnsTsigKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteTsigKey(context.Background(), request)
+	_, err := s.Client.DeleteTsigKey(s.Context(), request)
 	return err
 }
 
@@ -316,7 +314,7 @@ func (s *DnsTsigKeyResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeTsigKeyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTsigKeyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package dns
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -58,7 +56,7 @@ func (s *DnsViewDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetView(context.Background(), request)
+	response, err := s.Client.GetView(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
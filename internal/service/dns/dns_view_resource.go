@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -184,7 +183,7 @@ func (s *DnsViewResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateView(context.Background(), request)
+	response, err := s.Client.CreateView(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +214,7 @@ func (s *DnsViewResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetView(context.Background(), request)
+	response, err := s.Client.GetView(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +261,7 @@ func (s *DnsViewResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateView(context.Background(), request)
+	response, err := s.Client.UpdateView(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +282,7 @@ func (s *DnsViewResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteView(context.Background(), request)
+	_, err := s.Client.DeleteView(s.Context(), request)
 	return err
 }
 
@@ -338,7 +337,7 @@ func (s *DnsViewResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeViewCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeViewCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -253,7 +252,7 @@ func (s *DnsZoneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateZone(context.Background(), request)
+	response, err := s.Client.CreateZone(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -298,7 +297,7 @@ func (s *DnsZoneResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetZone(context.Background(), request)
+	response, err := s.Client.GetZone(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -367,7 +366,7 @@ func (s *DnsZoneResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateZone(context.Background(), request)
+	response, err := s.Client.UpdateZone(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -398,7 +397,7 @@ func (s *DnsZoneResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteZone(context.Background(), request)
+	_, err := s.Client.DeleteZone(s.Context(), request)
 	return err
 }
 
@@ -522,7 +521,7 @@ func (s *DnsZoneResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeZoneCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeZoneCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
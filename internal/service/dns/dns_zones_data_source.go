@@ -4,7 +4,6 @@
 package dns
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -163,7 +162,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListZones(context.Background(), request)
+	response, err := s.Client.ListZones(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +171,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListZones(context.Background(), request)
+		listResponse, err := s.Client.ListZones(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
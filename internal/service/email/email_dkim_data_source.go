@@ -4,8 +4,6 @@
 package email
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *EmailDkimDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.GetDkim(context.Background(), request)
+	response, err := s.Client.GetDkim(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package email
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -208,7 +207,7 @@ func (s *EmailDkimResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateDkim(context.Background(), request)
+	response, err := s.Client.CreateDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -276,7 +275,7 @@ func dkimWaitForWorkRequest(wId *string, entityType string, action oci_email.Act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_email.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -312,7 +311,7 @@ func dkimWaitForWorkRequest(wId *string, entityType string, action oci_email.Act
 }
 
 func getErrorFromEmailDkimWorkRequest(client *oci_email.EmailClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_email.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_email.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -342,7 +341,7 @@ func (s *EmailDkimResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetDkim(context.Background(), request)
+	response, err := s.Client.GetDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -376,7 +375,7 @@ func (s *EmailDkimResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateDkim(context.Background(), request)
+	response, err := s.Client.UpdateDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -393,7 +392,7 @@ func (s *EmailDkimResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.DeleteDkim(context.Background(), request)
+	response, err := s.Client.DeleteDkim(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package email
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *EmailEmailDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.GetEmailDomain(context.Background(), request)
+	response, err := s.Client.GetEmailDomain(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
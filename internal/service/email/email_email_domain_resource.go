@@ -4,7 +4,6 @@
 package email
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -189,7 +188,7 @@ func (s *EmailEmailDomainResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateEmailDomain(context.Background(), request)
+	response, err := s.Client.CreateEmailDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +256,7 @@ func emailDomainWaitForWorkRequest(wId *string, entityType string, action oci_em
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_email.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -293,7 +292,7 @@ func emailDomainWaitForWorkRequest(wId *string, entityType string, action oci_em
 }
 
 func getErrorFromEmailEmailDomainWorkRequest(client *oci_email.EmailClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_email.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_email.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -323,7 +322,7 @@ func (s *EmailEmailDomainResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetEmailDomain(context.Background(), request)
+	response, err := s.Client.GetEmailDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -366,7 +365,7 @@ func (s *EmailEmailDomainResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateEmailDomain(context.Background(), request)
+	response, err := s.Client.UpdateEmailDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -383,7 +382,7 @@ func (s *EmailEmailDomainResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.DeleteEmailDomain(context.Background(), request)
+	response, err := s.Client.DeleteEmailDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -488,7 +487,7 @@ func (s *EmailEmailDomainResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.ChangeEmailDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeEmailDomainCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package email
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -94,7 +92,7 @@ func (s *EmailEmailDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.ListEmailDomains(context.Background(), request)
+	response, err := s.Client.ListEmailDomains(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *EmailEmailDomainsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEmailDomains(context.Background(), request)
+		listResponse, err := s.Client.ListEmailDomains(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
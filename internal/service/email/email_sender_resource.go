@@ -4,8 +4,6 @@
 package email
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -168,7 +166,7 @@ func (s *EmailSenderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSender(context.Background(), request)
+	response, err := s.Client.CreateSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +183,7 @@ func (s *EmailSenderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -223,7 +221,7 @@ func (s *EmailSenderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateSender(context.Background(), request)
+	response, err := s.Client.UpdateSender(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +238,7 @@ func (s *EmailSenderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSender(context.Background(), request)
+	_, err := s.Client.DeleteSender(s.Context(), request)
 	return err
 }
 
@@ -287,7 +285,7 @@ func (s *EmailSenderResourceCrud) updateCompartment(compartment interface{}) err
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.ChangeSenderCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSenderCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
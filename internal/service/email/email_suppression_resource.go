@@ -4,8 +4,6 @@
 package email
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -119,7 +117,7 @@ func (s *EmailSuppressionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSuppression(context.Background(), request)
+	response, err := s.Client.CreateSuppression(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +134,7 @@ func (s *EmailSuppressionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -153,7 +151,7 @@ func (s *EmailSuppressionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSuppression(context.Background(), request)
+	_, err := s.Client.DeleteSuppression(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package email
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -94,7 +93,7 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.ListSuppressions(context.Background(), request)
+	response, err := s.Client.ListSuppressions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +102,7 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSuppressions(context.Background(), request)
+		listResponse, err := s.Client.ListSuppressions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package events
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *EventsRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "events")
 
-	response, err := s.Client.GetRule(context.Background(), request)
+	response, err := s.Client.GetRule(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
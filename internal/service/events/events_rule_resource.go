@@ -5,7 +5,6 @@ package events
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -284,7 +283,7 @@ func (s *EventsRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.CreateRule(context.Background(), request)
+	response, err := s.Client.CreateRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +300,7 @@ func (s *EventsRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.GetRule(context.Background(), request)
+	response, err := s.Client.GetRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -370,7 +369,7 @@ func (s *EventsRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.UpdateRule(context.Background(), request)
+	response, err := s.Client.UpdateRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +386,7 @@ func (s *EventsRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	_, err := s.Client.DeleteRule(context.Background(), request)
+	_, err := s.Client.DeleteRule(s.Context(), request)
 	return err
 }
 
@@ -658,7 +657,7 @@ func (s *EventsRuleResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	_, err := s.Client.ChangeRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRuleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package events
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -76,7 +74,7 @@ func (s *EventsRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "events")
 
-	response, err := s.Client.ListRules(context.Background(), request)
+	response, err := s.Client.ListRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *EventsRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRules(context.Background(), request)
+		listResponse, err := s.Client.ListRules(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
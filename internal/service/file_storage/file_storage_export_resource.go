@@ -4,7 +4,6 @@
 package file_storage
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -212,7 +211,7 @@ func (s *FileStorageExportResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateExport(context.Background(), request)
+	response, err := s.Client.CreateExport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +232,7 @@ func (s *FileStorageExportResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExport(context.Background(), request)
+	response, err := s.Client.GetExport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +266,7 @@ func (s *FileStorageExportResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExport(context.Background(), request)
+	response, err := s.Client.UpdateExport(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -284,7 +283,7 @@ func (s *FileStorageExportResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteExport(context.Background(), request)
+	_, err := s.Client.DeleteExport(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package file_storage
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -131,7 +130,7 @@ func (s *FileStorageExportSetResourceCrud) Create() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-		response, err := s.Client.GetMountTarget(context.Background(), request)
+		response, err := s.Client.GetMountTarget(s.Context(), request)
 		if err != nil {
 			return fmt.Errorf("getting mount target details failed with error: %s", err.Error())
 		}
@@ -157,7 +156,7 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExportSet(context.Background(), request)
+	response, err := s.Client.GetExportSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -197,7 +196,7 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExportSet(context.Background(), request)
+	response, err := s.Client.UpdateExportSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
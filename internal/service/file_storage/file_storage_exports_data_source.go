@@ -4,8 +4,6 @@
 package file_storage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -94,7 +92,7 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListExports(context.Background(), request)
+	response, err := s.Client.ListExports(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExports(context.Background(), request)
+		listResponse, err := s.Client.ListExports(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
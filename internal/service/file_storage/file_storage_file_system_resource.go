@@ -4,7 +4,6 @@
 package file_storage
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -231,7 +230,7 @@ func (s *FileStorageFileSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateFileSystem(context.Background(), request)
+	response, err := s.Client.CreateFileSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,7 +251,7 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetFileSystem(context.Background(), request)
+	response, err := s.Client.GetFileSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -300,7 +299,7 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateFileSystem(context.Background(), request)
+	response, err := s.Client.UpdateFileSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -317,7 +316,7 @@ func (s *FileStorageFileSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteFileSystem(context.Background(), request)
+	_, err := s.Client.DeleteFileSystem(s.Context(), request)
 	return err
 }
 
@@ -400,7 +399,7 @@ func (s *FileStorageFileSystemResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.ChangeFileSystemCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeFileSystemCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
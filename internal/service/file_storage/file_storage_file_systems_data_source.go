@@ -4,7 +4,6 @@
 package file_storage
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -113,7 +112,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListFileSystems(context.Background(), request)
+	response, err := s.Client.ListFileSystems(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -122,7 +121,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFileSystems(context.Background(), request)
+		listResponse, err := s.Client.ListFileSystems(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
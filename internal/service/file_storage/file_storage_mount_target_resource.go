@@ -4,8 +4,6 @@
 package file_storage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -250,7 +248,7 @@ func (s *FileStorageMountTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateMountTarget(context.Background(), request)
+	response, err := s.Client.CreateMountTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -271,7 +269,7 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetMountTarget(context.Background(), request)
+	response, err := s.Client.GetMountTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +326,7 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateMountTarget(context.Background(), request)
+	response, err := s.Client.UpdateMountTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -345,7 +343,7 @@ func (s *FileStorageMountTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteMountTarget(context.Background(), request)
+	_, err := s.Client.DeleteMountTarget(s.Context(), request)
 	return err
 }
 
@@ -416,7 +414,7 @@ func (s *FileStorageMountTargetResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.ChangeMountTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMountTargetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -435,7 +433,7 @@ func (s *FileStorageMountTargetResourceCrud) setPrivateIpDetails(privateIpOcid s
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
 
-	response, err := s.VirtualNetworkClient.GetPrivateIp(context.Background(), request)
+	response, err := s.VirtualNetworkClient.GetPrivateIp(s.Context(), request)
 	if err != nil {
 		return err
 	}
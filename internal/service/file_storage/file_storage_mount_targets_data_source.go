@@ -4,8 +4,6 @@
 package file_storage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListMountTargets(context.Background(), request)
+	response, err := s.Client.ListMountTargets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMountTargets(context.Background(), request)
+		listResponse, err := s.Client.ListMountTargets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
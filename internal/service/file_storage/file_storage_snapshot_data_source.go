@@ -4,8 +4,6 @@
 package file_storage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *FileStorageSnapshotDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package file_storage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -173,7 +171,7 @@ func (s *FileStorageSnapshotResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateSnapshot(context.Background(), request)
+	response, err := s.Client.CreateSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +192,7 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -223,7 +221,7 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateSnapshot(context.Background(), request)
+	response, err := s.Client.UpdateSnapshot(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +238,7 @@ func (s *FileStorageSnapshotResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteSnapshot(context.Background(), request)
+	_, err := s.Client.DeleteSnapshot(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package functions
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"time"
@@ -319,7 +318,7 @@ func (s *FunctionsApplicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.CreateApplication(context.Background(), request)
+	response, err := s.Client.CreateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +335,7 @@ func (s *FunctionsApplicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -419,7 +418,7 @@ func (s *FunctionsApplicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.UpdateApplication(context.Background(), request)
+	response, err := s.Client.UpdateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -436,7 +435,7 @@ func (s *FunctionsApplicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.DeleteApplication(context.Background(), request)
+	_, err := s.Client.DeleteApplication(s.Context(), request)
 	return err
 }
 
@@ -600,7 +599,7 @@ func (s *FunctionsApplicationResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.ChangeApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeApplicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package functions
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *FunctionsFunctionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	response, err := s.Client.GetFunction(context.Background(), request)
+	response, err := s.Client.GetFunction(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package functions
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -316,7 +315,7 @@ func (s *FunctionsFunctionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.CreateFunction(context.Background(), request)
+	response, err := s.Client.CreateFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -333,7 +332,7 @@ func (s *FunctionsFunctionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.GetFunction(context.Background(), request)
+	response, err := s.Client.GetFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -404,7 +403,7 @@ func (s *FunctionsFunctionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.UpdateFunction(context.Background(), request)
+	response, err := s.Client.UpdateFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,7 +420,7 @@ func (s *FunctionsFunctionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.DeleteFunction(context.Background(), request)
+	_, err := s.Client.DeleteFunction(s.Context(), request)
 	return err
 }
 
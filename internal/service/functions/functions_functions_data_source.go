@@ -4,7 +4,6 @@
 package functions
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -86,7 +85,7 @@ func (s *FunctionsFunctionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	response, err := s.Client.ListFunctions(context.Background(), request)
+	response, err := s.Client.ListFunctions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +94,7 @@ func (s *FunctionsFunctionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFunctions(context.Background(), request)
+		listResponse, err := s.Client.ListFunctions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
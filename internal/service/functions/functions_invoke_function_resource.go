@@ -5,7 +5,6 @@ package functions
 
 import (
 	"bytes"
-	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -120,7 +119,7 @@ func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (
 
 	functionGetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	functionGetResponse, err := functionsManagementClient.GetFunction(context.Background(), functionGetRequest)
+	functionGetResponse, err := functionsManagementClient.GetFunction(s.Context(), functionGetRequest)
 	if err != nil {
 		return "", err
 	}
@@ -196,7 +195,7 @@ func (s *FunctionsInvokeFunctionResourceCrud) Create() error {
 		s.Client.Host = endPoint.(string)
 	}
 
-	response, err := s.Client.InvokeFunction(context.Background(), request)
+	response, err := s.Client.InvokeFunction(s.Context(), request)
 	if err != nil {
 		return err
 	}
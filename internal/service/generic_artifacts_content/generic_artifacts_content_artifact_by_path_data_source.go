@@ -4,8 +4,6 @@
 package generic_artifacts_content
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v55/genericartifactscontent"
 
@@ -68,7 +66,7 @@ func (s *GenericArtifactsContentArtifactByPathDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContentByPath(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
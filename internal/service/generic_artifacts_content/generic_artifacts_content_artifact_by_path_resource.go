@@ -5,7 +5,6 @@ package generic_artifacts_content
 
 import (
 	"bytes"
-	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -185,7 +184,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +231,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByCont
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.PutGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.PutGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -274,7 +273,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySour
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.PutGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.PutGenericArtifactContentByPath(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package generic_artifacts_content
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v55/genericartifactscontent"
 
@@ -54,7 +52,7 @@ func (s *GenericArtifactsContentGenericArtifactsContentDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContent(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContent(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
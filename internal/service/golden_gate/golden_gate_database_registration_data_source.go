@@ -4,8 +4,6 @@
 package golden_gate
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *GoldenGateDatabaseRegistrationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.GetDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.GetDatabaseRegistration(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
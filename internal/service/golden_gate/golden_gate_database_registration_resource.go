@@ -4,7 +4,6 @@
 package golden_gate
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -331,7 +330,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.CreateDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.CreateDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -398,7 +397,7 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_golden_gate.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -434,7 +433,7 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 }
 
 func getErrorFromGoldenGateDatabaseRegistrationWorkRequest(client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_golden_gate.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -464,7 +463,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.GetDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.GetDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -546,7 +545,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.UpdateDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -563,7 +562,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.DeleteDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseRegistration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -752,7 +751,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.ChangeDatabaseRegistrationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseRegistrationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
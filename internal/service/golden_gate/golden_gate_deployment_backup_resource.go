@@ -4,7 +4,6 @@
 package golden_gate
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -246,7 +245,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.CreateDeploymentBackup(context.Background(), request)
+	response, err := s.Client.CreateDeploymentBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -313,7 +312,7 @@ func goldenGateDeploymentBackupWaitForWorkRequest(wId *string, entityType string
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_golden_gate.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -349,7 +348,7 @@ func goldenGateDeploymentBackupWaitForWorkRequest(wId *string, entityType string
 }
 
 func getErrorFromGoldenGateDeploymentBackupWorkRequest(client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_golden_gate.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -379,7 +378,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.GetDeploymentBackup(context.Background(), request)
+	response, err := s.Client.GetDeploymentBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +416,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.UpdateDeploymentBackup(context.Background(), request)
+	response, err := s.Client.UpdateDeploymentBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -434,7 +433,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.DeleteDeploymentBackup(context.Background(), request)
+	response, err := s.Client.DeleteDeploymentBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -611,7 +610,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	_, err := s.Client.ChangeDeploymentBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDeploymentBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package golden_gate
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -94,7 +92,7 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListDeploymentBackups(context.Background(), request)
+	response, err := s.Client.ListDeploymentBackups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeploymentBackups(context.Background(), request)
+		listResponse, err := s.Client.ListDeploymentBackups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package golden_gate
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -376,7 +375,7 @@ func (s *GoldenGateDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.CreateDeployment(context.Background(), request)
+	response, err := s.Client.CreateDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -443,7 +442,7 @@ func goldenGateDeploymentWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_golden_gate.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -479,7 +478,7 @@ func goldenGateDeploymentWaitForWorkRequest(wId *string, entityType string, acti
 }
 
 func getErrorFromDeploymentWorkRequest(client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_golden_gate.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -509,7 +508,7 @@ func (s *GoldenGateDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -611,7 +610,7 @@ func (s *GoldenGateDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.UpdateDeployment(context.Background(), request)
+	response, err := s.Client.UpdateDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -628,7 +627,7 @@ func (s *GoldenGateDeploymentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.DeleteDeployment(context.Background(), request)
+	response, err := s.Client.DeleteDeployment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -943,7 +942,7 @@ func (s *GoldenGateDeploymentResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.ChangeDeploymentCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDeploymentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
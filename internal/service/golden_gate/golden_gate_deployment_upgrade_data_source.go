@@ -4,8 +4,6 @@
 package golden_gate
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -121,7 +119,7 @@ func (s *GoldenGateDeploymentUpgradeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.GetDeploymentUpgrade(context.Background(), request)
+	response, err := s.Client.GetDeploymentUpgrade(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package golden_gate
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -172,7 +170,7 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListDeploymentUpgrades(context.Background(), request)
+	response, err := s.Client.ListDeploymentUpgrades(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +179,7 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeploymentUpgrades(context.Background(), request)
+		listResponse, err := s.Client.ListDeploymentUpgrades(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
 
@@ -50,7 +48,7 @@ func (s *HealthChecksHttpMonitorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
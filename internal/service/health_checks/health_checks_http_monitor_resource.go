@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
@@ -256,7 +254,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateHttpMonitor(context.Background(), request)
+	response, err := s.Client.CreateHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +271,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -379,7 +377,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdateHttpMonitor(context.Background(), request)
+	response, err := s.Client.UpdateHttpMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -396,7 +394,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeleteHttpMonitor(context.Background(), request)
+	_, err := s.Client.DeleteHttpMonitor(s.Context(), request)
 	return err
 }
 
@@ -471,7 +469,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.ChangeHttpMonitorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHttpMonitorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
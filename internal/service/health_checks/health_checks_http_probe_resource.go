@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
@@ -195,7 +193,7 @@ func (s *HealthChecksHttpProbeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandHttpProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandHttpProbe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
 
@@ -239,7 +237,7 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListHttpProbeResults(context.Background(), request)
+	response, err := s.Client.ListHttpProbeResults(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -248,7 +246,7 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHttpProbeResults(context.Background(), request)
+		listResponse, err := s.Client.ListHttpProbeResults(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
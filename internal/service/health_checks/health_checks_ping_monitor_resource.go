@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
@@ -227,7 +225,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreatePingMonitor(context.Background(), request)
+	response, err := s.Client.CreatePingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +242,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetPingMonitor(context.Background(), request)
+	response, err := s.Client.GetPingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +335,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdatePingMonitor(context.Background(), request)
+	response, err := s.Client.UpdatePingMonitor(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -354,7 +352,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeletePingMonitor(context.Background(), request)
+	_, err := s.Client.DeletePingMonitor(s.Context(), request)
 	return err
 }
 
@@ -421,7 +419,7 @@ func (s *HealthChecksPingMonitorResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.ChangePingMonitorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePingMonitorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
 
@@ -77,7 +75,7 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListPingMonitors(context.Background(), request)
+	response, err := s.Client.ListPingMonitors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPingMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListPingMonitors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
@@ -161,7 +159,7 @@ func (s *HealthChecksPingProbeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandPingProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandPingProbe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
 
@@ -199,7 +197,7 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListPingProbeResults(context.Background(), request)
+	response, err := s.Client.ListPingProbeResults(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +206,7 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPingProbeResults(context.Background(), request)
+		listResponse, err := s.Client.ListPingProbeResults(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package health_checks
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v55/healthchecks"
 
@@ -160,7 +158,7 @@ func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListHealthChecksVantagePoints(context.Background(), request)
+	response, err := s.Client.ListHealthChecksVantagePoints(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +167,7 @@ func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHealthChecksVantagePoints(context.Background(), request)
+		listResponse, err := s.Client.ListHealthChecksVantagePoints(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
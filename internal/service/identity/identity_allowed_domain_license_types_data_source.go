@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -79,7 +77,7 @@ func (s *IdentityAllowedDomainLicenseTypesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAllowedDomainLicenseTypes(context.Background(), request)
+	response, err := s.Client.ListAllowedDomainLicenseTypes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -151,7 +150,7 @@ func (s *IdentityApiKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UploadApiKey(context.Background(), request)
+	response, err := s.Client.UploadApiKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +177,7 @@ func (s *IdentityApiKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -210,7 +209,7 @@ func (s *IdentityApiKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteApiKey(context.Background(), request)
+	_, err := s.Client.DeleteApiKey(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -157,7 +156,7 @@ func (s *IdentityAuthTokenResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateAuthToken(context.Background(), request)
+	response, err := s.Client.CreateAuthToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +183,7 @@ func (s *IdentityAuthTokenResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -218,7 +217,7 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthToken(context.Background(), request)
+	response, err := s.Client.UpdateAuthToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *IdentityAuthTokenResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteAuthToken(context.Background(), request)
+	_, err := s.Client.DeleteAuthToken(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -60,7 +59,7 @@ func (s *IdentityAuthTokensDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
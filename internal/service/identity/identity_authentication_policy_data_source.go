@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -50,7 +48,7 @@ func (s *IdentityAuthenticationPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
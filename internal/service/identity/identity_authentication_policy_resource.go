@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -185,7 +184,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +210,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -252,7 +251,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
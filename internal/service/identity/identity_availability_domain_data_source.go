@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"sort"
 
 	"fmt"
@@ -74,7 +72,7 @@ func (s *AvailabilityDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -81,7 +79,7 @@ func (s *IdentityAvailabilityDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
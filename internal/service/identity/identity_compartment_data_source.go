@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -95,7 +94,7 @@ func (s *IdentityCompartmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -213,7 +212,7 @@ func (s *IdentityCompartmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCompartment(context.Background(), request)
+	response, err := s.Client.CreateCompartment(s.Context(), request)
 	if err != nil {
 		if response.RawResponse != nil && response.RawResponse.StatusCode == 409 {
 
@@ -272,7 +271,7 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -320,7 +319,7 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCompartment(context.Background(), request)
+	response, err := s.Client.UpdateCompartment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +336,7 @@ func (s *IdentityCompartmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCompartment(context.Background(), request)
+	_, err := s.Client.DeleteCompartment(s.Context(), request)
 	return err
 }
 
@@ -390,7 +389,7 @@ func (s *IdentityCompartmentResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.MoveCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.MoveCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -94,7 +93,7 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListCompartments(context.Background(), request)
+	response, err := s.Client.ListCompartments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +102,7 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCompartments(context.Background(), request)
+		listResponse, err := s.Client.ListCompartments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -143,7 +141,7 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListCostTrackingTags(context.Background(), request)
+	response, err := s.Client.ListCostTrackingTags(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -152,7 +150,7 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCostTrackingTags(context.Background(), request)
+		listResponse, err := s.Client.ListCostTrackingTags(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
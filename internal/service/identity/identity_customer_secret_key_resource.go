@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -157,7 +156,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.CreateCustomerSecretKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +183,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -218,7 +217,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.UpdateCustomerSecretKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCustomerSecretKey(context.Background(), request)
+	_, err := s.Client.DeleteCustomerSecretKey(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -89,7 +87,7 @@ func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_data_plane")
 
-	response, err := s.Client.GenerateScopedAccessToken(context.Background(), request)
+	response, err := s.Client.GenerateScopedAccessToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
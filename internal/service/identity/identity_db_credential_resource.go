@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -149,7 +148,7 @@ func (s *IdentityDbCredentialResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDbCredential(context.Background(), request)
+	response, err := s.Client.CreateDbCredential(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +175,7 @@ func (s *IdentityDbCredentialResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListDbCredentials(context.Background(), request)
+	response, err := s.Client.ListDbCredentials(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +212,7 @@ func (s *IdentityDbCredentialResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDbCredential(context.Background(), request)
+	_, err := s.Client.DeleteDbCredential(s.Context(), request)
 	return err
 }
 
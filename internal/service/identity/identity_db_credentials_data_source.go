@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -76,7 +74,7 @@ func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListDbCredentials(context.Background(), request)
+	response, err := s.Client.ListDbCredentials(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbCredentials(context.Background(), request)
+		listResponse, err := s.Client.ListDbCredentials(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
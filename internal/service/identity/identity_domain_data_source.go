@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -50,7 +48,7 @@ func (s *IdentityDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
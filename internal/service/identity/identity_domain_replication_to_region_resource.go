@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -88,7 +87,7 @@ func (s *IdentityDomainReplicationToRegionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.EnableReplicationToRegion(context.Background(), request)
+	response, err := s.Client.EnableReplicationToRegion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +119,7 @@ func (s *IdentityDomainReplicationToRegionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -171,7 +170,7 @@ func domainReplicationToRegionWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetIamWorkRequest(context.Background(),
+			response, err = client.GetIamWorkRequest(tfresource.GetStopContext(),
 				oci_identity.GetIamWorkRequestRequest{
 					IamWorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -218,7 +217,7 @@ func getErrorFromIdentityDomainReplicaWorkRequest(client *oci_identity.IdentityC
 
 func getErrorMessageFromIdentityDomainReplicaWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy) (string, error) {
 	errorMessage := ""
-	response, err := client.ListIamWorkRequestErrors(context.Background(),
+	response, err := client.ListIamWorkRequestErrors(tfresource.GetStopContext(),
 		oci_identity.ListIamWorkRequestErrorsRequest{
 			IamWorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -251,7 +250,7 @@ func (s *IdentityDomainResourceCrud) GetDomain() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
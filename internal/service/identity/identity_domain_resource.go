@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -359,7 +358,7 @@ func (s *IdentityDomainResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDomain(context.Background(), request)
+	response, err := s.Client.CreateDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -425,7 +424,7 @@ func domainWaitForWorkRequest(wId *string, entityType string, action oci_identit
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetIamWorkRequest(context.Background(),
+			response, err = client.GetIamWorkRequest(tfresource.GetStopContext(),
 				oci_identity.GetIamWorkRequestRequest{
 					IamWorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -471,7 +470,7 @@ func getErrorFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient,
 
 func getErrorMessageFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy) (string, error) {
 	errorMessage := ""
-	response, err := client.ListIamWorkRequestErrors(context.Background(),
+	response, err := client.ListIamWorkRequestErrors(tfresource.GetStopContext(),
 		oci_identity.ListIamWorkRequestErrorsRequest{
 			IamWorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -500,7 +499,7 @@ func (s *IdentityDomainResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -564,7 +563,7 @@ func (s *IdentityDomainResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDomain(context.Background(), request)
+	response, err := s.Client.UpdateDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -581,7 +580,7 @@ func (s *IdentityDomainResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.DeleteDomain(context.Background(), request)
+	response, err := s.Client.DeleteDomain(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -678,7 +677,7 @@ func (s *IdentityDomainResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ChangeDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDomainCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -698,7 +697,7 @@ func (s *IdentityDomainResourceCrud) updateLicenseType(licenseType interface{})
 
 	changeDomainLicenseTypeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ChangeDomainLicenseType(context.Background(), changeDomainLicenseTypeRequest)
+	response, err := s.Client.ChangeDomainLicenseType(s.Context(), changeDomainLicenseTypeRequest)
 	if err != nil {
 		return err
 	}
@@ -715,7 +714,7 @@ func (s *IdentityDomainResourceCrud) activate() error {
 
 	activateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ActivateDomain(context.Background(), activateDomainRequest)
+	response, err := s.Client.ActivateDomain(s.Context(), activateDomainRequest)
 	if err != nil {
 		return err
 	}
@@ -732,7 +731,7 @@ func (s *IdentityDomainResourceCrud) deActivate() error {
 
 	deactivateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.DeactivateDomain(context.Background(), deactivateDomainRequest)
+	response, err := s.Client.DeactivateDomain(s.Context(), deactivateDomainRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -130,7 +128,7 @@ func (s *IdentityDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListDomains(context.Background(), request)
+	response, err := s.Client.ListDomains(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -139,7 +137,7 @@ func (s *IdentityDomainsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDomains(context.Background(), request)
+		listResponse, err := s.Client.ListDomains(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
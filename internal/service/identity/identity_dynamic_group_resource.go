@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -184,7 +183,7 @@ func (s *IdentityDynamicGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDynamicGroup(context.Background(), request)
+	response, err := s.Client.CreateDynamicGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +200,7 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDynamicGroup(context.Background(), request)
+	response, err := s.Client.GetDynamicGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDynamicGroup(context.Background(), request)
+	response, err := s.Client.UpdateDynamicGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +256,7 @@ func (s *IdentityDynamicGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDynamicGroup(context.Background(), request)
+	_, err := s.Client.DeleteDynamicGroup(s.Context(), request)
 	return err
 }
 
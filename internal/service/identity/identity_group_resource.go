@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -200,7 +199,7 @@ func (s *IdentityGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateGroup(context.Background(), request)
+	response, err := s.Client.CreateGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +216,7 @@ func (s *IdentityGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +250,7 @@ func (s *IdentityGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateGroup(context.Background(), request)
+	response, err := s.Client.UpdateGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +267,7 @@ func (s *IdentityGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteGroup(context.Background(), request)
+	_, err := s.Client.DeleteGroup(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -77,7 +76,7 @@ func (s *IdentityGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListGroups(context.Background(), request)
+	response, err := s.Client.ListGroups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +85,7 @@ func (s *IdentityGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGroups(context.Background(), request)
+		listResponse, err := s.Client.ListGroups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
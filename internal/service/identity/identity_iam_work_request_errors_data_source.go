@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -79,7 +77,7 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIamWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequestErrors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +86,7 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequestErrors(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequestErrors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
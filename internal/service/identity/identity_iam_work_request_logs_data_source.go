@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -75,7 +73,7 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIamWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequestLogs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +82,7 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequestLogs(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequestLogs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
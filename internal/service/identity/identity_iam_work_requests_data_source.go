@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -137,7 +135,7 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIamWorkRequests(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequests(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +144,7 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequests(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequests(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -112,7 +110,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+	response, err := s.Client.ListIdentityProviderGroups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +119,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+		listResponse, err := s.Client.ListIdentityProviderGroups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -192,7 +191,7 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdentityProvider(context.Background(), request)
+	response, err := s.Client.CreateIdentityProvider(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +208,7 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdentityProvider(context.Background(), request)
+	response, err := s.Client.GetIdentityProvider(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +226,7 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdentityProvider(context.Background(), request)
+	response, err := s.Client.UpdateIdentityProvider(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +243,7 @@ func (s *IdentityIdentityProviderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdentityProvider(context.Background(), request)
+	_, err := s.Client.DeleteIdentityProvider(s.Context(), request)
 	return err
 }
 
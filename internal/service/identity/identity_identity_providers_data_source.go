@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"log"
 	"strconv"
 
@@ -86,7 +85,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIdentityProviders(context.Background(), request)
+	response, err := s.Client.ListIdentityProviders(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +94,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviders(context.Background(), request)
+		listResponse, err := s.Client.ListIdentityProviders(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
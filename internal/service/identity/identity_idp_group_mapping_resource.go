@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -157,7 +156,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.CreateIdpGroupMapping(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -187,7 +186,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.GetIdpGroupMapping(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -219,7 +218,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.UpdateIdpGroupMapping(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +240,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdpGroupMapping(context.Background(), request)
+	_, err := s.Client.DeleteIdpGroupMapping(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -60,7 +59,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+	response, err := s.Client.ListIdpGroupMappings(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -69,7 +68,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+		listResponse, err := s.Client.ListIdpGroupMappings(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
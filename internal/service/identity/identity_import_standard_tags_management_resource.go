@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -94,7 +93,7 @@ func (s *IdentityImportStandardTagsManagementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ImportStandardTags(context.Background(), request)
+	response, err := s.Client.ImportStandardTags(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +164,7 @@ func importStandardTagsManagementWaitForWorkRequest(wId *string, entityType stri
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetTaggingWorkRequest(context.Background(),
+			response, err = client.GetTaggingWorkRequest(tfresource.GetStopContext(),
 				oci_identity.GetTaggingWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -194,7 +193,7 @@ func importStandardTagsManagementWaitForWorkRequest(wId *string, entityType stri
 }
 
 func getErrorFromIdentityImportStandardTagsManagementWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_identity.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListTaggingWorkRequestErrors(context.Background(),
+	response, err := client.ListTaggingWorkRequestErrors(tfresource.GetStopContext(),
 		oci_identity.ListTaggingWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
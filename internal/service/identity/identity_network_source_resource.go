@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -257,7 +256,7 @@ func (s *IdentityNetworkSourceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateNetworkSource(context.Background(), request)
+	response, err := s.Client.CreateNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -274,7 +273,7 @@ func (s *IdentityNetworkSourceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetNetworkSource(context.Background(), request)
+	response, err := s.Client.GetNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -351,7 +350,7 @@ func (s *IdentityNetworkSourceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateNetworkSource(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -368,7 +367,7 @@ func (s *IdentityNetworkSourceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteNetworkSource(context.Background(), request)
+	_, err := s.Client.DeleteNetworkSource(s.Context(), request)
 	return err
 }
 
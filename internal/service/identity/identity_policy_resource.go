@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"strconv"
@@ -238,7 +237,7 @@ func (s *IdentityPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreatePolicy(context.Background(), request)
+	response, err := s.Client.CreatePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +261,7 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetPolicy(context.Background(), request)
+	response, err := s.Client.GetPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -322,7 +321,7 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdatePolicy(context.Background(), request)
+	response, err := s.Client.UpdatePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -346,7 +345,7 @@ func (s *IdentityPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeletePolicy(context.Background(), request)
+	_, err := s.Client.DeletePolicy(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -66,7 +64,7 @@ func (s *IdentityRegionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListRegions(context.Background())
+	response, err := s.Client.ListRegions(tfresource.GetResourceDataContext(s.D))
 	if err != nil {
 		return err
 	}
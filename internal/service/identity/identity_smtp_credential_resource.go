@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -161,7 +160,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSmtpCredential(context.Background(), request)
+	response, err := s.Client.CreateSmtpCredential(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -199,7 +198,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSmtpCredentials(context.Background(), request)
+	response, err := s.Client.ListSmtpCredentials(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +232,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSmtpCredential(context.Background(), request)
+	response, err := s.Client.UpdateSmtpCredential(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +254,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSmtpCredential(context.Background(), request)
+	_, err := s.Client.DeleteSmtpCredential(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -157,7 +156,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSwiftPassword(context.Background(), request)
+	response, err := s.Client.CreateSwiftPassword(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +183,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -218,7 +217,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSwiftPassword(context.Background(), request)
+	response, err := s.Client.UpdateSwiftPassword(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSwiftPassword(context.Background(), request)
+	_, err := s.Client.DeleteSwiftPassword(s.Context(), request)
 	return err
 }
 
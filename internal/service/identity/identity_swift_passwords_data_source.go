@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -60,7 +59,7 @@ func (s *IdentitySwiftPasswordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -157,7 +156,7 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateTagDefault(context.Background(), request)
+	response, err := s.Client.CreateTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +183,7 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +210,7 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagDefault(context.Background(), request)
+	response, err := s.Client.UpdateTagDefault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +235,7 @@ func (s *IdentityTagDefaultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagDefault(context.Background(), request)
+	_, err := s.Client.DeleteTagDefault(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -85,7 +83,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListTagDefaults(context.Background(), request)
+	response, err := s.Client.ListTagDefaults(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTagDefaults(context.Background(), request)
+		listResponse, err := s.Client.ListTagDefaults(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -184,7 +182,7 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := s.Context()
 	response, err := s.Client.CreateTagNamespace(contextToUse, request)
 	if err == nil {
 		s.Res = &response.TagNamespace
@@ -236,7 +234,7 @@ func (s *IdentityTagNamespaceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagNamespace(context.Background(), request)
+	response, err := s.Client.GetTagNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -284,7 +282,7 @@ func (s *IdentityTagNamespaceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagNamespace(context.Background(), request)
+	response, err := s.Client.UpdateTagNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +299,7 @@ func (s *IdentityTagNamespaceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagNamespace(context.Background(), request)
+	_, err := s.Client.DeleteTagNamespace(s.Context(), request)
 	return err
 }
 
@@ -348,7 +346,7 @@ func (s *IdentityTagNamespaceResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.ChangeTagNamespaceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTagNamespaceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
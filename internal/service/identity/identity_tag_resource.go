@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -244,7 +243,7 @@ func (s *IdentityTagResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := s.Context()
 	response, err := s.Client.CreateTag(contextToUse, request)
 	if err == nil {
 		s.Res = &response.Tag
@@ -310,7 +309,7 @@ func (s *IdentityTagResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTag(context.Background(), request)
+	response, err := s.Client.GetTag(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -396,7 +395,7 @@ func (s *IdentityTagResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTag(context.Background(), request)
+	response, err := s.Client.UpdateTag(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -430,7 +429,7 @@ func (s *IdentityTagResourceCrud) Delete() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
-	response, err := s.Client.DeleteTag(context.Background(), request)
+	response, err := s.Client.DeleteTag(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -461,7 +460,7 @@ func IdentityTaggingWaitForWorkRequest(workRequestId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetTaggingWorkRequest(context.Background(), oci_identity.GetTaggingWorkRequestRequest{
+			response, err = client.GetTaggingWorkRequest(tfresource.GetStopContext(), oci_identity.GetTaggingWorkRequestRequest{
 				WorkRequestId: workRequestId,
 				RequestMetadata: oci_common.RequestMetadata{
 					RetryPolicy: retryPolicy,
@@ -499,7 +498,7 @@ func IdentityTaggingWaitForWorkRequest(workRequestId *string, entityType string,
 }
 
 func getIdentityTaggingWorkRequestErrors(client *oci_identity.IdentityClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_identity.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListTaggingWorkRequestErrors(context.Background(), oci_identity.ListTaggingWorkRequestErrorsRequest{
+	response, err := client.ListTaggingWorkRequestErrors(tfresource.GetStopContext(), oci_identity.ListTaggingWorkRequestErrorsRequest{
 		WorkRequestId: workRequestId,
 		RequestMetadata: oci_common.RequestMetadata{
 			RetryPolicy: retryPolicy,
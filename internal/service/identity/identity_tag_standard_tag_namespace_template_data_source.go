@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -111,7 +109,7 @@ func (s *IdentityTagStandardTagNamespaceTemplateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetStandardTagTemplate(context.Background(), request)
+	response, err := s.Client.GetStandardTagTemplate(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package identity
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v55/identity"
 
@@ -67,7 +65,7 @@ func (s *IdentityTagsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListTags(context.Background(), request)
+	response, err := s.Client.ListTags(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -76,7 +74,7 @@ func (s *IdentityTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTags(context.Background(), request)
+		listResponse, err := s.Client.ListTags(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
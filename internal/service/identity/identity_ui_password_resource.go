@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -113,7 +112,7 @@ func (s *IdentityUiPasswordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateOrResetUIPassword(context.Background(), request)
+	response, err := s.Client.CreateOrResetUIPassword(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -161,7 +160,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Create() error {
 		userCapabilityRequest.CanUseSmtpCredentials = &canUseSmtpCredentials
 	}
 
-	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(context.Background(), userCapabilityRequest)
+	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(s.Context(), userCapabilityRequest)
 	if err != nil {
 		return err
 	}
@@ -182,7 +181,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *IdentityUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
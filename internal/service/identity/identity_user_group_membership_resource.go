@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -138,7 +137,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.AddUserToGroup(context.Background(), request)
+	response, err := s.Client.AddUserToGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -155,7 +154,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUserGroupMembership(context.Background(), request)
+	response, err := s.Client.GetUserGroupMembership(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +171,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.RemoveUserFromGroup(context.Background(), request)
+	_, err := s.Client.RemoveUserFromGroup(s.Context(), request)
 	return err
 }
 
@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -78,7 +77,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+	response, err := s.Client.ListUserGroupMemberships(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +86,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+		listResponse, err := s.Client.ListUserGroupMemberships(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
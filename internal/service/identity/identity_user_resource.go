@@ -4,7 +4,6 @@
 package identity
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -279,7 +278,7 @@ func (s *IdentityUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateUser(context.Background(), request)
+	response, err := s.Client.CreateUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -296,7 +295,7 @@ func (s *IdentityUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -335,7 +334,7 @@ func (s *IdentityUserResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateUser(context.Background(), request)
+	response, err := s.Client.UpdateUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +351,7 @@ func (s *IdentityUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteUser(context.Background(), request)
+	_, err := s.Client.DeleteUser(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package identity_data_plane
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -89,7 +87,7 @@ func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_data_plane")
 
-	response, err := s.Client.GenerateScopedAccessToken(context.Background(), request)
+	response, err := s.Client.GenerateScopedAccessToken(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package integration
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *IntegrationIntegrationInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "integration")
 
-	response, err := s.Client.GetIntegrationInstance(context.Background(), request)
+	response, err := s.Client.GetIntegrationInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
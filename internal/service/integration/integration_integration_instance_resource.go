@@ -5,7 +5,6 @@ package integration
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -487,7 +486,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.CreateIntegrationInstance(context.Background(), request)
+	response, err := s.Client.CreateIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -553,7 +552,7 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_integration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -589,7 +588,7 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 }
 
 func getErrorFromIntegrationIntegrationInstanceWorkRequest(client *oci_integration.IntegrationInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_integration.ListWorkRequestErrorsRequest{
 			CompartmentId: compartmentId,
 			WorkRequestId: workId,
@@ -620,7 +619,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.GetIntegrationInstance(context.Background(), request)
+	response, err := s.Client.GetIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -716,7 +715,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.UpdateIntegrationInstance(context.Background(), request)
+	response, err := s.Client.UpdateIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -733,7 +732,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.DeleteIntegrationInstance(context.Background(), request)
+	response, err := s.Client.DeleteIntegrationInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1044,7 +1043,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.ChangeIntegrationInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeIntegrationInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -1068,7 +1067,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance()
 	request.IntegrationInstanceId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StartIntegrationInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StartIntegrationInstance(s.Context(), request); err != nil {
 		return err
 	}
 	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
@@ -1091,7 +1090,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance()
 	request.IntegrationInstanceId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StopIntegrationInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StopIntegrationInstance(s.Context(), request); err != nil {
 		return err
 	}
 	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
@@ -4,8 +4,6 @@
 package jms
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v55/jms"
 
@@ -50,7 +48,7 @@ func (s *JmsFleetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.GetFleet(context.Background(), request)
+	response, err := s.Client.GetFleet(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package jms
 
 import (
-	"context"
 	"log"
 	"strings"
 	"time"
@@ -195,7 +194,7 @@ func (s *JmsFleetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
-	response, err := s.Client.CreateFleet(context.Background(), request)
+	response, err := s.Client.CreateFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +261,7 @@ func fleetWaitForWorkRequest(wId *string, entityType string, action oci_jms.Acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_jms.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -303,7 +302,7 @@ func (s *JmsFleetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
-	response, err := s.Client.GetFleet(context.Background(), request)
+	response, err := s.Client.GetFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -351,7 +350,7 @@ func (s *JmsFleetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
-	response, err := s.Client.UpdateFleet(context.Background(), request)
+	response, err := s.Client.UpdateFleet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -368,7 +367,7 @@ func (s *JmsFleetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
-	_, err := s.Client.DeleteFleet(context.Background(), request)
+	_, err := s.Client.DeleteFleet(s.Context(), request)
 	return err
 }
 
@@ -485,7 +484,7 @@ func (s *JmsFleetResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
-	_, err := s.Client.ChangeFleetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeFleetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package jms
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -213,7 +212,7 @@ func (s *JmsListJreUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListJreUsage(context.Background(), request)
+	response, err := s.Client.ListJreUsage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package jms
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -100,7 +99,7 @@ func (s *JmsSummarizeResourceInventoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.SummarizeResourceInventory(context.Background(), request)
+	response, err := s.Client.SummarizeResourceInventory(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
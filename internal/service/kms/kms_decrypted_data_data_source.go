@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -95,7 +93,7 @@ func (s *DecryptedDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.Decrypt(context.Background(), request)
+	response, err := s.Client.Decrypt(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -91,7 +89,7 @@ func (s *EncryptedDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -156,7 +154,7 @@ func (s *KmsEncryptedDataResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(s.Context(), request)
 	if err != nil {
 		return err
 	}
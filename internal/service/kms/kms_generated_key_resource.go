@@ -4,7 +4,6 @@
 package kms
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -173,7 +172,7 @@ func (s *KmsGeneratedKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GenerateDataEncryptionKey(context.Background(), request)
+	response, err := s.Client.GenerateDataEncryptionKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -65,7 +63,7 @@ func (s *KmsKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package kms
 
 import (
 	"bytes"
-	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -448,7 +447,7 @@ func (s *KmsKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKey(context.Background(), request)
+	response, err := s.Client.CreateKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -465,7 +464,7 @@ func (s *KmsKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -523,7 +522,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 	request.KeyId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateKey(context.Background(), request)
+	response, err := s.Client.UpdateKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -540,7 +539,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 			activationRequest.KeyId = &tmpId
 
 			activationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
-			activationResponse, err := s.Client.EnableKey(context.Background(), activationRequest)
+			activationResponse, err := s.Client.EnableKey(s.Context(), activationRequest)
 			if err != nil {
 				return err
 			}
@@ -551,7 +550,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 			deactivationRequest.KeyId = &tmpId
 
 			deactivationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
-			deactivationResponse, err := s.Client.DisableKey(context.Background(), deactivationRequest)
+			deactivationResponse, err := s.Client.DisableKey(s.Context(), deactivationRequest)
 			if err != nil {
 				return err
 			}
@@ -577,7 +576,7 @@ func (s *KmsKeyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.KeyId = &tmp
 
-	_, err := s.Client.ScheduleKeyDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleKeyDeletion(s.Context(), request)
 	return err
 }
 
@@ -695,7 +694,7 @@ func (s *KmsKeyResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ChangeKeyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeKeyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -723,7 +722,7 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromObjectStore() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreKeyFromObjectStore(context.Background(), request)
+	response, err := s.Client.RestoreKeyFromObjectStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -757,7 +756,7 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromFile() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreKeyFromFile(context.Background(), request)
+	response, err := s.Client.RestoreKeyFromFile(s.Context(), request)
 	if err != nil {
 		return err
 	}
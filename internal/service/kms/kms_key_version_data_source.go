@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -73,7 +71,7 @@ func (s *KmsKeyVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
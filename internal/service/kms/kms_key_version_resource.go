@@ -4,7 +4,6 @@
 package kms
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -238,7 +237,7 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKeyVersion(context.Background(), request)
+	response, err := s.Client.CreateKeyVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +261,7 @@ func (s *KmsKeyVersionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -293,7 +292,7 @@ func (s *KmsKeyVersionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, error := s.Client.ScheduleKeyVersionDeletion(context.Background(), request)
+	_, error := s.Client.ScheduleKeyVersionDeletion(s.Context(), request)
 	return error
 }
 
@@ -4,7 +4,6 @@
 package kms
 
 import (
-	"context"
 	"regexp"
 
 	"fmt"
@@ -71,7 +70,7 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListKeyVersions(context.Background(), request)
+	response, err := s.Client.ListKeyVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +79,7 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeyVersions(context.Background(), request)
+		listResponse, err := s.Client.ListKeyVersions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -106,7 +104,7 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListKeys(context.Background(), request)
+	response, err := s.Client.ListKeys(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +113,7 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeys(context.Background(), request)
+		listResponse, err := s.Client.ListKeys(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package kms
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -90,7 +89,7 @@ func (s *KmsReplicationStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetReplicationStatus(context.Background(), request)
+	response, err := s.Client.GetReplicationStatus(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package kms
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -133,7 +132,7 @@ func (s *KmsSignResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Sign(context.Background(), request)
+	response, err := s.Client.Sign(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v55/keymanagement"
 
@@ -50,7 +48,7 @@ func (s *KmsVaultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v55/keymanagement"
 
@@ -83,7 +81,7 @@ func (s *KmsVaultReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListVaultReplicas(context.Background(), request)
+	response, err := s.Client.ListVaultReplicas(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
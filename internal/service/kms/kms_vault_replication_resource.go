@@ -1,7 +1,6 @@
 package kms
 
 import (
-	"context"
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -109,7 +108,7 @@ func (s *KmsVaultReplicaResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.ListVaultReplicas(context.Background(), request)
+	response, err := s.Client.ListVaultReplicas(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +173,7 @@ func (s *KmsVaultReplicaResourceCrud) createVaultReplicaHelper(vaultId string, r
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.CreateVaultReplica(context.Background(), request)
+	_, err := s.Client.CreateVaultReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +195,7 @@ func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, r
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.DeleteVaultReplica(context.Background(), request)
+	_, err := s.Client.DeleteVaultReplica(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package kms
 
 import (
 	"bytes"
-	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -312,7 +311,7 @@ func (s *KmsVaultResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateVault(context.Background(), request)
+	response, err := s.Client.CreateVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -329,7 +328,7 @@ func (s *KmsVaultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +384,7 @@ func (s *KmsVaultResourceCrud) UpdateVaultDetails() error {
 	request.VaultId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateVault(context.Background(), request)
+	response, err := s.Client.UpdateVault(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -410,7 +409,7 @@ func (s *KmsVaultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ScheduleVaultDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleVaultDeletion(s.Context(), request)
 	return err
 }
 
@@ -487,7 +486,7 @@ func (s *KmsVaultResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ChangeVaultCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVaultCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -520,7 +519,7 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromObjectStore() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreVaultFromObjectStore(context.Background(), request)
+	response, err := s.Client.RestoreVaultFromObjectStore(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -560,7 +559,7 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromFile() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreVaultFromFile(context.Background(), request)
+	response, err := s.Client.RestoreVaultFromFile(s.Context(), request)
 	if err != nil {
 		return err
 	}
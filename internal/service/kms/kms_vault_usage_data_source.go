@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -70,7 +68,7 @@ func (s *KmsVaultUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetVaultUsage(context.Background(), request)
+	response, err := s.Client.GetVaultUsage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
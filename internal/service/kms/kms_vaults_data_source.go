@@ -4,8 +4,6 @@
 package kms
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v55/keymanagement"
 
@@ -59,7 +57,7 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListVaults(context.Background(), request)
+	response, err := s.Client.ListVaults(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +66,7 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVaults(context.Background(), request)
+		listResponse, err := s.Client.ListVaults(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package kms
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -142,7 +141,7 @@ func (s *KmsVerifyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Verify(context.Background(), request)
+	response, err := s.Client.Verify(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package limits
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -122,7 +120,7 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListLimitDefinitions(context.Background(), request)
+	response, err := s.Client.ListLimitDefinitions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +129,7 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLimitDefinitions(context.Background(), request)
+		listResponse, err := s.Client.ListLimitDefinitions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
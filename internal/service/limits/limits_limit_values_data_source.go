@@ -4,7 +4,6 @@
 package limits
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -120,7 +119,7 @@ func (s *LimitsLimitValuesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListLimitValues(context.Background(), request)
+	response, err := s.Client.ListLimitValues(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +128,7 @@ func (s *LimitsLimitValuesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLimitValues(context.Background(), request)
+		listResponse, err := s.Client.ListLimitValues(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
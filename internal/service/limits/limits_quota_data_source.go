@@ -4,8 +4,6 @@
 package limits
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +49,7 @@ func (s *LimitsQuotaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.GetQuota(context.Background(), request)
+	response, err := s.Client.GetQuota(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package limits
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
@@ -186,7 +184,7 @@ func (s *LimitsQuotaResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.CreateQuota(context.Background(), request)
+	response, err := s.Client.CreateQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +201,7 @@ func (s *LimitsQuotaResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.GetQuota(context.Background(), request)
+	response, err := s.Client.GetQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -250,7 +248,7 @@ func (s *LimitsQuotaResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.UpdateQuota(context.Background(), request)
+	response, err := s.Client.UpdateQuota(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +265,7 @@ func (s *LimitsQuotaResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	_, err := s.Client.DeleteQuota(context.Background(), request)
+	_, err := s.Client.DeleteQuota(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package limits
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -77,7 +75,7 @@ func (s *LimitsQuotasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListQuotas(context.Background(), request)
+	response, err := s.Client.ListQuotas(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *LimitsQuotasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQuotas(context.Background(), request)
+		listResponse, err := s.Client.ListQuotas(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
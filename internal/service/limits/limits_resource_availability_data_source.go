@@ -4,7 +4,6 @@
 package limits
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -104,7 +103,7 @@ func (s *LimitsResourceAvailabilityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.GetResourceAvailability(context.Background(), request)
+	response, err := s.Client.GetResourceAvailability(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package limits
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -76,7 +74,7 @@ func (s *LimitsServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListServices(context.Background(), request)
+	response, err := s.Client.ListServices(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *LimitsServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServices(context.Background(), request)
+		listResponse, err := s.Client.ListServices(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
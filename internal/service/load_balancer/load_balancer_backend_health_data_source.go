@@ -4,8 +4,6 @@
 package load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -105,7 +103,7 @@ func (s *LoadBalancerBackendHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetBackendHealth(context.Background(), request)
+	response, err := s.Client.GetBackendHealth(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -227,7 +226,7 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateBackend(context.Background(), request)
+	response, err := s.Client.CreateBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +235,7 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -284,7 +283,7 @@ func (s *LoadBalancerBackendResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetBackend(context.Background(), request)
+	response, err := s.Client.GetBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -333,7 +332,7 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateBackend(context.Background(), request)
+	response, err := s.Client.UpdateBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,7 +341,7 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -375,7 +374,7 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteBackend(context.Background(), request)
+	response, err := s.Client.DeleteBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -384,7 +383,7 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
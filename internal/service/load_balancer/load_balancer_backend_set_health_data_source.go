@@ -4,8 +4,6 @@
 package load_balancer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v55/loadbalancer"
 
@@ -92,7 +90,7 @@ func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
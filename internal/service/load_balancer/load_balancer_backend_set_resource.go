@@ -5,7 +5,6 @@ package load_balancer
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -458,7 +457,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateBackendSet(context.Background(), request)
+	response, err := s.Client.CreateBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -467,7 +466,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -511,7 +510,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetBackendSet(context.Background(), request)
+	response, err := s.Client.GetBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -625,7 +624,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateBackendSet(context.Background(), request)
+	response, err := s.Client.UpdateBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -634,7 +633,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -662,7 +661,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteBackendSet(context.Background(), request)
+	response, err := s.Client.DeleteBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -671,7 +670,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -187,7 +186,7 @@ func (s *LoadBalancerCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +195,7 @@ func (s *LoadBalancerCertificateResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -237,7 +236,7 @@ func (s *LoadBalancerCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +266,7 @@ func (s *LoadBalancerCertificateResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteCertificate(context.Background(), request)
+	response, err := s.Client.DeleteCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -276,7 +275,7 @@ func (s *LoadBalancerCertificateResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -158,7 +157,7 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateHostname(context.Background(), request)
+	response, err := s.Client.CreateHostname(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +166,7 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -211,7 +210,7 @@ func (s *LoadBalancerHostnameResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetHostname(context.Background(), request)
+	response, err := s.Client.GetHostname(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateHostname(context.Background(), request)
+	response, err := s.Client.UpdateHostname(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -249,7 +248,7 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -277,7 +276,7 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteHostname(context.Background(), request)
+	response, err := s.Client.DeleteHostname(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +285,7 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
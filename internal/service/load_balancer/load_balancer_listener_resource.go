@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -349,7 +348,7 @@ func (s *LoadBalancerListenerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateListener(context.Background(), request)
+	response, err := s.Client.CreateListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +357,7 @@ func (s *LoadBalancerListenerResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -402,7 +401,7 @@ func (s *LoadBalancerListenerResourceCrud) GetListener(loadBalancerID, name stri
 	request.LoadBalancerId = &loadBalancerID
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetLoadBalancer(s.Context(), request)
 	if err != nil {
 		return nil, err
 	}
@@ -505,7 +504,7 @@ func (s *LoadBalancerListenerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateListener(context.Background(), request)
+	response, err := s.Client.UpdateListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -514,7 +513,7 @@ func (s *LoadBalancerListenerResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -542,7 +541,7 @@ func (s *LoadBalancerListenerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteListener(context.Background(), request)
+	response, err := s.Client.DeleteListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -551,7 +550,7 @@ func (s *LoadBalancerListenerResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package load_balancer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v55/loadbalancer"
 
@@ -207,7 +205,7 @@ func (s *LoadBalancerListenerRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListListenerRules(context.Background(), request)
+	response, err := s.Client.ListListenerRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -375,7 +374,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateLoadBalancer(context.Background(), request)
+	response, err := s.Client.CreateLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -384,7 +383,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -416,7 +415,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -485,7 +484,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateLoadBalancer(context.Background(), request)
+	response, err := s.Client.UpdateLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -494,7 +493,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -515,7 +514,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteLoadBalancer(context.Background(), request)
+	response, err := s.Client.DeleteLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -524,7 +523,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -695,7 +694,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	_, err := s.Client.ChangeLoadBalancerCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLoadBalancerCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -728,7 +727,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() err
 
 	updateNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateNetworkSecurityGroups(context.Background(), updateNsgIdsRequest)
+	response, err := s.Client.UpdateNetworkSecurityGroups(s.Context(), updateNsgIdsRequest)
 	if err != nil {
 		return err
 	}
@@ -737,7 +736,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() err
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -771,7 +770,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) er
 		}
 	}
 
-	response, err := s.Client.UpdateLoadBalancerShape(context.Background(), changeShapeRequest)
+	response, err := s.Client.UpdateLoadBalancerShape(s.Context(), changeShapeRequest)
 	if err != nil {
 		return err
 	}
@@ -779,7 +778,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) er
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -222,7 +221,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateRoutingPolicy(context.Background(), request)
+	response, err := s.Client.CreateRoutingPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +230,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -275,7 +274,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetRoutingPolicy(context.Background(), request)
+	response, err := s.Client.GetRoutingPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -320,7 +319,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateRoutingPolicy(context.Background(), request)
+	response, err := s.Client.UpdateRoutingPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -329,7 +328,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -357,7 +356,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteRoutingPolicy(context.Background(), request)
+	response, err := s.Client.DeleteRoutingPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -366,7 +365,7 @@ func (s *LoadBalancerLoadBalancerRoutingPolicyResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
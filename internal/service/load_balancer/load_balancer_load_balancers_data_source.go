@@ -4,8 +4,6 @@
 package load_balancer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v55/loadbalancer"
 
@@ -85,7 +83,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListLoadBalancers(context.Background(), request)
+	response, err := s.Client.ListLoadBalancers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLoadBalancers(context.Background(), request)
+		listResponse, err := s.Client.ListLoadBalancers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -206,7 +205,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreatePathRouteSet(context.Background(), request)
+	response, err := s.Client.CreatePathRouteSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +214,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -259,7 +258,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetPathRouteSet(context.Background(), request)
+	response, err := s.Client.GetPathRouteSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -300,7 +299,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdatePathRouteSet(context.Background(), request)
+	response, err := s.Client.UpdatePathRouteSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -309,7 +308,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -337,7 +336,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeletePathRouteSet(context.Background(), request)
+	response, err := s.Client.DeletePathRouteSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -346,7 +345,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
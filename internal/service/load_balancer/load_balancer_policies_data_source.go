@@ -4,8 +4,6 @@
 package load_balancer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v55/loadbalancer"
 
@@ -71,7 +69,7 @@ func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListPolicies(context.Background(), request)
+	response, err := s.Client.ListPolicies(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
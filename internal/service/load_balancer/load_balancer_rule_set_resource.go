@@ -5,7 +5,6 @@ package load_balancer
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -332,7 +331,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateRuleSet(context.Background(), request)
+	response, err := s.Client.CreateRuleSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -341,7 +340,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -385,7 +384,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetRuleSet(context.Background(), request)
+	response, err := s.Client.GetRuleSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -427,7 +426,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateRuleSet(context.Background(), request)
+	response, err := s.Client.UpdateRuleSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -436,7 +435,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -464,7 +463,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteRuleSet(context.Background(), request)
+	response, err := s.Client.DeleteRuleSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -473,7 +472,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -162,7 +161,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.CreateSSLCipherSuite(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -171,7 +170,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -215,7 +214,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.GetSSLCipherSuite(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +238,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.DeleteSSLCipherSuite(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -248,7 +247,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -114,7 +112,7 @@ func (s *LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
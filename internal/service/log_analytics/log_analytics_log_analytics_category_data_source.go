@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -79,7 +77,7 @@ func (s *LogAnalyticsLogAnalyticsCategoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetCategory(context.Background(), request)
+	response, err := s.Client.GetCategory(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -176,7 +174,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsEntities(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsEntities(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +183,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsEntities(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsEntities(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
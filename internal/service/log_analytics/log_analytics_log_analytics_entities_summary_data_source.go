@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -75,7 +73,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntitiesSummary(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntitiesSummary(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
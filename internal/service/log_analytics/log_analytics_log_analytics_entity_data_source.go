@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *LogAnalyticsLogAnalyticsEntityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntity(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
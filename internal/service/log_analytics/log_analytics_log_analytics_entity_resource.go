@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -266,7 +265,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -296,7 +295,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -363,7 +362,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsEntity(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +384,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsEntity(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsEntity(s.Context(), request)
 	return err
 }
 
@@ -574,7 +573,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsEntityCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsEntityCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -214,7 +212,7 @@ func (s *LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsEntityTopology(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsEntityTopology(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package log_analytics
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"io/ioutil"
 
@@ -216,7 +215,7 @@ func (s *LogAnalyticsLogAnalyticsImportCustomContentResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.ImportCustomContent(context.Background(), request)
+	response, err := s.Client.ImportCustomContent(s.Context(), request)
 	if err != nil {
 		return err
 	}
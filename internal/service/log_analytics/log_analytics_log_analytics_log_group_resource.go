@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -160,7 +159,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +189,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -243,7 +242,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +264,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsLogGroup(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsLogGroup(s.Context(), request)
 	return err
 }
 
@@ -380,7 +379,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsLogGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsLogGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
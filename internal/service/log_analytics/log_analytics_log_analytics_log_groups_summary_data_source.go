@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -67,7 +65,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroupsSummary(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroupsSummary(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
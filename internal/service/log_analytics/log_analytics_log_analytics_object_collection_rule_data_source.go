@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
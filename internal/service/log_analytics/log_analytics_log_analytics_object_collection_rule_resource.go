@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -351,7 +350,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Create() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,7 +385,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -484,7 +483,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Update() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsObjectCollectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -506,7 +505,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Delete() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsObjectCollectionRule(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsObjectCollectionRule(s.Context(), request)
 	return err
 }
 
@@ -687,7 +686,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) updateCompart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsObjectCollectionRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsObjectCollectionRuleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
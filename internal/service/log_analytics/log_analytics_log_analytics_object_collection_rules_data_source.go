@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -94,7 +92,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsObjectCollectionRules(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsObjectCollectionRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +101,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsObjectCollectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsObjectCollectionRules(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -5,7 +5,6 @@ package log_analytics
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"net/url"
 
@@ -217,7 +216,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) updatePrefer
 	request.NamespaceName = &namespace
 	request.UpdatePreferencesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.UpdatePreferences(context.Background(), request)
+	_, err := s.Client.UpdatePreferences(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -230,7 +229,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) removePrefer
 	request.NamespaceName = &namespace
 	request.RemovePreferencesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.RemovePreferences(context.Background(), request)
+	_, err := s.Client.RemovePreferences(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -143,7 +141,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListResourceCategories(context.Background(), request)
+	response, err := s.Client.ListResourceCategories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
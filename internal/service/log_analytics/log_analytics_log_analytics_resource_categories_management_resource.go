@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 
@@ -232,7 +231,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) updat
 	request.NamespaceName = &namespace
 	request.UpdateResourceCategoriesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.UpdateResourceCategories(context.Background(), request)
+	_, err := s.Client.UpdateResourceCategories(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -245,7 +244,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) remov
 	request.NamespaceName = &namespace
 	request.RemoveResourceCategoriesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.RemoveResourceCategories(context.Background(), request)
+	_, err := s.Client.RemoveResourceCategories(s.Context(), request)
 
 	if err != nil {
 		return err
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -70,7 +68,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.GetUnprocessedDataBucket(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -116,7 +114,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Cr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -145,7 +143,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Up
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +170,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) De
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
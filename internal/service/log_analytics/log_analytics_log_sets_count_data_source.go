@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -59,7 +58,7 @@ func (s *LogAnalyticsLogSetsCountDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogSetsCount(context.Background(), request)
+	response, err := s.Client.GetLogSetsCount(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
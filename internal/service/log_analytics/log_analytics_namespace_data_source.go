@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -62,7 +60,7 @@ func (s *LogAnalyticsNamespaceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	response, err := s.Client.GetNamespace(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
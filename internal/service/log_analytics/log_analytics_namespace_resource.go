@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -116,7 +115,7 @@ func (s *LogAnalyticsNamespaceResourceCrud) OnboardNamespace() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	response, err := s.Client.OnboardNamespace(context.Background(), request)
+	response, err := s.Client.OnboardNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -135,7 +134,7 @@ func (s *LogAnalyticsNamespaceResourceCrud) OffboardNamespace() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	response, err := s.Client.OffboardNamespace(context.Background(), request)
+	response, err := s.Client.OffboardNamespace(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +169,7 @@ func (s *LogAnalyticsNamespaceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListNamespaces(context.Background(), request)
+	response, err := s.Client.ListNamespaces(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,7 +227,7 @@ func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_log_analytics.GetWorkRequestRequest{
 					NamespaceName: ns,
 					WorkRequestId: wId,
@@ -288,7 +287,7 @@ func logAnalyticsWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 }
 
 func getErrorFromLogAnalyticsWorkRequest(client *oci_log_analytics.LogAnalyticsClient, wId *string, ns *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_log_analytics.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_log_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			NamespaceName: ns,
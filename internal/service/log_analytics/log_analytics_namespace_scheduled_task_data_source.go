@@ -4,7 +4,6 @@
 package log_analytics
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -65,7 +64,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetScheduledTask(context.Background(), request)
+	response, err := s.Client.GetScheduledTask(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package log_analytics
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -324,7 +323,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateScheduledTask(context.Background(), request)
+	response, err := s.Client.CreateScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -346,7 +345,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetScheduledTask(context.Background(), request)
+	response, err := s.Client.GetScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -424,7 +423,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update() error {
 	request.UpdateScheduledTaskDetails = details
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateScheduledTask(context.Background(), request)
+	response, err := s.Client.UpdateScheduledTask(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err = s.Client.DeleteScheduledTask(context.Background(), request)
+	_, err = s.Client.DeleteScheduledTask(s.Context(), request)
 	return err
 }
 
@@ -862,7 +861,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) updateCompartment(compa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeScheduledTaskCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeScheduledTaskCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package log_analytics
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -87,7 +85,7 @@ func (s *LogAnalyticsNamespacesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListNamespaces(context.Background(), request)
+	response, err := s.Client.ListNamespaces(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
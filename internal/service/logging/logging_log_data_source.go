@@ -4,8 +4,6 @@
 package logging
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *LoggingLogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.GetLog(context.Background(), request)
+	response, err := s.Client.GetLog(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package logging
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *LoggingLogGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.GetLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogGroup(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package logging
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -177,7 +176,7 @@ func (s *LoggingLogGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLogGroup(context.Background(), request)
+	response, err := s.Client.CreateLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -197,7 +196,7 @@ func (s *LoggingLogGroupResourceCrud) getLogGroupFromWorkRequest(workId *string,
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, logGroupId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_logging.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -256,7 +255,7 @@ func logGroupWaitForWorkRequest(wId *string, entityType string, action oci_loggi
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -292,7 +291,7 @@ func logGroupWaitForWorkRequest(wId *string, entityType string, action oci_loggi
 }
 
 func getErrorFromLoggingLogGroupWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -322,7 +321,7 @@ func (s *LoggingLogGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -370,7 +369,7 @@ func (s *LoggingLogGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLogGroup(context.Background(), request)
+	response, err := s.Client.UpdateLogGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +386,7 @@ func (s *LoggingLogGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLogGroup(context.Background(), request)
+	_, err := s.Client.DeleteLogGroup(s.Context(), request)
 	return err
 }
 
@@ -434,7 +433,7 @@ func (s *LoggingLogGroupResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.ChangeLogGroupCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeLogGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
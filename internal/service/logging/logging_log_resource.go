@@ -4,7 +4,6 @@
 package logging
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -281,7 +280,7 @@ func (s *LoggingLogResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLog(context.Background(), request)
+	response, err := s.Client.CreateLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +300,7 @@ func (s *LoggingLogResourceCrud) getLogFromWorkRequest(workId *string, retryPoli
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, logId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_logging.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -360,7 +359,7 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -396,7 +395,7 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 }
 
 func getErrorFromLoggingLogWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -439,7 +438,7 @@ func (s *LoggingLogResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLog(context.Background(), request)
+	response, err := s.Client.GetLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -499,7 +498,7 @@ func (s *LoggingLogResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLog(context.Background(), request)
+	response, err := s.Client.UpdateLog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -521,7 +520,7 @@ func (s *LoggingLogResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLog(context.Background(), request)
+	_, err := s.Client.DeleteLog(s.Context(), request)
 	return err
 }
 
@@ -700,7 +699,7 @@ func (s *LoggingLogResourceCrud) updateLogGroup(oldLogGroupId interface{}, newLo
 
 	updateLogGroupRequest.ChangeLogLogGroupDetails = updateLogGroupDetails
 
-	response, err := s.Client.ChangeLogLogGroup(context.Background(), updateLogGroupRequest)
+	response, err := s.Client.ChangeLogLogGroup(s.Context(), updateLogGroupRequest)
 	if err != nil {
 		return err
 	}
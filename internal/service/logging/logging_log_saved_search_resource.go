@@ -4,8 +4,6 @@
 package logging
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -192,7 +190,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLogSavedSearch(context.Background(), request)
+	response, err := s.Client.CreateLogSavedSearch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +207,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLogSavedSearch(context.Background(), request)
+	response, err := s.Client.GetLogSavedSearch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +260,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLogSavedSearch(context.Background(), request)
+	response, err := s.Client.UpdateLogSavedSearch(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -279,7 +277,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLogSavedSearch(context.Background(), request)
+	_, err := s.Client.DeleteLogSavedSearch(s.Context(), request)
 	return err
 }
 
@@ -372,7 +370,7 @@ func (s *LoggingLogSavedSearchResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.ChangeLogSavedSearchCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogSavedSearchCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
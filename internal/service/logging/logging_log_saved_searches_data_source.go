@@ -4,8 +4,6 @@
 package logging
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -86,7 +84,7 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.ListLogSavedSearches(context.Background(), request)
+	response, err := s.Client.ListLogSavedSearches(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +93,7 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogSavedSearches(context.Background(), request)
+		listResponse, err := s.Client.ListLogSavedSearches(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package logging
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -520,7 +519,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.CreateUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -586,7 +585,7 @@ func unifiedAgentConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -622,7 +621,7 @@ func unifiedAgentConfigurationWaitForWorkRequest(wId *string, entityType string,
 }
 
 func getErrorFromLoggingUnifiedAgentConfigurationWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -652,7 +651,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.GetUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -727,7 +726,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -744,7 +743,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.DeleteUnifiedAgentConfiguration(context.Background(), request)
+	response, err := s.Client.DeleteUnifiedAgentConfiguration(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -2072,7 +2071,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.ChangeUnifiedAgentConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeUnifiedAgentConfigurationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
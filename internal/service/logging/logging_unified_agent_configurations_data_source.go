@@ -4,8 +4,6 @@
 package logging
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -174,7 +172,7 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.ListUnifiedAgentConfigurations(context.Background(), request)
+	response, err := s.Client.ListUnifiedAgentConfigurations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +181,7 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUnifiedAgentConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListUnifiedAgentConfigurations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package management_agent
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -109,7 +108,7 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListAvailabilityHistories(context.Background(), request)
+	response, err := s.Client.ListAvailabilityHistories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +117,7 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAvailabilityHistories(context.Background(), request)
+		listResponse, err := s.Client.ListAvailabilityHistories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
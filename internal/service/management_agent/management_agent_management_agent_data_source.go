@@ -4,8 +4,6 @@
 package management_agent
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v55/managementagent"
 
@@ -50,7 +48,7 @@ func (s *ManagementAgentManagementAgentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.GetManagementAgent(context.Background(), request)
+	response, err := s.Client.GetManagementAgent(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
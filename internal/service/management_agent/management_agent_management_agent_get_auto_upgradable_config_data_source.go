@@ -4,8 +4,6 @@
 package management_agent
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v55/managementagent"
 
@@ -58,7 +56,7 @@ func (s *ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.GetAutoUpgradableConfig(context.Background(), request)
+	response, err := s.Client.GetAutoUpgradableConfig(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
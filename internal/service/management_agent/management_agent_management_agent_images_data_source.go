@@ -4,8 +4,6 @@
 package management_agent
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v55/managementagent"
 
@@ -124,7 +122,7 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentImages(context.Background(), request)
+	response, err := s.Client.ListManagementAgentImages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -133,7 +131,7 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentImages(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentImages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
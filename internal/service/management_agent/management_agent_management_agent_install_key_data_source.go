@@ -4,7 +4,6 @@
 package management_agent
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *ManagementAgentManagementAgentInstallKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.GetManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.GetManagementAgentInstallKey(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package management_agent
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -184,7 +183,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.CreateManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.CreateManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +200,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.GetManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.GetManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -228,7 +227,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.UpdateManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.UpdateManagementAgentInstallKey(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +244,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	_, err := s.Client.DeleteManagementAgentInstallKey(context.Background(), request)
+	_, err := s.Client.DeleteManagementAgentInstallKey(s.Context(), request)
 	return err
 }
 
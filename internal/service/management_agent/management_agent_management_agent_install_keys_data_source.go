@@ -4,7 +4,6 @@
 package management_agent
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -95,7 +94,7 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentInstallKeys(context.Background(), request)
+	response, err := s.Client.ListManagementAgentInstallKeys(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +103,7 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentInstallKeys(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentInstallKeys(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package management_agent
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v55/managementagent"
 
@@ -139,7 +137,7 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentPlugins(context.Background(), request)
+	response, err := s.Client.ListManagementAgentPlugins(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +146,7 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentPlugins(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentPlugins(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package management_agent
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -259,7 +258,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) getManagementAgentFromWorkR
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, managementAgentId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_management_agent.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -318,7 +317,7 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_management_agent.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -354,7 +353,7 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 }
 
 func getErrorFromManagementAgentManagementAgentWorkRequest(client *oci_management_agent.ManagementAgentClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_management_agent.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_management_agent.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -388,7 +387,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Get() error {
 	request.ManagementAgentId = &managedInstanceId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.GetManagementAgent(context.Background(), request)
+	response, err := s.Client.GetManagementAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -427,7 +426,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.UpdateManagementAgent(context.Background(), request)
+	response, err := s.Client.UpdateManagementAgent(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -460,7 +459,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	_, err := s.Client.DeleteManagementAgent(context.Background(), request)
+	_, err := s.Client.DeleteManagementAgent(s.Context(), request)
 	return err
 }
 
@@ -600,7 +599,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) deployPlugin(pluginIds []st
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.DeployPlugins(context.Background(), request)
+	response, err := s.Client.DeployPlugins(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package management_agent
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v55/managementagent"
 
@@ -170,7 +168,7 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgents(context.Background(), request)
+	response, err := s.Client.ListManagementAgents(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -179,7 +177,7 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgents(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgents(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
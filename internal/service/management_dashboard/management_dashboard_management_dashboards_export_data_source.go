@@ -4,7 +4,6 @@
 package management_dashboard
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -60,7 +59,7 @@ func (s *ManagementDashboardManagementDashboardsExportDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_dashboard")
 
-	response, err := s.Client.ExportDashboard(context.Background(), request)
+	response, err := s.Client.ExportDashboard(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package management_dashboard
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -105,7 +104,7 @@ func (s *ManagementDashboardManagementDashboardsImportResourceCrud) Create() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_dashboard")
 
-	_, err := s.Client.ImportDashboard(context.Background(), request)
+	_, err := s.Client.ImportDashboard(s.Context(), request)
 	if err != nil {
 		return err
 	}
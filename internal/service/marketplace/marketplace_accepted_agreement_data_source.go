@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -50,7 +48,7 @@ func (s *MarketplaceAcceptedAgreementDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.GetAcceptedAgreement(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
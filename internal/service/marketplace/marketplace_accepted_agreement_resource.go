@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
@@ -173,7 +171,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.CreateAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.CreateAcceptedAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +188,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.GetAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.GetAcceptedAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -224,7 +222,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.UpdateAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.UpdateAcceptedAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +244,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.DeleteAcceptedAgreement(context.Background(), request)
+	_, err := s.Client.DeleteAcceptedAgreement(s.Context(), request)
 	return err
 }
 
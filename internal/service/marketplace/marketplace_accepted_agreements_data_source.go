@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -95,7 +93,7 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListAcceptedAgreements(context.Background(), request)
+	response, err := s.Client.ListAcceptedAgreements(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +102,7 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAcceptedAgreements(context.Background(), request)
+		listResponse, err := s.Client.ListAcceptedAgreements(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
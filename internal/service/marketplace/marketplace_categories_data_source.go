@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -71,7 +69,7 @@ func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +78,7 @@ func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCategories(context.Background(), request)
+		listResponse, err := s.Client.ListCategories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package marketplace
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -516,7 +515,7 @@ func (s *MarketplaceListingDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetListing(context.Background(), request)
+	response, err := s.Client.GetListing(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
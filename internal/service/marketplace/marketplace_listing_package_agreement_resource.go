@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -119,7 +117,7 @@ func (s *MarketplaceListingPackageAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetAgreement(context.Background(), request)
+	response, err := s.Client.GetAgreement(s.Context(), request)
 	if err != nil {
 		return err
 	}
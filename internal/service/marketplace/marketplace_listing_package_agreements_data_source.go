@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -101,7 +99,7 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListAgreements(context.Background(), request)
+	response, err := s.Client.ListAgreements(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +108,7 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgreements(context.Background(), request)
+		listResponse, err := s.Client.ListAgreements(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
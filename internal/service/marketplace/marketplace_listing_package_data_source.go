@@ -4,7 +4,6 @@
 package marketplace
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -234,7 +233,7 @@ func (s *MarketplaceListingPackageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetPackage(context.Background(), request)
+	response, err := s.Client.GetPackage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
@@ -181,7 +179,7 @@ func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPackages(context.Background(), request)
+	response, err := s.Client.ListPackages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +188,7 @@ func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPackages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
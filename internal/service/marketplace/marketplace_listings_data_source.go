@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -356,7 +354,7 @@ func (s *MarketplaceListingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListListings(context.Background(), request)
+	response, err := s.Client.ListListings(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -365,7 +363,7 @@ func (s *MarketplaceListingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListListings(context.Background(), request)
+		listResponse, err := s.Client.ListListings(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package marketplace
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -158,7 +157,7 @@ func (s *MarketplacePublicationPackageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetPublicationPackage(context.Background(), request)
+	response, err := s.Client.GetPublicationPackage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
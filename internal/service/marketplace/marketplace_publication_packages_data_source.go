@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -105,7 +103,7 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPublicationPackages(context.Background(), request)
+	response, err := s.Client.ListPublicationPackages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +112,7 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicationPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPublicationPackages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -5,7 +5,6 @@ package marketplace
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -410,7 +409,7 @@ func (s *MarketplacePublicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.CreatePublication(context.Background(), request)
+	response, err := s.Client.CreatePublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -427,7 +426,7 @@ func (s *MarketplacePublicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.GetPublication(context.Background(), request)
+	response, err := s.Client.GetPublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -498,7 +497,7 @@ func (s *MarketplacePublicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.UpdatePublication(context.Background(), request)
+	response, err := s.Client.UpdatePublication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -515,7 +514,7 @@ func (s *MarketplacePublicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.DeletePublication(context.Background(), request)
+	_, err := s.Client.DeletePublication(s.Context(), request)
 	return err
 }
 
@@ -806,7 +805,7 @@ func (s *MarketplacePublicationResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.ChangePublicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -116,7 +114,7 @@ func (s *MarketplacePublicationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPublications(context.Background(), request)
+	response, err := s.Client.ListPublications(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +123,7 @@ func (s *MarketplacePublicationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublications(context.Background(), request)
+		listResponse, err := s.Client.ListPublications(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package marketplace
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v55/marketplace"
 
@@ -88,7 +86,7 @@ func (s *MarketplacePublishersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPublishers(context.Background(), request)
+	response, err := s.Client.ListPublishers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +95,7 @@ func (s *MarketplacePublishersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublishers(context.Background(), request)
+		listResponse, err := s.Client.ListPublishers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
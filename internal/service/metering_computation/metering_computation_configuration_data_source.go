@@ -4,8 +4,6 @@
 package metering_computation
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v55/usageapi"
 
@@ -78,7 +76,7 @@ func (s *MeteringComputationConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.RequestSummarizedConfigurations(context.Background(), request)
+	response, err := s.Client.RequestSummarizedConfigurations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
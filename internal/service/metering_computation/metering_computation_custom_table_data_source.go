@@ -4,8 +4,6 @@
 package metering_computation
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v55/usageapi"
 
@@ -50,7 +48,7 @@ func (s *MeteringComputationCustomTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.GetCustomTable(context.Background(), request)
+	response, err := s.Client.GetCustomTable(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package metering_computation
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -189,7 +188,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateCustomTable(context.Background(), request)
+	response, err := s.Client.CreateCustomTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +205,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetCustomTable(context.Background(), request)
+	response, err := s.Client.GetCustomTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -234,7 +233,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateCustomTable(context.Background(), request)
+	response, err := s.Client.UpdateCustomTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +250,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteCustomTable(context.Background(), request)
+	_, err := s.Client.DeleteCustomTable(s.Context(), request)
 	return err
 }
 
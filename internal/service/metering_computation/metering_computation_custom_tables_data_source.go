@@ -4,8 +4,6 @@
 package metering_computation
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v55/usageapi"
 
@@ -77,7 +75,7 @@ func (s *MeteringComputationCustomTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.ListCustomTables(context.Background(), request)
+	response, err := s.Client.ListCustomTables(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *MeteringComputationCustomTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCustomTables(context.Background(), request)
+		listResponse, err := s.Client.ListCustomTables(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package metering_computation
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v55/usageapi"
 
@@ -68,7 +66,7 @@ func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.ListQueries(context.Background(), request)
+	response, err := s.Client.ListQueries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +75,7 @@ func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQueries(context.Background(), request)
+		listResponse, err := s.Client.ListQueries(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package metering_computation
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -286,7 +285,7 @@ func (s *MeteringComputationQueryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateQuery(context.Background(), request)
+	response, err := s.Client.CreateQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +302,7 @@ func (s *MeteringComputationQueryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetQuery(context.Background(), request)
+	response, err := s.Client.GetQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -331,7 +330,7 @@ func (s *MeteringComputationQueryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateQuery(context.Background(), request)
+	response, err := s.Client.UpdateQuery(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -348,7 +347,7 @@ func (s *MeteringComputationQueryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteQuery(context.Background(), request)
+	_, err := s.Client.DeleteQuery(s.Context(), request)
 	return err
 }
 
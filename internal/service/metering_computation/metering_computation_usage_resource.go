@@ -4,7 +4,6 @@
 package metering_computation
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -432,7 +431,7 @@ func (s *MeteringComputationUsageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.RequestSummarizedUsages(context.Background(), request)
+	response, err := s.Client.RequestSummarizedUsages(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package monitoring
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *MonitoringAlarmDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
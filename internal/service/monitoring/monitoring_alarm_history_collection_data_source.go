@@ -4,7 +4,6 @@
 package monitoring
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -117,7 +116,7 @@ func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.GetAlarmHistory(context.Background(), request)
+	response, err := s.Client.GetAlarmHistory(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
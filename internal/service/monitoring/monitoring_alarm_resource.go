@@ -4,7 +4,6 @@
 package monitoring
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -339,7 +338,7 @@ func (s *MonitoringAlarmResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.CreateAlarm(context.Background(), request)
+	response, err := s.Client.CreateAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -356,7 +355,7 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -486,7 +485,7 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.UpdateAlarm(context.Background(), request)
+	response, err := s.Client.UpdateAlarm(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -503,7 +502,7 @@ func (s *MonitoringAlarmResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.DeleteAlarm(context.Background(), request)
+	_, err := s.Client.DeleteAlarm(s.Context(), request)
 	return err
 }
 
@@ -643,7 +642,7 @@ func (s *MonitoringAlarmResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.ChangeAlarmCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAlarmCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package monitoring
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -132,7 +130,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.ListAlarmsStatus(context.Background(), request)
+	response, err := s.Client.ListAlarmsStatus(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -141,7 +139,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarmsStatus(context.Background(), request)
+		listResponse, err := s.Client.ListAlarmsStatus(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
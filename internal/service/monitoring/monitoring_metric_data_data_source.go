@@ -4,7 +4,6 @@
 package monitoring
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -213,7 +212,7 @@ func (s *MonitoringMetricDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.SummarizeMetricsData(context.Background(), request)
+	response, err := s.Client.SummarizeMetricsData(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package monitoring
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -176,7 +174,7 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.ListMetrics(context.Background(), request)
+	response, err := s.Client.ListMetrics(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +183,7 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMetrics(context.Background(), request)
+		listResponse, err := s.Client.ListMetrics(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
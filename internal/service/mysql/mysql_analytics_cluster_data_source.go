@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *MysqlAnalyticsClusterDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.GetAnalyticsCluster(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package mysql
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -255,7 +254,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.AddAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.AddAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,7 +280,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.GetAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,7 +309,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.UpdateAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +327,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.DeleteAnalyticsCluster(s.Context(), request)
 	return err
 }
 
@@ -404,7 +403,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) StartAnalyticsCluster() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.StartAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -423,7 +422,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) StopAnalyticsCluster() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.StopAnalyticsCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *MysqlChannelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetChannel(context.Background(), request)
+	response, err := s.Client.GetChannel(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package mysql
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -347,7 +346,7 @@ func (s *MysqlChannelResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateChannel(context.Background(), request)
+	response, err := s.Client.CreateChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -364,7 +363,7 @@ func (s *MysqlChannelResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetChannel(context.Background(), request)
+	response, err := s.Client.GetChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -430,7 +429,7 @@ func (s *MysqlChannelResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateChannel(context.Background(), request)
+	_, err := s.Client.UpdateChannel(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *MysqlChannelResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteChannel(context.Background(), request)
+	_, err := s.Client.DeleteChannel(s.Context(), request)
 	return err
 }
 
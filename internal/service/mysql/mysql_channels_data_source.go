@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -103,7 +101,7 @@ func (s *MysqlChannelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListChannels(context.Background(), request)
+	response, err := s.Client.ListChannels(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *MysqlChannelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListChannels(context.Background(), request)
+		listResponse, err := s.Client.ListChannels(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
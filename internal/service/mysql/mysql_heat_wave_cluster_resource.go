@@ -4,7 +4,6 @@
 package mysql
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -259,7 +258,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.AddHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.AddHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +284,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.GetHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -314,7 +313,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.UpdateHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +331,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.DeleteHeatWaveCluster(s.Context(), request)
 	return err
 }
 
@@ -428,7 +427,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Stop() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.StopHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -447,7 +446,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Start() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.StartHeatWaveCluster(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v55/mysql"
 
@@ -50,7 +48,7 @@ func (s *MysqlMysqlBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -430,7 +428,7 @@ func (s *MysqlMysqlBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -447,7 +445,7 @@ func (s *MysqlMysqlBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -500,7 +498,7 @@ func (s *MysqlMysqlBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateBackup(context.Background(), request)
+	_, err := s.Client.UpdateBackup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -516,7 +514,7 @@ func (s *MysqlMysqlBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(s.Context(), request)
 	return err
 }
 
@@ -759,7 +757,7 @@ func (s *MysqlMysqlBackupResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.ChangeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBackupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v55/mysql"
 
@@ -102,7 +100,7 @@ func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListBackups(context.Background(), request)
+	response, err := s.Client.ListBackups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +109,7 @@ func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBackups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -468,7 +466,7 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListConfigurations(context.Background(), request)
+	response, err := s.Client.ListConfigurations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -477,7 +475,7 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListConfigurations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
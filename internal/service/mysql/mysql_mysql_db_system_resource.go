@@ -4,7 +4,6 @@
 package mysql
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -837,7 +836,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateDbSystem(context.Background(), request)
+	response, err := s.Client.CreateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -854,7 +853,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -915,7 +914,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateDbSystem(context.Background(), request)
+	_, err := s.Client.UpdateDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -931,7 +930,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteDbSystem(context.Background(), request)
+	_, err := s.Client.DeleteDbSystem(s.Context(), request)
 	return err
 }
 
@@ -1446,7 +1445,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) StartMysqlDbInstance() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartDbSystem(context.Background(), request)
+	_, err := s.Client.StartDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1483,7 +1482,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) StopMysqlDbInstance() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopDbSystem(context.Background(), request)
+	_, err := s.Client.StopDbSystem(s.Context(), request)
 	if err != nil {
 		return err
 	}
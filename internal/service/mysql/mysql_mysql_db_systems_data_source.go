@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v55/mysql"
 
@@ -121,7 +119,7 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListDbSystems(context.Background(), request)
+	response, err := s.Client.ListDbSystems(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +128,7 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystems(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystems(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v55/mysql"
 
@@ -92,7 +90,7 @@ func (s *MysqlMysqlVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListVersions(context.Background(), request)
+	response, err := s.Client.ListVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package mysql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v55/mysql"
 
@@ -124,7 +122,7 @@ func (s *MysqlShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -215,7 +214,7 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateBackend(context.Background(), request)
+	response, err := s.Client.CreateBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,7 +280,7 @@ func nlbBackendWaitForWorkRequest(wId *string, action oci_network_load_balancer.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -320,7 +319,7 @@ func nlbBackendWaitForWorkRequest(wId *string, action oci_network_load_balancer.
 func getErrorFromNlbBackendWorkRequest(wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -368,7 +367,7 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetBackend(context.Background(), request)
+	response, err := s.Client.GetBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +416,7 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateBackend(context.Background(), request)
+	response, err := s.Client.UpdateBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +445,7 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteBackend(context.Background(), request)
+	response, err := s.Client.DeleteBackend(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package network_load_balancer
 
 import (
 	"bytes"
-	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
@@ -276,7 +275,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateBackendSet(context.Background(), request)
+	response, err := s.Client.CreateBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -342,7 +341,7 @@ func nlbBackendSetWaitForWorkRequest(wId *string, action oci_network_load_balanc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -381,7 +380,7 @@ func nlbBackendSetWaitForWorkRequest(wId *string, action oci_network_load_balanc
 func getErrorFromNlbBackendSetWorkRequest(wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -425,7 +424,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSet(context.Background(), request)
+	response, err := s.Client.GetBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -499,7 +498,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateBackendSet(context.Background(), request)
+	response, err := s.Client.UpdateBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -523,7 +522,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteBackendSet(context.Background(), request)
+	response, err := s.Client.DeleteBackendSet(s.Context(), request)
 	if err != nil {
 		return err
 	}
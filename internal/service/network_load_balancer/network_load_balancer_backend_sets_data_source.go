@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -67,7 +65,7 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListBackendSets(context.Background(), request)
+	response, err := s.Client.ListBackendSets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -76,7 +74,7 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackendSets(context.Background(), request)
+		listResponse, err := s.Client.ListBackendSets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -92,7 +90,7 @@ func (s *NetworkLoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
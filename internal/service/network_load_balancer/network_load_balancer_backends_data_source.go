@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -77,7 +75,7 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListBackends(context.Background(), request)
+	response, err := s.Client.ListBackends(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackends(context.Background(), request)
+		listResponse, err := s.Client.ListBackends(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
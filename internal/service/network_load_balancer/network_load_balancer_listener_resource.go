@@ -4,7 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -143,7 +142,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateListener(context.Background(), request)
+	response, err := s.Client.CreateListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +208,7 @@ func nlbListenerWaitForWorkRequest(wId *string, action oci_network_load_balancer
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -248,7 +247,7 @@ func nlbListenerWaitForWorkRequest(wId *string, action oci_network_load_balancer
 func getErrorFromNlbListenerWorkRequest(wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -292,7 +291,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetListener(context.Background(), request)
+	response, err := s.Client.GetListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +331,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateListener(context.Background(), request)
+	response, err := s.Client.UpdateListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -356,7 +355,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteListener(context.Background(), request)
+	response, err := s.Client.DeleteListener(s.Context(), request)
 	if err != nil {
 		return err
 	}
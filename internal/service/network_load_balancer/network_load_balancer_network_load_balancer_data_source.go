@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancer(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -83,7 +81,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancerHealth(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancerHealth(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -320,7 +319,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.CreateNetworkLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,7 +385,7 @@ func networkLoadBalancerWaitForWorkRequest(wId *string, action oci_network_load_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -425,7 +424,7 @@ func networkLoadBalancerWaitForWorkRequest(wId *string, action oci_network_load_
 func getErrorFromNetworkLoadBalancerWorkRequest(wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -454,7 +453,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -512,7 +511,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Update() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.UpdateNetworkLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -529,7 +528,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.DeleteNetworkLoadBalancer(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -731,7 +730,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateNetworkSecuri
 
 	updateNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateNetworkSecurityGroups(context.Background(), updateNsgIdsRequest)
+	response, err := s.Client.UpdateNetworkSecurityGroups(s.Context(), updateNsgIdsRequest)
 	if err != nil {
 		return err
 	}
@@ -751,7 +750,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateCompartment(c
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.ChangeNetworkLoadBalancerCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeNetworkLoadBalancerCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
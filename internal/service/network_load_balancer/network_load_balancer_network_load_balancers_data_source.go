@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancers(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancers(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkLoadBalancers(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkLoadBalancers(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package network_load_balancer
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -65,7 +63,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancersProtocols(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancersProtocols(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package nosql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -68,7 +66,7 @@ func (s *NosqlIndexDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.GetIndex(context.Background(), request)
+	response, err := s.Client.GetIndex(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package nosql
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -213,7 +212,7 @@ func (s *NosqlIndexResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.CreateIndex(context.Background(), request)
+	response, err := s.Client.CreateIndex(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +231,7 @@ func (s *NosqlIndexResourceCrud) getIndexFromWorkRequest(workId *string, retryPo
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, indexId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_nosql.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -291,7 +290,7 @@ func indexWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_nosql.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -327,7 +326,7 @@ func indexWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 }
 
 func getErrorFromNosqlIndexWorkRequest(client *oci_nosql.NosqlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_nosql.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -377,7 +376,7 @@ func (s *NosqlIndexResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.GetIndex(context.Background(), request)
+	response, err := s.Client.GetIndex(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -411,7 +410,7 @@ func (s *NosqlIndexResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.DeleteIndex(context.Background(), request)
+	response, err := s.Client.DeleteIndex(s.Context(), request)
 	if err != nil {
 		return err
 	}
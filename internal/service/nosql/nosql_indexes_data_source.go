@@ -4,8 +4,6 @@
 package nosql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *NosqlIndexesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.ListIndexes(context.Background(), request)
+	response, err := s.Client.ListIndexes(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package nosql
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -59,7 +57,7 @@ func (s *NosqlTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.GetTable(context.Background(), request)
+	response, err := s.Client.GetTable(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
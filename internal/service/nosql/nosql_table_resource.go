@@ -4,7 +4,6 @@
 package nosql
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -309,7 +308,7 @@ func (s *NosqlTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.CreateTable(context.Background(), request)
+	response, err := s.Client.CreateTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +327,7 @@ func (s *NosqlTableResourceCrud) getTableFromWorkRequest(workId *string, retryPo
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, tableId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(s.Context(),
 			oci_nosql.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -393,7 +392,7 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_nosql.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -428,7 +427,7 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 }
 
 func getErrorFromNosqlTableWorkRequest(client *oci_nosql.NosqlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_nosql.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -468,7 +467,7 @@ func (s *NosqlTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.GetTable(context.Background(), request)
+	response, err := s.Client.GetTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -566,7 +565,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 }
 
 func sendUpdateRequest(s *NosqlTableResourceCrud, request oci_nosql.UpdateTableRequest) error {
-	response, err := s.Client.UpdateTable(context.Background(), request)
+	response, err := s.Client.UpdateTable(tfresource.GetStopContext(), request)
 	if err != nil {
 		return err
 	}
@@ -601,7 +600,7 @@ func (s *NosqlTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.DeleteTable(context.Background(), request)
+	response, err := s.Client.DeleteTable(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -829,7 +828,7 @@ func (s *NosqlTableResourceCrud) updateCompartment(fromCompartmentId, toCompartm
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.ChangeTableCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeTableCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package nosql
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v55/nosql"
 
@@ -76,7 +74,7 @@ func (s *NosqlTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.ListTables(context.Background(), request)
+	response, err := s.Client.ListTables(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package objectstorage
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -167,7 +166,7 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 	}
 	putObjectRequest.RequestMetadata.RetryPolicy = multipartUploadData.RequestMetadata.RetryPolicy
 
-	_, err = multipartUploadData.ObjectStorageClient.PutObject(context.Background(), *putObjectRequest)
+	_, err = multipartUploadData.ObjectStorageClient.PutObject(tfresource.GetStopContext(), *putObjectRequest)
 	if err != nil {
 		return "", err
 	}
@@ -245,7 +244,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 		return "", fmt.Errorf("error splitting source file for upload \"%v\": %s", source, err)
 	}
 
-	multipartUploadResponse, err := client.CreateMultipartUpload(context.Background(), *multipartUploadRequest)
+	multipartUploadResponse, err := client.CreateMultipartUpload(tfresource.GetStopContext(), *multipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
 	}
@@ -306,7 +305,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 			RequestMetadata:    multipartUploadRequest.RequestMetadata,
 		}
 
-		_, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest)
+		_, err := client.AbortMultipartUpload(tfresource.GetStopContext(), abortMultipartUploadRequest)
 
 		if err != nil {
 			log.Println("[WARN] Aborting the multi part upload failed")
@@ -325,7 +324,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 	commitMultipartUploadRequest.PartsToCommit = commitMultipartUploadPartDetails
 
-	_, err = client.CommitMultipartUpload(context.Background(), commitMultipartUploadRequest)
+	_, err = client.CommitMultipartUpload(tfresource.GetStopContext(), commitMultipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("failed to commit multi part upload of \"%v\" to the service: %s", source, err)
 	}
@@ -427,7 +426,7 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 			UploadPartNum:   sourceBlock.blockNumber,
 		}
 
-		uploadPartResponse, err := ctx.client.UploadPart(context.Background(), *uploadPartRequest)
+		uploadPartResponse, err := ctx.client.UploadPart(tfresource.GetStopContext(), *uploadPartRequest)
 
 		osUploadPartResponse := &objectStorageUploadPartResponse{
 			response:   uploadPartResponse,
@@ -477,7 +476,7 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 			getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 			getWorkRequestRequest.WorkRequestId = wId
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+			workRequestResponse, err := client.GetWorkRequest(tfresource.GetStopContext(), getWorkRequestRequest)
 			wr := &workRequestResponse.WorkRequest
 			return workRequestResponse, string(wr.Status), err
 		},
@@ -531,7 +530,7 @@ func getObjectStorageErrorFromWorkRequest(workRequestId *string, client *oci_obj
 	req := oci_object_storage.ListWorkRequestErrorsRequest{}
 	req.WorkRequestId = workRequestId
 	req.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableFoundAutoRetries, "object_storage")
-	res, err := client.ListWorkRequestErrors(context.Background(), req)
+	res, err := client.ListWorkRequestErrors(tfresource.GetStopContext(), req)
 
 	if err != nil {
 		return "", err
@@ -556,7 +555,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 		request.Prefix = &prefix
 	}
 
-	response, err := client.ListObjectVersions(context.Background(), request)
+	response, err := client.ListObjectVersions(tfresource.GetStopContext(), request)
 	if err != nil {
 		return err
 	}
@@ -566,7 +565,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 	for request.Page != nil {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
 
-		listResponse, err := client.ListObjectVersions(context.Background(), request)
+		listResponse, err := client.ListObjectVersions(tfresource.GetStopContext(), request)
 		if err != nil {
 			return err
 		}
@@ -585,7 +584,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 
 		deleteObjectVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
 
-		_, err := client.DeleteObject(context.Background(), deleteObjectVersionRequest)
+		_, err := client.DeleteObject(tfresource.GetStopContext(), deleteObjectVersionRequest)
 		if err != nil {
 			errors = append(errors, err.Error())
 		}
@@ -4,7 +4,6 @@
 package objectstorage
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -66,7 +65,7 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -75,7 +74,7 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 
 	// using list call as summary and get response is same for a retention rule
 	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	listRetentionRulesResponse, e := s.Client.ListRetentionRules(context.Background(), listRetentionRulesRequest)
+	listRetentionRulesResponse, e := s.Client.ListRetentionRules(tfresource.GetResourceDataContext(s.D), listRetentionRulesRequest)
 	if e != nil {
 		return e
 	}
@@ -5,7 +5,6 @@ package objectstorage
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -326,7 +325,7 @@ func (s *ObjectStorageBucketResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateBucket(context.Background(), request)
+	response, err := s.Client.CreateBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -370,7 +369,7 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -379,7 +378,7 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 
 	// using list call as summary and get response is same for a retention rule
 	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	listRetentionRulesResponse, e := s.Client.ListRetentionRules(context.Background(), listRetentionRulesRequest)
+	listRetentionRulesResponse, e := s.Client.ListRetentionRules(s.Context(), listRetentionRulesRequest)
 
 	if e != nil {
 		return e
@@ -473,7 +472,7 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateBucket(context.Background(), request)
+	response, err := s.Client.UpdateBucket(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -503,7 +502,7 @@ func (s *ObjectStorageBucketResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteBucket(context.Background(), request)
+	_, err := s.Client.DeleteBucket(s.Context(), request)
 	return err
 }
 
@@ -643,7 +642,7 @@ func (s *ObjectStorageBucketResourceCrud) createRetentionRulesHelper(newRetentio
 		createRequest.TimeRuleLocked = item.TimeRuleLocked
 		createRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-		createResponse, err := s.Client.CreateRetentionRule(context.Background(), createRequest)
+		createResponse, err := s.Client.CreateRetentionRule(s.Context(), createRequest)
 		if err != nil {
 			log.Printf("[ERROR] Failed to Create the retention rule '%s' : %v", *createRequest.DisplayName, err)
 			s.RetentionRuleRes = responseList
@@ -787,7 +786,7 @@ func (s *ObjectStorageBucketResourceCrud) updateRetentionRulesHelper(retentionRu
 		updateRequest.TimeRuleLocked = item.TimeRuleLocked
 		updateRequest.RetentionRuleId = item.Id
 
-		updateResponse, err := s.Client.UpdateRetentionRule(context.Background(), updateRequest)
+		updateResponse, err := s.Client.UpdateRetentionRule(s.Context(), updateRequest)
 		if err != nil {
 			log.Printf("[ERROR] Failed to Update the retention rule '%s' : %v", *updateRequest.DisplayName, err)
 			s.RetentionRuleRes = responseList
@@ -827,7 +826,7 @@ func (s *ObjectStorageBucketResourceCrud) deleteRetentionRulesHelper(oldRetentio
 	for _, retentionRuleId := range deleteIds {
 		deleteRequest.RetentionRuleId = &retentionRuleId
 
-		_, err := s.Client.DeleteRetentionRule(context.Background(), deleteRequest)
+		_, err := s.Client.DeleteRetentionRule(s.Context(), deleteRequest)
 		if err != nil {
 			log.Printf("Failed to delete the retention rule '%s' : %v", *deleteRequest.RetentionRuleId, err)
 			e := tfresource.ReadResource(s)
@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -71,7 +69,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListBuckets(context.Background(), request)
+	response, err := s.Client.ListBuckets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +78,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuckets(context.Background(), request)
+		listResponse, err := s.Client.ListBuckets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
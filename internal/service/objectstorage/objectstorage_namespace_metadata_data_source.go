@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -61,7 +59,7 @@ func (s *NamespaceMetadataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -82,7 +80,7 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *NamespaceMetadataResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.UpdateNamespaceMetadata(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package objectstorage
 
 import (
-	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -165,7 +164,7 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 		headObjectRequest.VersionId = &tmp
 	}
 
-	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
+	headObjectResponse, err := s.Client.HeadObject(tfresource.GetResourceDataContext(s.D), *headObjectRequest)
 	if err != nil {
 		return err
 	}
@@ -219,7 +218,7 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 		request.HttpResponseExpires = &tmp
 	}
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
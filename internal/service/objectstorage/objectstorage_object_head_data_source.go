@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -96,7 +94,7 @@ func (s *ObjectHeadDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.HeadObject(context.Background(), request)
+	response, err := s.Client.HeadObject(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
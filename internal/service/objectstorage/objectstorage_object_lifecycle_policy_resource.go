@@ -5,7 +5,6 @@ package objectstorage
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -224,7 +223,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +255,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -297,7 +296,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -321,7 +320,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteObjectLifecyclePolicy(context.Background(), request)
+	_, err := s.Client.DeleteObjectLifecyclePolicy(s.Context(), request)
 	return err
 }
 
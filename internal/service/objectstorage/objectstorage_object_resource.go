@@ -5,7 +5,6 @@ package objectstorage
 
 import (
 	"bytes"
-	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
@@ -439,7 +438,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	}
 
 	if workRequestId == "" {
-		copyObjectResponse, err := s.SourceRegionClient.CopyObject(context.Background(), copyObjectRequest)
+		copyObjectResponse, err := s.SourceRegionClient.CopyObject(s.Context(), copyObjectRequest)
 		if err != nil {
 			s.D.Set("state", string(oci_object_storage.WorkRequestStatusCanceled))
 			return err
@@ -453,7 +452,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = &workRequestId
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(s.Context(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
@@ -662,7 +661,7 @@ func (s *ObjectStorageObjectResourceCrud) createContentObject() error {
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
 
-	_, err := s.Client.PutObject(context.Background(), request)
+	_, err := s.Client.PutObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -693,7 +692,7 @@ func (s *ObjectStorageObjectResourceCrud) getObjectHead() error {
 
 	headObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
+	headObjectResponse, err := s.Client.HeadObject(s.Context(), *headObjectRequest)
 	if err != nil {
 		return err
 	}
@@ -736,7 +735,7 @@ func (s *ObjectStorageObjectResourceCrud) updateState() (bool, error) {
 					return false, fmt.Errorf("no source_uri_details specified to verify copy state by WorkRequest")
 				}
 
-				workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(context.Background(), getWorkRequestRequest)
+				workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(s.Context(), getWorkRequestRequest)
 				if err != nil {
 					return false, err
 				}
@@ -817,7 +816,7 @@ func (s *ObjectStorageObjectResourceCrud) getObject() error {
 	// to call Get() all the time
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -858,7 +857,7 @@ func (s *ObjectStorageObjectResourceCrud) Update() error {
 		request.NewName = &newName
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-		_, err := s.Client.RenameObject(context.Background(), request)
+		_, err := s.Client.RenameObject(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -892,7 +891,7 @@ func (s *ObjectStorageObjectResourceCrud) Delete() error {
 	} else {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-		_, err := s.Client.DeleteObject(context.Background(), request)
+		_, err := s.Client.DeleteObject(s.Context(), request)
 		return err
 	}
 }
@@ -4,7 +4,6 @@
 package objectstorage
 
 import (
-	"context"
 	"strconv"
 	"time"
 
@@ -181,7 +180,7 @@ func (s *ObjectStorageObjectVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListObjectVersions(context.Background(), request)
+	response, err := s.Client.ListObjectVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -182,7 +180,7 @@ func (s *ObjectStorageObjectsDataSourceCrud) Get() error {
 	for {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-		response, err := s.Client.ListObjects(context.Background(), request)
+		response, err := s.Client.ListObjects(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
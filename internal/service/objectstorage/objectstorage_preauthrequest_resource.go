@@ -4,7 +4,6 @@
 package objectstorage
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -204,7 +203,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreatePreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.CreatePreauthenticatedRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +226,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -266,7 +265,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeletePreauthenticatedRequest(context.Background(), request)
+	_, err := s.Client.DeletePreauthenticatedRequest(s.Context(), request)
 	return err
 }
 
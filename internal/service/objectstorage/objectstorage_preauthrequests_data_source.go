@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -77,7 +75,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+	response, err := s.Client.ListPreauthenticatedRequests(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +84,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+		listResponse, err := s.Client.ListPreauthenticatedRequests(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
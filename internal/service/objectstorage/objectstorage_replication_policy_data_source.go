@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -68,7 +66,7 @@ func (s *ObjectStorageReplicationPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetReplicationPolicy(context.Background(), request)
+	response, err := s.Client.GetReplicationPolicy(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
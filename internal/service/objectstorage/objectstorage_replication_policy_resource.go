@@ -4,7 +4,6 @@
 package objectstorage
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -155,7 +154,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateReplicationPolicy(context.Background(), request)
+	response, err := s.Client.CreateReplicationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +177,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetReplicationPolicy(context.Background(), request)
+	response, err := s.Client.GetReplicationPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +200,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err = s.Client.DeleteReplicationPolicy(context.Background(), request)
+	_, err = s.Client.DeleteReplicationPolicy(s.Context(), request)
 	return err
 }
 
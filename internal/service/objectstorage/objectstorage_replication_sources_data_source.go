@@ -4,8 +4,6 @@
 package objectstorage
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -88,7 +86,7 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListReplicationSources(context.Background(), request)
+	response, err := s.Client.ListReplicationSources(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +95,7 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReplicationSources(context.Background(), request)
+		listResponse, err := s.Client.ListReplicationSources(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package oce
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *OceOceInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oce")
 
-	response, err := s.Client.GetOceInstance(context.Background(), request)
+	response, err := s.Client.GetOceInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
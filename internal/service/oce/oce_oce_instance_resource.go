@@ -4,7 +4,6 @@
 package oce
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -305,7 +304,7 @@ func (s *OceOceInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.CreateOceInstance(context.Background(), request)
+	response, err := s.Client.CreateOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -376,7 +375,7 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_oce.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -412,7 +411,7 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 }
 
 func getErrorFromOceOceInstanceWorkRequest(client *oci_oce.OceInstanceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_oce.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -442,7 +441,7 @@ func (s *OceOceInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.GetOceInstance(context.Background(), request)
+	response, err := s.Client.GetOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -498,7 +497,7 @@ func (s *OceOceInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.UpdateOceInstance(context.Background(), request)
+	response, err := s.Client.UpdateOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -515,7 +514,7 @@ func (s *OceOceInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.DeleteOceInstance(context.Background(), request)
+	response, err := s.Client.DeleteOceInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -616,7 +615,7 @@ func (s *OceOceInstanceResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.ChangeOceInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeOceInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package oce
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *OceOceInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oce")
 
-	response, err := s.Client.ListOceInstances(context.Background(), request)
+	response, err := s.Client.ListOceInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *OceOceInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOceInstances(context.Background(), request)
+		listResponse, err := s.Client.ListOceInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package ocvp
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v55/ocvp"
 
@@ -50,7 +48,7 @@ func (s *OcvpEsxiHostDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.GetEsxiHost(context.Background(), request)
+	response, err := s.Client.GetEsxiHost(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package ocvp
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -237,7 +236,7 @@ func (s *OcvpEsxiHostResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.CreateEsxiHost(context.Background(), request)
+	response, err := s.Client.CreateEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +302,7 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_ocvp.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -339,7 +338,7 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 }
 
 func getErrorFromOcvpEsxiHostWorkRequest(client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_ocvp.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -369,7 +368,7 @@ func (s *OcvpEsxiHostResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.GetEsxiHost(context.Background(), request)
+	response, err := s.Client.GetEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -407,7 +406,7 @@ func (s *OcvpEsxiHostResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.UpdateEsxiHost(context.Background(), request)
+	response, err := s.Client.UpdateEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -424,7 +423,7 @@ func (s *OcvpEsxiHostResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.DeleteEsxiHost(context.Background(), request)
+	response, err := s.Client.DeleteEsxiHost(s.Context(), request)
 	if err != nil {
 		return err
 	}
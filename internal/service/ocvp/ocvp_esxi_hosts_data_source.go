@@ -4,8 +4,6 @@
 package ocvp
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v55/ocvp"
 
@@ -85,7 +83,7 @@ func (s *OcvpEsxiHostsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.ListEsxiHosts(context.Background(), request)
+	response, err := s.Client.ListEsxiHosts(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
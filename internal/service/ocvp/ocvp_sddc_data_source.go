@@ -4,8 +4,6 @@
 package ocvp
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v55/ocvp"
 
@@ -50,7 +48,7 @@ func (s *OcvpSddcDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.GetSddc(context.Background(), request)
+	response, err := s.Client.GetSddc(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package ocvp
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -504,7 +503,7 @@ func (s *OcvpSddcResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.CreateSddc(context.Background(), request)
+	response, err := s.Client.CreateSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -583,7 +582,7 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_ocvp.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -619,7 +618,7 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 }
 
 func getErrorFromOcvpSddcWorkRequest(client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_ocvp.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -649,7 +648,7 @@ func (s *OcvpSddcResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.GetSddc(context.Background(), request)
+	response, err := s.Client.GetSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -752,7 +751,7 @@ func (s *OcvpSddcResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.UpdateSddc(context.Background(), request)
+	response, err := s.Client.UpdateSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -781,7 +780,7 @@ func (s *OcvpSddcResourceCrud) Update() error {
 		if action == UpgradeHcxAction {
 			hcxRequest := oci_ocvp.UpgradeHcxRequest{}
 			hcxRequest.SddcId = &sddcId
-			hcxRes, hcxErr := s.Client.UpgradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.UpgradeHcx(s.Context(), hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
@@ -800,7 +799,7 @@ func (s *OcvpSddcResourceCrud) Update() error {
 				}
 				hcxRequest.ReservingHcxOnPremiseLicenseKeys = keys
 			}
-			hcxRes, hcxErr := s.Client.DowngradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.DowngradeHcx(s.Context(), hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
@@ -812,7 +811,7 @@ func (s *OcvpSddcResourceCrud) Update() error {
 		} else if action == CancelDowngradeHcxAction {
 			hcxRequest := oci_ocvp.CancelDowngradeHcxRequest{}
 			hcxRequest.SddcId = &sddcId
-			hcxRes, hcxErr := s.Client.CancelDowngradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.CancelDowngradeHcx(s.Context(), hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
@@ -841,7 +840,7 @@ func (s *OcvpSddcResourceCrud) Update() error {
 func (s *OcvpSddcResourceCrud) refreshHcxLicenseStatus(sddcId *string, refresh interface{}) error {
 	hcxRequest := oci_ocvp.RefreshHcxLicenseStatusRequest{}
 	hcxRequest.SddcId = sddcId
-	hcxRes, hcxErr := s.Client.RefreshHcxLicenseStatus(context.Background(), hcxRequest)
+	hcxRes, hcxErr := s.Client.RefreshHcxLicenseStatus(s.Context(), hcxRequest)
 	if hcxErr != nil {
 		return hcxErr
 	}
@@ -861,7 +860,7 @@ func (s *OcvpSddcResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.DeleteSddc(context.Background(), request)
+	response, err := s.Client.DeleteSddc(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1156,7 +1155,7 @@ func (s *OcvpSddcResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	_, err := s.Client.ChangeSddcCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSddcCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package ocvp
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v55/ocvp"
 
@@ -71,7 +69,7 @@ func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.ListSupportedSkus(context.Background(), request)
+	response, err := s.Client.ListSupportedSkus(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +78,7 @@ func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSupportedSkus(context.Background(), request)
+		listResponse, err := s.Client.ListSupportedSkus(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package ocvp
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v55/ocvp"
 
@@ -76,7 +74,7 @@ func (s *OcvpSupportedVmwareSoftwareVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.ListSupportedVmwareSoftwareVersions(context.Background(), request)
+	response, err := s.Client.ListSupportedVmwareSoftwareVersions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
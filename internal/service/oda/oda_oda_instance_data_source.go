@@ -4,8 +4,6 @@
 package oda
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_oda "github.com/oracle/oci-go-sdk/v55/oda"
 
@@ -50,7 +48,7 @@ func (s *OdaOdaInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oda")
 
-	response, err := s.Client.GetOdaInstance(context.Background(), request)
+	response, err := s.Client.GetOdaInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package oda
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -285,7 +284,7 @@ func (s *OdaOdaInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.CreateOdaInstance(context.Background(), request)
+	response, err := s.Client.CreateOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -351,7 +350,7 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_oda.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -387,7 +386,7 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 }
 
 func getErrorFromOdaOdaInstanceWorkRequest(client *oci_oda.OdaClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_oda.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -417,7 +416,7 @@ func (s *OdaOdaInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.GetOdaInstance(context.Background(), request)
+	response, err := s.Client.GetOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -465,7 +464,7 @@ func (s *OdaOdaInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.UpdateOdaInstance(context.Background(), request)
+	response, err := s.Client.UpdateOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -482,7 +481,7 @@ func (s *OdaOdaInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.DeleteOdaInstance(context.Background(), request)
+	response, err := s.Client.DeleteOdaInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -553,7 +552,7 @@ func (s *OdaOdaInstanceResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.ChangeOdaInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeOdaInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -576,7 +575,7 @@ func (s *OdaOdaInstanceResourceCrud) StartOdaInstance() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	if _, err := s.Client.StartOdaInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StartOdaInstance(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
@@ -598,7 +597,7 @@ func (s *OdaOdaInstanceResourceCrud) StopOdaInstance() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	if _, err := s.Client.StopOdaInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StopOdaInstance(s.Context(), request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
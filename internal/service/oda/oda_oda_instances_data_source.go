@@ -4,8 +4,6 @@
 package oda
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_oda "github.com/oracle/oci-go-sdk/v55/oda"
 
@@ -76,7 +74,7 @@ func (s *OdaOdaInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oda")
 
-	response, err := s.Client.ListOdaInstances(context.Background(), request)
+	response, err := s.Client.ListOdaInstances(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +83,7 @@ func (s *OdaOdaInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOdaInstances(context.Background(), request)
+		listResponse, err := s.Client.ListOdaInstances(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
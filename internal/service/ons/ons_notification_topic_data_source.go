@@ -4,8 +4,6 @@
 package ons
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *OnsNotificationTopicDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package ons
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -195,7 +194,7 @@ func (s *OnsNotificationTopicResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateTopic(context.Background(), request)
+	response, err := s.Client.CreateTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +216,7 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -262,7 +261,7 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.UpdateTopic(context.Background(), request)
+	response, err := s.Client.UpdateTopic(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -281,7 +280,7 @@ func (s *OnsNotificationTopicResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteTopic(context.Background(), request)
+	_, err := s.Client.DeleteTopic(s.Context(), request)
 	return err
 }
 
@@ -345,7 +344,7 @@ func (s *OnsNotificationTopicResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.ChangeTopicCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTopicCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
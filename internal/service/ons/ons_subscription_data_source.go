@@ -4,7 +4,6 @@
 package ons
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *OnsSubscriptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
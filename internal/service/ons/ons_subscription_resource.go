@@ -4,7 +4,6 @@
 package ons
 
 import (
-	"context"
 	"encoding/json"
 	"reflect"
 	"strconv"
@@ -191,7 +190,7 @@ func (s *OnsSubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateSubscription(context.Background(), request)
+	response, err := s.Client.CreateSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +207,7 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +254,7 @@ func (s *OnsSubscriptionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.UpdateSubscription(context.Background(), request)
+	_, err := s.Client.UpdateSubscription(s.Context(), request)
 	if err == nil {
 		return s.Get()
 	}
@@ -271,7 +270,7 @@ func (s *OnsSubscriptionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteSubscription(context.Background(), request)
+	_, err := s.Client.DeleteSubscription(s.Context(), request)
 	return err
 }
 
@@ -370,7 +369,7 @@ func (s *OnsSubscriptionResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.ChangeSubscriptionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSubscriptionCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
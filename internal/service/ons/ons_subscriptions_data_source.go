@@ -4,7 +4,6 @@
 package ons
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -70,7 +69,7 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -79,7 +78,7 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
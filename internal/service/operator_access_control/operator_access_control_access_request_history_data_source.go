@@ -4,8 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v55/operatoraccesscontrol"
 
@@ -98,7 +96,7 @@ func (s *OperatorAccessControlAccessRequestHistoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListAccessRequestHistories(context.Background(), request)
+	response, err := s.Client.ListAccessRequestHistories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -249,7 +248,7 @@ func (s *OperatorAccessControlAccessRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListAccessRequests(context.Background(), request)
+	response, err := s.Client.ListAccessRequests(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v55/operatoraccesscontrol"
 
@@ -95,7 +93,7 @@ func (s *OperatorAccessControlOperatorActionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorAction(context.Background(), request)
+	response, err := s.Client.GetOperatorAction(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
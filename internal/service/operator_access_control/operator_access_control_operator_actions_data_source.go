@@ -4,8 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v55/operatoraccesscontrol"
 
@@ -149,7 +147,7 @@ func (s *OperatorAccessControlOperatorActionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorActions(context.Background(), request)
+	response, err := s.Client.ListOperatorActions(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
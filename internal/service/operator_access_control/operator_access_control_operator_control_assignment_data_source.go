@@ -4,7 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.GetOperatorControlAssignment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -320,7 +319,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Create() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.CreateOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.CreateOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +336,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.GetOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,7 +425,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.UpdateOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.UpdateOperatorControlAssignment(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -448,7 +447,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Delete() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.DeleteOperatorControlAssignment(context.Background(), request)
+	_, err := s.Client.DeleteOperatorControlAssignment(s.Context(), request)
 	return err
 }
 
@@ -629,7 +628,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) updateCompa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.ChangeOperatorControlAssignmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOperatorControlAssignmentCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v55/operatoraccesscontrol"
 
@@ -103,7 +101,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorControlAssignments(context.Background(), request)
+	response, err := s.Client.ListOperatorControlAssignments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +110,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOperatorControlAssignments(context.Background(), request)
+		listResponse, err := s.Client.ListOperatorControlAssignments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
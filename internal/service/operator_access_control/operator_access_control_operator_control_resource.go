@@ -4,8 +4,6 @@
 package operator_access_control
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v55/operatoraccesscontrol"
@@ -297,7 +295,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.CreateOperatorControl(context.Background(), request)
+	response, err := s.Client.CreateOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -314,7 +312,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControl(context.Background(), request)
+	response, err := s.Client.GetOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -424,7 +422,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.UpdateOperatorControl(context.Background(), request)
+	response, err := s.Client.UpdateOperatorControl(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +444,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.DeleteOperatorControl(context.Background(), request)
+	_, err := s.Client.DeleteOperatorControl(s.Context(), request)
 	return err
 }
 
@@ -565,7 +563,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) updateCompartment(com
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.ChangeOperatorControlCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOperatorControlCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"strconv"
 	"time"
 
@@ -131,7 +130,7 @@ func (s *OpsiAwrHubAwrSnapshotDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListAwrSnapshots(context.Background(), request)
+	response, err := s.Client.ListAwrSnapshots(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"strconv"
 	"time"
 
@@ -152,7 +151,7 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListAwrSnapshots(context.Background(), request)
+	response, err := s.Client.ListAwrSnapshots(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +160,7 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAwrSnapshots(context.Background(), request)
+		listResponse, err := s.Client.ListAwrSnapshots(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -121,7 +119,7 @@ func (s *OpsiAwrHubAwrSourcesSummaryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.SummarizeAwrSourcesSummaries(context.Background(), request)
+	response, err := s.Client.SummarizeAwrSourcesSummaries(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v55/opsi"
 
@@ -50,7 +48,7 @@ func (s *OpsiAwrHubDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetAwrHub(context.Background(), request)
+	response, err := s.Client.GetAwrHub(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
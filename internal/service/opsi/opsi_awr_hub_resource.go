@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -202,7 +201,7 @@ func (s *OpsiAwrHubResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateAwrHub(context.Background(), request)
+	response, err := s.Client.CreateAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +267,7 @@ func awrHubWaitForWorkRequest(wId *string, entityType string, action oci_opsi.Ac
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -304,7 +303,7 @@ func awrHubWaitForWorkRequest(wId *string, entityType string, action oci_opsi.Ac
 }
 
 func getErrorFromOpsiAwrHubWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -334,7 +333,7 @@ func (s *OpsiAwrHubResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetAwrHub(context.Background(), request)
+	response, err := s.Client.GetAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -368,7 +367,7 @@ func (s *OpsiAwrHubResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateAwrHub(context.Background(), request)
+	response, err := s.Client.UpdateAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +384,7 @@ func (s *OpsiAwrHubResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteAwrHub(context.Background(), request)
+	response, err := s.Client.DeleteAwrHub(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *OpsiDatabaseInsightDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetDatabaseInsight(context.Background(), request)
+	response, err := s.Client.GetDatabaseInsight(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
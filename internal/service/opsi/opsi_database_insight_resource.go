@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -228,7 +227,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateDatabaseInsight(context.Background(), request)
+	response, err := s.Client.CreateDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +250,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.DatabaseInsightId = &tmp
-			response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+			response, err := s.Client.DisableDatabaseInsight(s.Context(), request)
 			if err != nil {
 				return err
 			}
@@ -328,7 +327,7 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -364,7 +363,7 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 }
 
 func getErrorFromOpsiDatabaseInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -394,7 +393,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetDatabaseInsight(context.Background(), request)
+	response, err := s.Client.GetDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -421,7 +420,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateDatabaseInsight(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -452,7 +451,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
-		response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.DisableDatabaseInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -478,7 +477,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.EnableDatabaseInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -504,7 +503,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
-		response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.DisableDatabaseInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -526,7 +525,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteDatabaseInsight(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -916,7 +915,7 @@ func (s *OpsiDatabaseInsightResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeDatabaseInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseInsightCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
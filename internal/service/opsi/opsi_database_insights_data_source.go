@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -203,7 +201,7 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListDatabaseInsights(context.Background(), request)
+	response, err := s.Client.ListDatabaseInsights(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +210,7 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseInsights(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseInsights(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
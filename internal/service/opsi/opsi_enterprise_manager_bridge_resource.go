@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -206,7 +205,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.CreateEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -272,7 +271,7 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -308,7 +307,7 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 }
 
 func getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -338,7 +337,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.GetEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -386,7 +385,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.UpdateEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -403,7 +402,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.DeleteEnterpriseManagerBridge(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -532,7 +531,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeEnterpriseManagerBridgeCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeEnterpriseManagerBridgeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
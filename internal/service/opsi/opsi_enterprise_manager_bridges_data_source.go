@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -115,7 +113,7 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListEnterpriseManagerBridges(context.Background(), request)
+	response, err := s.Client.ListEnterpriseManagerBridges(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +122,7 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEnterpriseManagerBridges(context.Background(), request)
+		listResponse, err := s.Client.ListEnterpriseManagerBridges(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *OpsiExadataInsightDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetExadataInsight(context.Background(), request)
+	response, err := s.Client.GetExadataInsight(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
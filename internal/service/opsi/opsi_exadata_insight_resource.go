@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -223,7 +222,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateExadataInsight(context.Background(), request)
+	response, err := s.Client.CreateExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +245,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.ExadataInsightId = &tmp
-			response, err := s.Client.DisableExadataInsight(context.Background(), request)
+			response, err := s.Client.DisableExadataInsight(s.Context(), request)
 			if err != nil {
 				return err
 			}
@@ -323,7 +322,7 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -359,7 +358,7 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromOpsiExadataInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -389,7 +388,7 @@ func (s *OpsiExadataInsightResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetExadataInsight(context.Background(), request)
+	response, err := s.Client.GetExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -416,7 +415,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateExadataInsight(context.Background(), request)
+	response, err := s.Client.UpdateExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -447,7 +446,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
-		response, err := s.Client.DisableExadataInsight(context.Background(), request)
+		response, err := s.Client.DisableExadataInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -473,7 +472,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableExadataInsight(context.Background(), request)
+		response, err := s.Client.EnableExadataInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -499,7 +498,7 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
-		response, err := s.Client.DisableExadataInsight(context.Background(), request)
+		response, err := s.Client.DisableExadataInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -521,7 +520,7 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteExadataInsight(context.Background(), request)
+	response, err := s.Client.DeleteExadataInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -873,7 +872,7 @@ func (s *OpsiExadataInsightResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeExadataInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeExadataInsightCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
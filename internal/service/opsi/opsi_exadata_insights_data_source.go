@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -154,7 +152,7 @@ func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListExadataInsights(context.Background(), request)
+	response, err := s.Client.ListExadataInsights(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -163,7 +161,7 @@ func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExadataInsights(context.Background(), request)
+		listResponse, err := s.Client.ListExadataInsights(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
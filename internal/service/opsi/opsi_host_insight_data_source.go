@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *OpsiHostInsightDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetHostInsight(context.Background(), request)
+	response, err := s.Client.GetHostInsight(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -242,7 +241,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateHostInsight(context.Background(), request)
+	response, err := s.Client.CreateHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +264,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.HostInsightId = &tmp
-			response, err := s.Client.DisableHostInsight(context.Background(), request)
+			response, err := s.Client.DisableHostInsight(s.Context(), request)
 			if err != nil {
 				return err
 			}
@@ -342,7 +341,7 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -378,7 +377,7 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 }
 
 func getErrorFromOpsiHostInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -408,7 +407,7 @@ func (s *OpsiHostInsightResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetHostInsight(context.Background(), request)
+	response, err := s.Client.GetHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -435,7 +434,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateHostInsight(context.Background(), request)
+	response, err := s.Client.UpdateHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -467,7 +466,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
-		response, err := s.Client.DisableHostInsight(context.Background(), request)
+		response, err := s.Client.DisableHostInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -493,7 +492,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableHostInsight(context.Background(), request)
+		response, err := s.Client.EnableHostInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -520,7 +519,7 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
-		response, err := s.Client.DisableHostInsight(context.Background(), request)
+		response, err := s.Client.DisableHostInsight(s.Context(), request)
 		if err != nil {
 			return err
 		}
@@ -543,7 +542,7 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteHostInsight(context.Background(), request)
+	response, err := s.Client.DeleteHostInsight(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1022,7 +1021,7 @@ func (s *OpsiHostInsightResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeHostInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeHostInsightCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
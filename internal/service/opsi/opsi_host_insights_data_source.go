@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -163,7 +161,7 @@ func (s *OpsiHostInsightsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListHostInsights(context.Background(), request)
+	response, err := s.Client.ListHostInsights(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +170,7 @@ func (s *OpsiHostInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHostInsights(context.Background(), request)
+		listResponse, err := s.Client.ListHostInsights(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"io/ioutil"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -87,7 +86,7 @@ func (s *OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud) Cre
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DownloadOperationsInsightsWarehouseWallet(context.Background(), request)
+	response, err := s.Client.DownloadOperationsInsightsWarehouseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -217,7 +216,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.CreateOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +282,7 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -319,7 +318,7 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 }
 
 func getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -349,7 +348,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -393,7 +392,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.UpdateOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -410,7 +409,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.DeleteOperationsInsightsWarehouse(s.Context(), request)
 	if err != nil {
 		return err
 	}
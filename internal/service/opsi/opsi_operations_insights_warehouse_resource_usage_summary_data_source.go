@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -66,7 +64,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.SummarizeOperationsInsightsWarehouseResourceUsage(context.Background(), request)
+	response, err := s.Client.SummarizeOperationsInsightsWarehouseResourceUsage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
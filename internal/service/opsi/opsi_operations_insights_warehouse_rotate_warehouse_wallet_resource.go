@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -80,7 +79,7 @@ func (s *OpsiOperationsInsightsWarehouseRotateWarehouseWalletResourceCrud) Creat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.RotateOperationsInsightsWarehouseWallet(context.Background(), request)
+	response, err := s.Client.RotateOperationsInsightsWarehouseWallet(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +153,7 @@ func operationsInsightsWarehouseRotateWarehouseWalletWaitForWorkRequest(wId *str
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -190,7 +189,7 @@ func operationsInsightsWarehouseRotateWarehouseWalletWaitForWorkRequest(wId *str
 }
 
 func getErrorFromOpsiOperationsInsightsWarehouseRotateWarehouseWalletWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
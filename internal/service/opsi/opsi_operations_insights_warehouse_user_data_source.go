@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *OpsiOperationsInsightsWarehouseUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouseUser(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package opsi
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -228,7 +227,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.CreateOperationsInsightsWarehouseUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +293,7 @@ func operationsInsightsWarehouseUserWaitForWorkRequest(wId *string, entityType s
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -330,7 +329,7 @@ func operationsInsightsWarehouseUserWaitForWorkRequest(wId *string, entityType s
 }
 
 func getErrorFromOpsiOperationsInsightsWarehouseUserWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -360,7 +359,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouseUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -409,7 +408,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.UpdateOperationsInsightsWarehouseUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -426,7 +425,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.DeleteOperationsInsightsWarehouseUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package opsi
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -107,7 +105,7 @@ func (s *OpsiOperationsInsightsWarehousesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListOperationsInsightsWarehouses(context.Background(), request)
+	response, err := s.Client.ListOperationsInsightsWarehouses(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -116,7 +114,7 @@ func (s *OpsiOperationsInsightsWarehousesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOperationsInsightsWarehouses(context.Background(), request)
+		listResponse, err := s.Client.ListOperationsInsightsWarehouses(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
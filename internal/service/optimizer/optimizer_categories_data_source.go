@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -180,7 +178,7 @@ func (s *OptimizerCategoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +187,7 @@ func (s *OptimizerCategoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCategories(context.Background(), request)
+		listResponse, err := s.Client.ListCategories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
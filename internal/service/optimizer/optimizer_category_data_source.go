@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -129,7 +127,7 @@ func (s *OptimizerCategoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetCategory(context.Background(), request)
+	response, err := s.Client.GetCategory(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -50,7 +48,7 @@ func (s *OptimizerEnrollmentStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.GetEnrollmentStatus(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
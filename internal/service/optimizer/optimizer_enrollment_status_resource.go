@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
@@ -142,7 +140,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.UpdateEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -159,7 +157,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.GetEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +178,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.UpdateEnrollmentStatus(s.Context(), request)
 	if err != nil {
 		return err
 	}
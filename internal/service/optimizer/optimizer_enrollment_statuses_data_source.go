@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -84,7 +82,7 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListEnrollmentStatuses(context.Background(), request)
+	response, err := s.Client.ListEnrollmentStatuses(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +91,7 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEnrollmentStatuses(context.Background(), request)
+		listResponse, err := s.Client.ListEnrollmentStatuses(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -227,7 +225,7 @@ func (s *OptimizerHistoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListHistories(context.Background(), request)
+	response, err := s.Client.ListHistories(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +234,7 @@ func (s *OptimizerHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHistories(context.Background(), request)
+		listResponse, err := s.Client.ListHistories(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -157,7 +155,7 @@ func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListProfileLevels(context.Background(), request)
+	response, err := s.Client.ListProfileLevels(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +164,7 @@ func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProfileLevels(context.Background(), request)
+		listResponse, err := s.Client.ListProfileLevels(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package optimizer
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -330,7 +329,7 @@ func (s *OptimizerProfileResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.CreateProfile(context.Background(), request)
+	response, err := s.Client.CreateProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -347,7 +346,7 @@ func (s *OptimizerProfileResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetProfile(context.Background(), request)
+	response, err := s.Client.GetProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -424,7 +423,7 @@ func (s *OptimizerProfileResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateProfile(context.Background(), request)
+	response, err := s.Client.UpdateProfile(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -441,7 +440,7 @@ func (s *OptimizerProfileResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	_, err := s.Client.DeleteProfile(context.Background(), request)
+	_, err := s.Client.DeleteProfile(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -85,7 +83,7 @@ func (s *OptimizerProfilesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListProfiles(context.Background(), request)
+	response, err := s.Client.ListProfiles(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *OptimizerProfilesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProfiles(context.Background(), request)
+		listResponse, err := s.Client.ListProfiles(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package optimizer
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *OptimizerRecommendationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetRecommendation(context.Background(), request)
+	response, err := s.Client.GetRecommendation(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
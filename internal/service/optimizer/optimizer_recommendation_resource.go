@@ -4,7 +4,6 @@
 package optimizer
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -237,7 +236,7 @@ func (s *OptimizerRecommendationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateRecommendation(context.Background(), request)
+	response, err := s.Client.UpdateRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +253,7 @@ func (s *OptimizerRecommendationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetRecommendation(context.Background(), request)
+	response, err := s.Client.GetRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +282,7 @@ func (s *OptimizerRecommendationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateRecommendation(context.Background(), request)
+	response, err := s.Client.UpdateRecommendation(s.Context(), request)
 	if err != nil {
 		return err
 	}
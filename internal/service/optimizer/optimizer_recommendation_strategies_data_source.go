@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -168,7 +166,7 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+	response, err := s.Client.ListRecommendationStrategies(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -177,7 +175,7 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+		listResponse, err := s.Client.ListRecommendationStrategies(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
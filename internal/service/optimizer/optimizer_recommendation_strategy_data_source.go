@@ -4,7 +4,6 @@
 package optimizer
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -161,7 +160,7 @@ func (s *OptimizerRecommendationStrategyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+	response, err := s.Client.ListRecommendationStrategies(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package optimizer
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v55/optimizer"
 
@@ -111,7 +109,7 @@ func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListRecommendations(context.Background(), request)
+	response, err := s.Client.ListRecommendations(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +118,7 @@ func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRecommendations(context.Background(), request)
+		listResponse, err := s.Client.ListRecommendations(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
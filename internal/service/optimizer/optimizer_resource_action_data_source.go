@@ -4,7 +4,6 @@
 package optimizer
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -51,7 +50,7 @@ func (s *OptimizerResourceActionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetResourceAction(context.Background(), request)
+	response, err := s.Client.GetResourceAction(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package optimizer
 
 import (
-	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -223,7 +222,7 @@ func (s *OptimizerResourceActionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateResourceAction(context.Background(), request)
+	response, err := s.Client.UpdateResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +239,7 @@ func (s *OptimizerResourceActionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetResourceAction(context.Background(), request)
+	response, err := s.Client.GetResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -269,7 +268,7 @@ func (s *OptimizerResourceActionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateResourceAction(context.Background(), request)
+	response, err := s.Client.UpdateResourceAction(s.Context(), request)
 	if err != nil {
 		return err
 	}
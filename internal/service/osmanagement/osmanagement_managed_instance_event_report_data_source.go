@@ -4,7 +4,6 @@
 package osmanagement
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -93,7 +92,7 @@ func (s *OsmanagementManagedInstanceEventReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetEventReport(context.Background(), request)
+	response, err := s.Client.GetEventReport(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package osmanagement
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -200,7 +198,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.CreateManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.CreateManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +215,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.GetManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +263,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstanceGroup(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -282,7 +280,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.DeleteManagedInstanceGroup(context.Background(), request)
+	_, err := s.Client.DeleteManagedInstanceGroup(s.Context(), request)
 	return err
 }
 
@@ -343,7 +341,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.ChangeManagedInstanceGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedInstanceGroupCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
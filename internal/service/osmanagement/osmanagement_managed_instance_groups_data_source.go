@@ -4,8 +4,6 @@
 package osmanagement
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -84,7 +82,7 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.ListManagedInstanceGroups(context.Background(), request)
+	response, err := s.Client.ListManagedInstanceGroups(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +91,7 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedInstanceGroups(context.Background(), request)
+		listResponse, err := s.Client.ListManagedInstanceGroups(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -5,7 +5,6 @@ package osmanagement
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 
@@ -286,7 +285,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -459,7 +458,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 			detachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			detachParentSourceRequest.DetachParentSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(context.Background(), detachParentSourceRequest)
+			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(s.Context(), detachParentSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach parent software source, error: %v", detachErr)
 			}
@@ -474,7 +473,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 			attachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			attachParentSourceRequest.AttachParentSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(context.Background(), attachParentSourceRequest)
+			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(s.Context(), attachParentSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -506,7 +505,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 			detachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			detachParentSourceRequest.DetachParentSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(context.Background(), detachParentSourceRequest)
+			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(s.Context(), detachParentSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach parent software source, error: %v", detachErr)
 			}
@@ -518,7 +517,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 			attachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			attachParentSourceRequest.AttachParentSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(context.Background(), attachParentSourceRequest)
+			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(s.Context(), attachParentSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -540,7 +539,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 		detachChildSourceRequest.ManagedInstanceId = managedInstanceId
 		detachChildSourceRequest.DetachChildSoftwareSourceFromManagedInstanceDetails = opp
 
-		_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(context.Background(), detachChildSourceRequest)
+		_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(s.Context(), detachChildSourceRequest)
 		if detachErr != nil {
 			return fmt.Errorf("failed to detach child software source, error: %v", detachErr)
 		}
@@ -555,7 +554,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 			attachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			attachChildSourceRequest.AttachChildSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(context.Background(), attachChildSourceRequest)
+			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(s.Context(), attachChildSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach child software source, error: %v", attachErr)
 			}
@@ -586,7 +585,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 			detachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			detachChildSourceRequest.DetachChildSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(context.Background(), detachChildSourceRequest)
+			_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(s.Context(), detachChildSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach child software source, error: %v", detachErr)
 			}
@@ -598,7 +597,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 			attachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			attachChildSourceRequest.AttachChildSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(context.Background(), attachChildSourceRequest)
+			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(s.Context(), attachChildSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach child software source, error: %v", attachErr)
 			}
@@ -620,7 +619,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 		detachManagedInstanceFromGroupRequest.ManagedInstanceId = managedInstanceId
 		detachManagedInstanceFromGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-		_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(context.Background(), detachManagedInstanceFromGroupRequest)
+		_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(s.Context(), detachManagedInstanceFromGroupRequest)
 		if detachErr != nil {
 			return fmt.Errorf("failed to detach managed instance from managed instance Group request, error: %v", detachErr)
 		}
@@ -635,7 +634,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 			attachManagedInstanceToGroupRequest.ManagedInstanceId = managedInstanceId
 			attachManagedInstanceToGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(context.Background(), attachManagedInstanceToGroupRequest)
+			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(s.Context(), attachManagedInstanceToGroupRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach managed instance to  managed instance Group, error: %v", attachErr)
 			}
@@ -667,7 +666,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanc
 			detachManagedInstanceFromGroupRequest.ManagedInstanceId = managedInstanceId
 			detachManagedInstanceFromGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(context.Background(), detachManagedInstanceFromGroupRequest)
+			_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(s.Context(), detachManagedInstanceFromGroupRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach managed instance from managed instance Group request, error: %v", detachErr)
 			}
@@ -679,7 +678,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanc
 			attachManagedInstanceToGroupRequest.ManagedInstanceId = managedInstanceId
 			attachManagedInstanceToGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(context.Background(), attachManagedInstanceToGroupRequest)
+			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(s.Context(), attachManagedInstanceToGroupRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -4,8 +4,6 @@
 package osmanagement
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -268,7 +266,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +283,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -312,7 +310,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package osmanagement
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *OsmanagementSoftwareSourceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetSoftwareSource(context.Background(), request)
+	response, err := s.Client.GetSoftwareSource(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
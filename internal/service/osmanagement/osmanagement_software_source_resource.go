@@ -4,8 +4,6 @@
 package osmanagement
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -277,7 +275,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.CreateSoftwareSource(context.Background(), request)
+	response, err := s.Client.CreateSoftwareSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +292,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetSoftwareSource(context.Background(), request)
+	response, err := s.Client.GetSoftwareSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -361,7 +359,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateSoftwareSource(context.Background(), request)
+	response, err := s.Client.UpdateSoftwareSource(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -378,7 +376,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.DeleteSoftwareSource(context.Background(), request)
+	_, err := s.Client.DeleteSoftwareSource(s.Context(), request)
 	return err
 }
 
@@ -473,7 +471,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.ChangeSoftwareSourceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSoftwareSourceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package resourcemanager
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -121,7 +120,7 @@ func (s *ResourcemanagerStackDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.GetStack(context.Background(), request)
+	response, err := s.Client.GetStack(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -6,7 +6,6 @@ package resourcemanager
 import (
 	"archive/zip"
 	"bytes"
-	"context"
 	"encoding/base64"
 	"fmt"
 
@@ -39,7 +38,7 @@ func CreateResourceManagerStack(resourceManagerClient resourcemanager.ResourceMa
 	// stack representation to assert in tests
 	stackDescription := stackDisplayName + " Description"
 
-	createStackResponse, err := resourceManagerClient.CreateStack(context.Background(), resourcemanager.CreateStackRequest{
+	createStackResponse, err := resourceManagerClient.CreateStack(tfresource.GetStopContext(), resourcemanager.CreateStackRequest{
 		CreateStackDetails: resourcemanager.CreateStackDetails{
 			CompartmentId: &compartment,
 			ConfigSource: resourcemanager.CreateZipUploadConfigSourceDetails{
@@ -70,7 +69,7 @@ func DestroyResourceManagerStack(resourceManagerClient resourcemanager.ResourceM
 		return fmt.Errorf("[DEBUG] ResourceManager StackId is not specified to delete the stack")
 	}
 
-	_, err := resourceManagerClient.DeleteStack(context.Background(), resourcemanager.DeleteStackRequest{
+	_, err := resourceManagerClient.DeleteStack(tfresource.GetStopContext(), resourcemanager.DeleteStackRequest{
 		StackId: &resourceManagerStackId,
 		RequestMetadata: common.RequestMetadata{
 			RetryPolicy: tfresource.GetRetryPolicy(true, "resourcemanager"),
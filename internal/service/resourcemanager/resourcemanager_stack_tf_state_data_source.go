@@ -4,7 +4,6 @@
 package resourcemanager
 
 import (
-	"context"
 	"io/ioutil"
 	"log"
 
@@ -60,7 +59,7 @@ func (s *ResourcemanagerStackTfStateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.GetStackTfState(context.Background(), request)
+	response, err := s.Client.GetStackTfState(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
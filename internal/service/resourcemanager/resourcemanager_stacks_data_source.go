@@ -4,8 +4,6 @@
 package resourcemanager
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v55/resourcemanager"
@@ -171,7 +169,7 @@ func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.ListStacks(context.Background(), request)
+	response, err := s.Client.ListStacks(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +178,7 @@ func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListStacks(context.Background(), request)
+		listResponse, err := s.Client.ListStacks(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
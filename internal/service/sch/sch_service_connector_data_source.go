@@ -4,8 +4,6 @@
 package sch
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *SchServiceConnectorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "sch")
 
-	response, err := s.Client.GetServiceConnector(context.Background(), request)
+	response, err := s.Client.GetServiceConnector(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package sch
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -551,7 +550,7 @@ func (s *SchServiceConnectorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.CreateServiceConnector(context.Background(), request)
+	response, err := s.Client.CreateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -619,7 +618,7 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_sch.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -655,7 +654,7 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 }
 
 func getErrorFromSchServiceConnectorWorkRequest(client *oci_sch.ServiceConnectorClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_sch.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_sch.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -685,7 +684,7 @@ func (s *SchServiceConnectorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.GetServiceConnector(context.Background(), request)
+	response, err := s.Client.GetServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -772,7 +771,7 @@ func (s *SchServiceConnectorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.UpdateServiceConnector(context.Background(), request)
+	response, err := s.Client.UpdateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -789,7 +788,7 @@ func (s *SchServiceConnectorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.DeleteServiceConnector(context.Background(), request)
+	response, err := s.Client.DeleteServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1435,7 +1434,7 @@ func (s *SchServiceConnectorResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.ChangeServiceConnectorCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeServiceConnectorCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -1452,7 +1451,7 @@ func (s *SchServiceConnectorResourceCrud) StartSchResource() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.ActivateServiceConnector(context.Background(), request)
+	response, err := s.Client.ActivateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1469,7 +1468,7 @@ func (s *SchServiceConnectorResourceCrud) StopSchResource() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.DeactivateServiceConnector(context.Background(), request)
+	response, err := s.Client.DeactivateServiceConnector(s.Context(), request)
 	if err != nil {
 		return err
 	}
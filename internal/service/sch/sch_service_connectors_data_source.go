@@ -4,8 +4,6 @@
 package sch
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *SchServiceConnectorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "sch")
 
-	response, err := s.Client.ListServiceConnectors(context.Background(), request)
+	response, err := s.Client.ListServiceConnectors(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *SchServiceConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListServiceConnectors(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
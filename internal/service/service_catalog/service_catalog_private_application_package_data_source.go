@@ -4,7 +4,6 @@
 package service_catalog
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -83,7 +82,7 @@ func (s *ServiceCatalogPrivateApplicationPackageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplicationPackage(context.Background(), request)
+	response, err := s.Client.GetPrivateApplicationPackage(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package service_catalog
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v55/servicecatalog"
 
@@ -145,7 +143,7 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.ListPrivateApplicationPackages(context.Background(), request)
+	response, err := s.Client.ListPrivateApplicationPackages(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +152,7 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateApplicationPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateApplicationPackages(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
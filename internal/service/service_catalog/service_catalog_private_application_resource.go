@@ -4,7 +4,6 @@
 package service_catalog
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -277,7 +276,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreatePrivateApplication(context.Background(), request)
+	response, err := s.Client.CreatePrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +351,7 @@ func privateApplicationWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_service_catalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -388,7 +387,7 @@ func privateApplicationWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromServiceCatalogPrivateApplicationWorkRequest(client *oci_service_catalog.ServiceCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_catalog.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_service_catalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -418,7 +417,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplication(context.Background(), request)
+	response, err := s.Client.GetPrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -476,7 +475,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.UpdatePrivateApplication(context.Background(), request)
+	response, err := s.Client.UpdatePrivateApplication(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -496,7 +495,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeletePrivateApplication(context.Background(), request)
+	_, err := s.Client.DeletePrivateApplication(s.Context(), request)
 	return err
 
 	//if err != nil {
@@ -662,7 +661,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.ChangePrivateApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePrivateApplicationCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
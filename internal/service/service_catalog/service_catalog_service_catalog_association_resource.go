@@ -4,8 +4,6 @@
 package service_catalog
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v55/servicecatalog"
@@ -109,7 +107,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreateServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.CreateServiceCatalogAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +124,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.GetServiceCatalogAssociation(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +141,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeleteServiceCatalogAssociation(context.Background(), request)
+	_, err := s.Client.DeleteServiceCatalogAssociation(s.Context(), request)
 	return err
 }
 
@@ -4,8 +4,6 @@
 package service_catalog
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v55/servicecatalog"
 
@@ -50,7 +48,7 @@ func (s *ServiceCatalogServiceCatalogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalog(context.Background(), request)
+	response, err := s.Client.GetServiceCatalog(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package service_catalog
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v55/servicecatalog"
@@ -159,7 +157,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreateServiceCatalog(context.Background(), request)
+	response, err := s.Client.CreateServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +174,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalog(context.Background(), request)
+	response, err := s.Client.GetServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -219,7 +217,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.UpdateServiceCatalog(context.Background(), request)
+	response, err := s.Client.UpdateServiceCatalog(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +234,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeleteServiceCatalog(context.Background(), request)
+	_, err := s.Client.DeleteServiceCatalog(s.Context(), request)
 	return err
 }
 
@@ -303,7 +301,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.ChangeServiceCatalogCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeServiceCatalogCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package service_catalog
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v55/servicecatalog"
 
@@ -86,7 +84,7 @@ func (s *ServiceCatalogServiceCatalogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.ListServiceCatalogs(context.Background(), request)
+	response, err := s.Client.ListServiceCatalogs(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +93,7 @@ func (s *ServiceCatalogServiceCatalogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceCatalogs(context.Background(), request)
+		listResponse, err := s.Client.ListServiceCatalogs(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
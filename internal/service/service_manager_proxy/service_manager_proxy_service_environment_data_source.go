@@ -4,8 +4,6 @@
 package service_manager_proxy
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_service_manager_proxy "github.com/oracle/oci-go-sdk/v55/servicemanagerproxy"
 
@@ -125,7 +123,7 @@ func (s *ServiceManagerProxyServiceEnvironmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_manager_proxy")
 
-	response, err := s.Client.GetServiceEnvironment(context.Background(), request)
+	response, err := s.Client.GetServiceEnvironment(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
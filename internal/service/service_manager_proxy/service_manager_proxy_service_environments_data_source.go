@@ -4,8 +4,6 @@
 package service_manager_proxy
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_service_manager_proxy "github.com/oracle/oci-go-sdk/v55/servicemanagerproxy"
 
@@ -186,7 +184,7 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_manager_proxy")
 
-	response, err := s.Client.ListServiceEnvironments(context.Background(), request)
+	response, err := s.Client.ListServiceEnvironments(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +193,7 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceEnvironments(context.Background(), request)
+		listResponse, err := s.Client.ListServiceEnvironments(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package streaming
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *StreamingConnectHarnessDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "streaming")
 
-	response, err := s.Client.GetConnectHarness(context.Background(), request)
+	response, err := s.Client.GetConnectHarness(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package streaming
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -176,7 +174,7 @@ func (s *StreamingConnectHarnessResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateConnectHarness(context.Background(), request)
+	response, err := s.Client.CreateConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -193,7 +191,7 @@ func (s *StreamingConnectHarnessResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetConnectHarness(context.Background(), request)
+	response, err := s.Client.GetConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +229,7 @@ func (s *StreamingConnectHarnessResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateConnectHarness(context.Background(), request)
+	response, err := s.Client.UpdateConnectHarness(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -248,7 +246,7 @@ func (s *StreamingConnectHarnessResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteConnectHarness(context.Background(), request)
+	_, err := s.Client.DeleteConnectHarness(s.Context(), request)
 	return err
 }
 
@@ -291,7 +289,7 @@ func (s *StreamingConnectHarnessResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeConnectHarnessCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeConnectHarnessCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
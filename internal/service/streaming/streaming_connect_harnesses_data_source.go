@@ -4,8 +4,6 @@
 package streaming
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *StreamingConnectHarnessesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "streaming")
 
-	response, err := s.Client.ListConnectHarnesses(context.Background(), request)
+	response, err := s.Client.ListConnectHarnesses(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *StreamingConnectHarnessesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnectHarnesses(context.Background(), request)
+		listResponse, err := s.Client.ListConnectHarnesses(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package streaming
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -315,7 +314,7 @@ func (s *StreamingStreamPoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStreamPool(context.Background(), request)
+	response, err := s.Client.CreateStreamPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +331,7 @@ func (s *StreamingStreamPoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStreamPool(context.Background(), request)
+	response, err := s.Client.GetStreamPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -397,7 +396,7 @@ func (s *StreamingStreamPoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStreamPool(context.Background(), request)
+	response, err := s.Client.UpdateStreamPool(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -414,7 +413,7 @@ func (s *StreamingStreamPoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStreamPool(context.Background(), request)
+	_, err := s.Client.DeleteStreamPool(s.Context(), request)
 	return err
 }
 
@@ -603,7 +602,7 @@ func (s *StreamingStreamPoolResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeStreamPoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeStreamPoolCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package streaming
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -214,7 +212,7 @@ func (s *StreamingStreamResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStream(context.Background(), request)
+	response, err := s.Client.CreateStream(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +229,7 @@ func (s *StreamingStreamResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStream(context.Background(), request)
+	response, err := s.Client.GetStream(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -274,7 +272,7 @@ func (s *StreamingStreamResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStream(context.Background(), request)
+	response, err := s.Client.UpdateStream(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -291,7 +289,7 @@ func (s *StreamingStreamResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStream(context.Background(), request)
+	_, err := s.Client.DeleteStream(s.Context(), request)
 	return err
 }
 
@@ -350,7 +348,7 @@ func (s *StreamingStreamResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeStreamCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeStreamCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
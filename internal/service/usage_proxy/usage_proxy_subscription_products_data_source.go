@@ -4,8 +4,6 @@
 package usage_proxy
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v55/usage"
 
@@ -134,7 +132,7 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListProducts(context.Background(), request)
+	response, err := s.Client.ListProducts(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +141,7 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProducts(context.Background(), request)
+		listResponse, err := s.Client.ListProducts(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
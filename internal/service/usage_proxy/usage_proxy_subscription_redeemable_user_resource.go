@@ -4,7 +4,6 @@
 package usage_proxy
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -146,7 +145,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
-	response, err := s.Client.CreateRedeemableUser(context.Background(), request)
+	response, err := s.Client.CreateRedeemableUser(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +177,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +205,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
-	_, err := s.Client.DeleteRedeemableUser(context.Background(), request)
+	_, err := s.Client.DeleteRedeemableUser(s.Context(), request)
 	return err
 }
 
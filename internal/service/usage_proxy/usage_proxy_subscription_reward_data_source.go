@@ -4,8 +4,6 @@
 package usage_proxy
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v55/usage"
 
@@ -157,7 +155,7 @@ func (s *UsageProxySubscriptionRewardDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRewards(context.Background(), request)
+	response, err := s.Client.ListRewards(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package vault
 
 import (
-	"context"
 	"log"
 	"strconv"
 	"time"
@@ -149,7 +148,7 @@ func (s *VaultSecretDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
 
-	response, err := s.Client.GetSecret(context.Background(), request)
+	response, err := s.Client.GetSecret(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
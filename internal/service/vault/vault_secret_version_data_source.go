@@ -4,7 +4,6 @@
 package vault
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -100,7 +99,7 @@ func (s *VaultSecretVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
 
-	response, err := s.Client.GetSecretVersion(context.Background(), request)
+	response, err := s.Client.GetSecretVersion(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package vault
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -147,7 +145,7 @@ func (s *VaultSecretsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
 
-	response, err := s.Client.ListSecrets(context.Background(), request)
+	response, err := s.Client.ListSecrets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -156,7 +154,7 @@ func (s *VaultSecretsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecrets(context.Background(), request)
+		listResponse, err := s.Client.ListSecrets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -1,8 +1,6 @@
 package visual_builder
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -102,7 +100,7 @@ func (s *VisualBuilderVbInstanceApplicationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
 
-	response, err := s.Client.RequestSummarizedApplications(context.Background(), request)
+	response, err := s.Client.RequestSummarizedApplications(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package visual_builder
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *VisualBuilderVbInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
 
-	response, err := s.Client.GetVbInstance(context.Background(), request)
+	response, err := s.Client.GetVbInstance(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package visual_builder
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -305,7 +304,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.CreateVbInstance(context.Background(), request)
+	response, err := s.Client.CreateVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -372,7 +371,7 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_visual_builder.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -408,7 +407,7 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 }
 
 func getErrorFromVisualBuilderVbInstanceWorkRequest(client *oci_visual_builder.VbInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_visual_builder.ListWorkRequestErrorsRequest{
 			CompartmentId: compartmentId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -438,7 +437,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.GetVbInstance(context.Background(), request)
+	response, err := s.Client.GetVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -524,7 +523,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.UpdateVbInstance(context.Background(), request)
+	response, err := s.Client.UpdateVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -541,7 +540,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.DeleteVbInstance(context.Background(), request)
+	response, err := s.Client.DeleteVbInstance(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -743,7 +742,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.ChangeVbInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVbInstanceCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package vulnerability_scanning
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *VulnerabilityScanningContainerScanRecipeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.GetContainerScanRecipe(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package vulnerability_scanning
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -204,7 +203,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.CreateContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +267,7 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -304,7 +303,7 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 }
 
 func getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -334,7 +333,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.GetContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -388,7 +387,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.UpdateContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -405,7 +404,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.DeleteContainerScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -522,7 +521,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) updateCompartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeContainerScanRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerScanRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
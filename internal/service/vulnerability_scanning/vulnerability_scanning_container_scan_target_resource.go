@@ -4,7 +4,6 @@
 package vulnerability_scanning
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -246,7 +245,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateContainerScanTarget(context.Background(), request)
+	response, err := s.Client.CreateContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -310,7 +309,7 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -346,7 +345,7 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 }
 
 func getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -376,7 +375,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanTarget(context.Background(), request)
+	response, err := s.Client.GetContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -440,7 +439,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateContainerScanTarget(context.Background(), request)
+	response, err := s.Client.UpdateContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -457,7 +456,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteContainerScanTarget(context.Background(), request)
+	response, err := s.Client.DeleteContainerScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -635,7 +634,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) updateCompartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeContainerScanTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerScanTargetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package vulnerability_scanning
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -85,7 +83,7 @@ func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.ListContainerScanTargets(context.Background(), request)
+	response, err := s.Client.ListContainerScanTargets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +92,7 @@ func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerScanTargets(context.Background(), request)
+		listResponse, err := s.Client.ListContainerScanTargets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
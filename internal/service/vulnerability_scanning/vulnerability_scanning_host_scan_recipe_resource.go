@@ -4,7 +4,6 @@
 package vulnerability_scanning
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -339,7 +338,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateHostScanRecipe(context.Background(), request)
+	response, err := s.Client.CreateHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -356,7 +355,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanRecipe(context.Background(), request)
+	response, err := s.Client.GetHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -432,7 +431,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateHostScanRecipe(context.Background(), request)
+	response, err := s.Client.UpdateHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -478,7 +477,7 @@ func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -536,7 +535,7 @@ func hostScanWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 }
 
 func getErrorFromHostScanRecipeWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -566,7 +565,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteHostScanRecipe(context.Background(), request)
+	response, err := s.Client.DeleteHostScanRecipe(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -850,7 +849,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeHostScanRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHostScanRecipeCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package vulnerability_scanning
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -225,7 +224,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateHostScanTarget(context.Background(), request)
+	response, err := s.Client.CreateHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +241,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanTarget(context.Background(), request)
+	response, err := s.Client.GetHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -313,7 +312,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateHostScanTarget(context.Background(), request)
+	response, err := s.Client.UpdateHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -359,7 +358,7 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -394,7 +393,7 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromHostScanTargetWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -424,7 +423,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteHostScanTarget(context.Background(), request)
+	response, err := s.Client.DeleteHostScanTarget(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -539,7 +538,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeHostScanTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHostScanTargetCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -183,7 +181,7 @@ func (s *WaasAddressListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateAddressList(context.Background(), request)
+	response, err := s.Client.CreateAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +198,7 @@ func (s *WaasAddressListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetAddressList(context.Background(), request)
+	response, err := s.Client.GetAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +254,7 @@ func (s *WaasAddressListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateAddressList(context.Background(), request)
+	response, err := s.Client.UpdateAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +271,7 @@ func (s *WaasAddressListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteAddressList(context.Background(), request)
+	_, err := s.Client.DeleteAddressList(s.Context(), request)
 	return err
 }
 
@@ -318,7 +316,7 @@ func (s *WaasAddressListResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeAddressListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAddressListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
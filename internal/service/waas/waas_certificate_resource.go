@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -357,7 +355,7 @@ func (s *WaasCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -374,7 +372,7 @@ func (s *WaasCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +415,7 @@ func (s *WaasCertificateResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -433,7 +431,7 @@ func (s *WaasCertificateResourceCrud) Delete() error {
 	request.CertificateId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, tfresource.WaasService, certificateService, globalvar.DeleteResource)
 
-	_, err := s.Client.DeleteCertificate(context.Background(), request)
+	_, err := s.Client.DeleteCertificate(s.Context(), request)
 	return err
 }
 
@@ -632,7 +630,7 @@ func (s *WaasCertificateResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeCertificateCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *WaasCustomProtectionRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.GetCustomProtectionRule(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
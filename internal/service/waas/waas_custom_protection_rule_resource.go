@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
@@ -185,7 +183,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.CreateCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +200,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.GetCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +253,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.UpdateCustomProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -272,7 +270,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteCustomProtectionRule(context.Background(), request)
+	_, err := s.Client.DeleteCustomProtectionRule(s.Context(), request)
 	return err
 }
 
@@ -321,7 +319,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeCustomProtectionRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCustomProtectionRuleCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -70,7 +68,7 @@ func (s *WaasEdgeSubnetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.ListEdgeSubnets(context.Background(), request)
+	response, err := s.Client.ListEdgeSubnets(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -79,7 +77,7 @@ func (s *WaasEdgeSubnetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEdgeSubnets(context.Background(), request)
+		listResponse, err := s.Client.ListEdgeSubnets(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
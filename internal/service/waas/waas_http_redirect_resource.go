@@ -4,7 +4,6 @@
 package waas
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -237,7 +236,7 @@ func (s *WaasHttpRedirectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateHttpRedirect(context.Background(), request)
+	response, err := s.Client.CreateHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +255,7 @@ func (s *WaasHttpRedirectResourceCrud) getHttpRedirectFromWorkRequest(workId *st
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, httpRedirectId)
-		_, cancelErr := s.WaasClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WaasClient.CancelWorkRequest(s.Context(),
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -315,7 +314,7 @@ func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_w
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -369,7 +368,7 @@ func (s *WaasHttpRedirectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetHttpRedirect(context.Background(), request)
+	response, err := s.Client.GetHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -428,7 +427,7 @@ func (s *WaasHttpRedirectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateHttpRedirect(context.Background(), request)
+	response, err := s.Client.UpdateHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -445,7 +444,7 @@ func (s *WaasHttpRedirectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.DeleteHttpRedirect(context.Background(), request)
+	response, err := s.Client.DeleteHttpRedirect(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -561,7 +560,7 @@ func (s *WaasHttpRedirectResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeHttpRedirectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHttpRedirectCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -117,7 +115,7 @@ func (s *WaasProtectionRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetProtectionRule(context.Background(), request)
+	response, err := s.Client.GetProtectionRule(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
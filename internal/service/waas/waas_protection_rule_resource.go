@@ -4,7 +4,6 @@
 package waas
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -180,7 +179,7 @@ func (s *WaasProtectionRuleResourceCrud) getProtectionRuleFromWorkRequest(workId
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, waasPolicyId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -238,7 +237,7 @@ func protectionRuleWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -300,7 +299,7 @@ func (s *WaasProtectionRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetProtectionRule(context.Background(), request)
+	response, err := s.Client.GetProtectionRule(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -350,7 +349,7 @@ func (s *WaasProtectionRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateProtectionRules(context.Background(), request)
+	response, err := s.Client.UpdateProtectionRules(s.Context(), request)
 	if err != nil {
 		return err
 	}
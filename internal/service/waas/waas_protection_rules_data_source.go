@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -99,7 +97,7 @@ func (s *WaasProtectionRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.ListProtectionRules(context.Background(), request)
+	response, err := s.Client.ListProtectionRules(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +106,7 @@ func (s *WaasProtectionRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionRules(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package waas
 
 import (
-	"context"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -145,7 +144,7 @@ func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.ListWaasPolicies(context.Background(), request)
+	response, err := s.Client.ListWaasPolicies(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +153,7 @@ func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWaasPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListWaasPolicies(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,8 +4,6 @@
 package waas
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -1263,7 +1261,7 @@ func (s *WaasWaasPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ package waas
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -1434,7 +1433,7 @@ func (s *WaasWaasPolicyResourceCrud) Create() error {
 	retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 	request.RequestMetadata.RetryPolicy = retryPolicy
 
-	response, err := s.Client.CreateWaasPolicy(context.Background(), request)
+	response, err := s.Client.CreateWaasPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1453,7 +1452,7 @@ func (s *WaasWaasPolicyResourceCrud) getWaasPolicyFromWorkRequest(workId *string
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, waasPolicyId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(s.Context(),
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -1512,7 +1511,7 @@ func waasPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waa
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1625,7 +1624,7 @@ func (s *WaasWaasPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1719,7 +1718,7 @@ func (s *WaasWaasPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateWaasPolicy(context.Background(), request)
+	response, err := s.Client.UpdateWaasPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1780,7 +1779,7 @@ func (s *WaasWaasPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.DeleteWaasPolicy(context.Background(), request)
+	response, err := s.Client.DeleteWaasPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -3931,7 +3930,7 @@ func (s *WaasWaasPolicyResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeWaasPolicyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeWaasPolicyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@
 package waf
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -205,7 +204,7 @@ func (s *WafNetworkAddressListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateNetworkAddressList(context.Background(), request)
+	response, err := s.Client.CreateNetworkAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -271,7 +270,7 @@ func networkAddressListWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -307,7 +306,7 @@ func networkAddressListWaitForWorkRequest(wId *string, entityType string, action
 }
 
 func getErrorFromWafNetworkAddressListWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -337,7 +336,7 @@ func (s *WafNetworkAddressListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetNetworkAddressList(context.Background(), request)
+	response, err := s.Client.GetNetworkAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -364,7 +363,7 @@ func (s *WafNetworkAddressListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateNetworkAddressList(context.Background(), request)
+	response, err := s.Client.UpdateNetworkAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -381,7 +380,7 @@ func (s *WafNetworkAddressListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteNetworkAddressList(context.Background(), request)
+	response, err := s.Client.DeleteNetworkAddressList(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -782,7 +781,7 @@ func (s *WafNetworkAddressListResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeNetworkAddressListCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeNetworkAddressListCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
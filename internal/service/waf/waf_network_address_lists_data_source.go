@@ -4,8 +4,6 @@
 package waf
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -109,7 +107,7 @@ func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListNetworkAddressLists(context.Background(), request)
+	response, err := s.Client.ListNetworkAddressLists(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +116,7 @@ func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkAddressLists(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkAddressLists(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
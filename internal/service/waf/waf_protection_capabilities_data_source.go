@@ -4,8 +4,6 @@
 package waf
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -206,7 +204,7 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListProtectionCapabilities(context.Background(), request)
+	response, err := s.Client.ListProtectionCapabilities(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +213,7 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionCapabilities(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionCapabilities(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
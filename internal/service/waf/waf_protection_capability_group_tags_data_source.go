@@ -4,8 +4,6 @@
 package waf
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -101,7 +99,7 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListProtectionCapabilityGroupTags(context.Background(), request)
+	response, err := s.Client.ListProtectionCapabilityGroupTags(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +108,7 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionCapabilityGroupTags(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionCapabilityGroupTags(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -4,7 +4,6 @@
 package waf
 
 import (
-	"context"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -51,7 +50,7 @@ func (s *WafWebAppFirewallDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.GetWebAppFirewall(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewall(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
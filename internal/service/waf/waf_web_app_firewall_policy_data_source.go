@@ -4,8 +4,6 @@
 package waf
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -50,7 +48,7 @@ func (s *WafWebAppFirewallPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.GetWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewallPolicy(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
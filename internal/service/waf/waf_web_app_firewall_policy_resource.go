@@ -4,7 +4,6 @@
 package waf
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -908,7 +907,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.CreateWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -974,7 +973,7 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1010,7 +1009,7 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 }
 
 func getErrorFromWafWebAppFirewallPolicyWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1040,7 +1039,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1163,7 +1162,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.UpdateWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -1180,7 +1179,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.DeleteWebAppFirewallPolicy(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -2158,7 +2157,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeWebAppFirewallPolicyCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeWebAppFirewallPolicyCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
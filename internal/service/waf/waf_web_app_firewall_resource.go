@@ -4,7 +4,6 @@
 package waf
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -182,7 +181,7 @@ func (s *WafWebAppFirewallResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateWebAppFirewall(context.Background(), request)
+	response, err := s.Client.CreateWebAppFirewall(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -248,7 +247,7 @@ func webAppFirewallWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(tfresource.GetStopContext(),
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -284,7 +283,7 @@ func webAppFirewallWaitForWorkRequest(wId *string, entityType string, action oci
 }
 
 func getErrorFromWafWebAppFirewallWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(tfresource.GetStopContext(),
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -314,7 +313,7 @@ func (s *WafWebAppFirewallResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetWebAppFirewall(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewall(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -370,7 +369,7 @@ func (s *WafWebAppFirewallResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateWebAppFirewall(context.Background(), request)
+	response, err := s.Client.UpdateWebAppFirewall(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +386,7 @@ func (s *WafWebAppFirewallResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteWebAppFirewall(context.Background(), request)
+	response, err := s.Client.DeleteWebAppFirewall(s.Context(), request)
 	if err != nil {
 		return err
 	}
@@ -573,7 +572,7 @@ func (s *WafWebAppFirewallResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeWebAppFirewallCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeWebAppFirewallCompartment(s.Context(), changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
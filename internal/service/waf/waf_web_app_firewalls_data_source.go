@@ -4,8 +4,6 @@
 package waf
 
 import (
-	"context"
-
 	"github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 
@@ -118,7 +116,7 @@ func (s *WafWebAppFirewallsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListWebAppFirewalls(context.Background(), request)
+	response, err := s.Client.ListWebAppFirewalls(tfresource.GetResourceDataContext(s.D), request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +125,7 @@ func (s *WafWebAppFirewallsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWebAppFirewalls(context.Background(), request)
+		listResponse, err := s.Client.ListWebAppFirewalls(tfresource.GetResourceDataContext(s.D), request)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,214 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	TraceKindRequest = "request"
+	TraceKindWait    = "wait"
+
+	slowestTracesCount = 10
+)
+
+// TraceRecord is an OCI call or a state polling loop, it is written as a JSON line to the file set in OCI_TF_TRACE_PATH
+type TraceRecord struct {
+	Kind         string    `json:"kind"`
+	Service      string    `json:"service,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceId   string    `json:"resource_id,omitempty"`
+	Operation    string    `json:"operation,omitempty"`
+	Method       string    `json:"http_method,omitempty"`
+	Path         string    `json:"path,omitempty"`
+	Status       int       `json:"status,omitempty"`
+	Attempt      uint      `json:"attempt,omitempty"`
+	OpcRequestId string    `json:"opc_request_id,omitempty"`
+	Start        time.Time `json:"start"`
+	End          time.Time `json:"end"`
+	DurationMs   int64     `json:"duration_ms"`
+	Error        string    `json:"error,omitempty"`
+}
+
+func (r TraceRecord) duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+func (r TraceRecord) resource() string {
+	if r.ResourceType != "" && r.ResourceId != "" {
+		return fmt.Sprintf("%s %s", r.ResourceType, r.ResourceId)
+	}
+	return r.ResourceType + r.ResourceId
+}
+
+func (r TraceRecord) String() string {
+	var description string
+	if r.Kind == TraceKindWait {
+		description = fmt.Sprintf("waiting for %s of %s", r.Operation, r.resource())
+	} else {
+		description = fmt.Sprintf("%s %s %s", r.Method, r.Service, r.Path)
+		if resource := r.resource(); resource != "" {
+			description = fmt.Sprintf("%s (%s %s)", description, r.Operation, resource)
+		}
+	}
+	return fmt.Sprintf("%v %s", r.duration().Round(time.Millisecond), description)
+}
+
+type serviceCallStats struct {
+	calls    int
+	errors   int
+	duration time.Duration
+}
+
+var traceMutex sync.Mutex
+var traceFileOnce sync.Once
+var traceEncoder *json.Encoder
+var callStatsByService = map[string]*serviceCallStats{}
+var waitDurationByResource = map[string]time.Duration{}
+var slowestTraces []TraceRecord
+
+// isTraceFileEnabled opens the trace file the first time it is called, the records are only kept in memory when
+// OCI_TF_TRACE_PATH is not set
+func isTraceFileEnabled() bool {
+	traceFileOnce.Do(func() {
+		tracePath := os.Getenv(globalvar.EnvOCITFTraceFile)
+		if tracePath == "" {
+			return
+		}
+		traceFile, err := os.OpenFile(tracePath, syscall.O_CREAT|syscall.O_WRONLY|syscall.O_APPEND, 0666)
+		if err != nil {
+			utils.Logf("[WARN] Unable to open the trace file %s: %v", tracePath, err)
+			return
+		}
+		traceEncoder = json.NewEncoder(traceFile)
+	})
+	return traceEncoder != nil
+}
+
+// RecordTrace adds an OCI call or a polling loop to the trace file and to the summary of the run
+func RecordTrace(record TraceRecord) {
+	record.DurationMs = record.duration().Milliseconds()
+	fileEnabled := isTraceFileEnabled()
+
+	traceMutex.Lock()
+	defer traceMutex.Unlock()
+	markRunSummaryChanged()
+
+	if fileEnabled {
+		if err := traceEncoder.Encode(record); err != nil {
+			utils.Logf("[WARN] Unable to write to the trace file: %v", err)
+		}
+	}
+
+	switch record.Kind {
+	case TraceKindRequest:
+		stats, ok := callStatsByService[record.Service]
+		if !ok {
+			stats = &serviceCallStats{}
+			callStatsByService[record.Service] = stats
+		}
+		stats.calls++
+		stats.duration += record.duration()
+		if record.Error != "" || record.Status >= 400 {
+			stats.errors++
+		}
+	case TraceKindWait:
+		waitDurationByResource[record.resource()] += record.duration()
+	}
+
+	// Keep the slowest records, sorted from the slowest
+	index := sort.Search(len(slowestTraces), func(i int) bool {
+		return slowestTraces[i].duration() < record.duration()
+	})
+	if index < slowestTracesCount {
+		slowestTraces = append(slowestTraces, TraceRecord{})
+		copy(slowestTraces[index+1:], slowestTraces[index:])
+		slowestTraces[index] = record
+		if len(slowestTraces) > slowestTracesCount {
+			slowestTraces = slowestTraces[:slowestTracesCount]
+		}
+	}
+}
+
+// traceWait records a state polling loop of the resource making the requests with ctx
+func traceWait(ctx context.Context, resourceId string, operationName string, start time.Time, err error) {
+	record := TraceRecord{
+		Kind:       TraceKindWait,
+		ResourceId: resourceId,
+		Operation:  operationName,
+		Start:      start,
+		End:        time.Now(),
+	}
+	if operation, ok := GetResourceOperation(ctx); ok {
+		record.ResourceType = operation.ResourceType
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	RecordTrace(record)
+}
+
+// GetTraceSummary returns the calls made to each service, the time spent waiting for each resource and the slowest
+// operations of the run
+func GetTraceSummary() []string {
+	traceMutex.Lock()
+	defer traceMutex.Unlock()
+
+	var summary []string
+	services := make([]string, 0, len(callStatsByService))
+	for service := range callStatsByService {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	if len(services) > 0 {
+		summary = append(summary, "API calls per service:")
+	}
+	for _, service := range services {
+		stats := callStatsByService[service]
+		summary = append(summary, fmt.Sprintf("  %s: %d calls, %d errors, %v", service, stats.calls, stats.errors, stats.duration.Round(time.Millisecond)))
+	}
+
+	resources := make([]string, 0, len(waitDurationByResource))
+	for resource := range waitDurationByResource {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return waitDurationByResource[resources[i]] > waitDurationByResource[resources[j]]
+	})
+	if len(resources) > 0 {
+		summary = append(summary, "Time spent waiting per resource:")
+	}
+	for _, resource := range resources {
+		summary = append(summary, fmt.Sprintf("  %s: %v", resource, waitDurationByResource[resource].Round(time.Second)))
+	}
+
+	if len(slowestTraces) > 0 {
+		summary = append(summary, "Slowest operations:")
+	}
+	for _, record := range slowestTraces {
+		summary = append(summary, "  "+record.String())
+	}
+	return summary
+}
+
+// LogTraceSummary logs the summary of the run when OCI_TF_TRACE_PATH is set, it is called when the provider becomes idle
+func LogTraceSummary() {
+	if !isTraceFileEnabled() {
+		return
+	}
+	for _, line := range GetTraceSummary() {
+		utils.Logln(line)
+	}
+}
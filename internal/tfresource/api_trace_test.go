@@ -0,0 +1,43 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitTraceSummary(t *testing.T) {
+	callStatsByService = map[string]*serviceCallStats{}
+	waitDurationByResource = map[string]time.Duration{}
+	slowestTraces = nil
+
+	start := time.Now()
+	RecordTrace(TraceRecord{Kind: TraceKindRequest, Service: "core", Method: "GET", Path: "/instances", Status: 200, Start: start, End: start.Add(time.Second)})
+	RecordTrace(TraceRecord{Kind: TraceKindRequest, Service: "core", Method: "POST", Path: "/instances", Status: 500, Start: start, End: start.Add(2 * time.Second)})
+	RecordTrace(TraceRecord{Kind: TraceKindWait, ResourceType: "oci_core_instance", ResourceId: "ocid1.test", Operation: "creation", Start: start, End: start.Add(time.Minute)})
+
+	assert.Equal(t, 2, callStatsByService["core"].calls)
+	assert.Equal(t, 1, callStatsByService["core"].errors)
+	assert.Equal(t, time.Minute, waitDurationByResource["oci_core_instance ocid1.test"])
+
+	// The slowest operations are sorted from the slowest
+	assert.Len(t, slowestTraces, 3)
+	assert.Equal(t, TraceKindWait, slowestTraces[0].Kind)
+	assert.Equal(t, "POST", slowestTraces[1].Method)
+
+	for i := 0; i < 2*slowestTracesCount; i++ {
+		RecordTrace(TraceRecord{Kind: TraceKindRequest, Service: "identity", Start: start, End: start.Add(time.Millisecond)})
+	}
+	assert.Len(t, slowestTraces, slowestTracesCount)
+
+	summary := GetTraceSummary()
+	assert.Contains(t, summary, "API calls per service:")
+	assert.Contains(t, summary, "  core: 2 calls, 1 errors, 3s")
+	assert.Contains(t, summary, "  oci_core_instance ocid1.test: 1m0s")
+	assert.Contains(t, summary, "  1m0s waiting for creation of oci_core_instance ocid1.test")
+}
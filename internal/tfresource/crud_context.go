@@ -0,0 +1,216 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// stopContext is cancelled when Terraform asks the provider to stop, e.g. when an apply is interrupted
+var stopContext = context.Background()
+var stopContextMutex sync.RWMutex
+
+// SetStopContext sets the context that is cancelled when Terraform stops the provider, it is set from the provider configuration
+func SetStopContext(ctx context.Context) {
+	stopContextMutex.Lock()
+	defer stopContextMutex.Unlock()
+	stopContext = ctx
+}
+
+// GetStopContext returns the context to use for the requests that are not made by the CRUD operation of a resource
+func GetStopContext() context.Context {
+	stopContextMutex.RLock()
+	defer stopContextMutex.RUnlock()
+	return stopContext
+}
+
+// ResourceOperation describes the operation of a resource or data source, it is attached to the context of the requests it makes.
+// The plugin protocol does not send the name of the resource to the provider, the resource is identified by its type and ID.
+type ResourceOperation struct {
+	ResourceType string
+	ResourceId   string
+	Operation    string
+}
+
+type resourceOperationKey struct{}
+
+// resourceOperationContexts maps the ResourceData of the operations in progress to their context
+var resourceOperationContexts sync.Map
+
+// StartResourceOperation attaches the operation to the context of the requests made for d, until EndResourceOperation is called
+func StartResourceOperation(d *schema.ResourceData, resourceType string, operation string) {
+	operationContext := context.WithValue(WithRequestAttempts(GetStopContext()), resourceOperationKey{}, &ResourceOperation{
+		ResourceType: resourceType,
+		ResourceId:   d.Id(),
+		Operation:    operation,
+	})
+	resourceOperationContexts.Store(d, operationContext)
+	atomic.AddInt32(&operationsInProgress, 1)
+}
+
+// EndResourceOperation detaches the operation from d, and logs the summaries of the run once no operation is in progress
+func EndResourceOperation(d *schema.ResourceData) {
+	resourceOperationContexts.Delete(d)
+	if atomic.AddInt32(&operationsInProgress, -1) == 0 {
+		logRunSummaries()
+	}
+}
+
+// GetResourceDataContext returns the context of the operation in progress for d, or the stop context of the provider
+func GetResourceDataContext(d *schema.ResourceData) context.Context {
+	if d != nil {
+		if operationContext, ok := resourceOperationContexts.Load(d); ok {
+			return operationContext.(context.Context)
+		}
+	}
+	return GetStopContext()
+}
+
+// GetResourceOperation returns the operation that made a request with the given context
+func GetResourceOperation(ctx context.Context) (*ResourceOperation, bool) {
+	operation, ok := ctx.Value(resourceOperationKey{}).(*ResourceOperation)
+	return operation, ok
+}
+
+// getOperationTimeout returns the timeout of the operation in progress for d, or its create timeout outside of CRUD
+// operations
+func getOperationTimeout(d *schema.ResourceData) time.Duration {
+	if operation, ok := GetResourceOperation(GetResourceDataContext(d)); ok {
+		return d.Timeout(operation.Operation)
+	}
+	return d.Timeout(schema.TimeoutCreate)
+}
+
+// ContextAwareResource is implemented by the resources embedding BaseCrud. CreateResource, UpdateResource and
+// DeleteResource set the context of the operation, which the resource passes to the SDK clients.
+type ContextAwareResource interface {
+	Context() context.Context
+	SetContext(ctx context.Context)
+}
+
+// Context returns the context of the current operation, or the stop context of the provider outside of CRUD operations
+func (s *BaseCrud) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return GetResourceDataContext(s.D)
+}
+
+func (s *BaseCrud) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// withOperationContext sets a cancellable context on the resource for the duration of an operation
+func withOperationContext(sync interface{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(WithRequestAttempts(getResourceContext(sync)))
+	if contextAware, ok := sync.(ContextAwareResource); ok {
+		contextAware.SetContext(ctx)
+	}
+	return ctx, cancel
+}
+
+func getResourceContext(sync interface{}) context.Context {
+	if contextAware, ok := sync.(ContextAwareResource); ok {
+		return contextAware.Context()
+	}
+	return GetStopContext()
+}
+
+// waitForStateWithContext runs stateConf.WaitForState() and returns as soon as the context is done, instead of waiting
+// for the next poll or for the timeout of the operation. The wait is added to the trace of the run.
+func waitForStateWithContext(ctx context.Context, stateConf *resource.StateChangeConf, resourceId string, operationName string) (result interface{}, err error) {
+	start := time.Now()
+	defer func() {
+		traceWait(ctx, resourceId, operationName, start, err)
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return nil, newCancelledError(err)
+	}
+
+	refresh := stateConf.Refresh
+	stateConf.Refresh = func() (interface{}, string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, "", newCancelledError(err)
+		}
+		return refresh()
+	}
+
+	type waitResult struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan waitResult, 1)
+	go func() {
+		result, err := stateConf.WaitForState()
+		done <- waitResult{result, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.result, r.err
+	case <-ctx.Done():
+		return nil, newCancelledError(ctx.Err())
+	}
+}
+
+func newCancelledError(err error) error {
+	return fmt.Errorf("the operation was interrupted before it completed: %v", err)
+}
+
+// saveInterruptedCreate keeps the ID of a resource whose Create() was interrupted after the service accepted the request,
+// so that the resource is saved to the state instead of being left dangling
+func saveInterruptedCreate(ctx context.Context, d *schema.ResourceData, sync ResourceCreator) {
+	if ctx.Err() == nil {
+		return
+	}
+
+	// ID() dereferences the response of the create request, which is not set if the request was not sent
+	if !hasCreatedResource(sync) {
+		utils.Debugf("No resource was created before the operation was interrupted")
+		return
+	}
+
+	if id := sync.ID(); id != "" {
+		utils.Logf("[WARN] Create of %s was interrupted, saving it to the state", id)
+		d.SetId(id)
+		if err := sync.SetData(); err != nil {
+			utils.Logf("[ERROR] error setting data after the create was interrupted: %v", err)
+		}
+	}
+}
+
+// hasCreatedResource returns false if the Res or Resource of the sync, which is set from the response of the create
+// request, is nil
+func hasCreatedResource(sync ResourceCreator) bool {
+	v := reflect.ValueOf(sync)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return true
+	}
+
+	for _, key := range []string{"Res", "Resource"} {
+		if resourceReferenceValue := v.FieldByName(key); resourceReferenceValue.IsValid() {
+			switch resourceReferenceValue.Kind() {
+			case reflect.Ptr, reflect.Interface:
+				return !resourceReferenceValue.IsNil()
+			}
+			return true
+		}
+	}
+	return true
+}
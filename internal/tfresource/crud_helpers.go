@@ -8,7 +8,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"math"
 	"reflect"
 	"sort"
@@ -27,6 +26,7 @@ import (
 	oci_work_requests "github.com/oracle/oci-go-sdk/v55/workrequests"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 )
 
 var (
@@ -59,6 +59,8 @@ const (
 type BaseCrud struct {
 	D     *schema.ResourceData
 	Mutex *sync.Mutex
+
+	ctx context.Context
 }
 
 func (s *BaseCrud) VoidState() {
@@ -84,11 +86,11 @@ func (s *BaseCrud) setState(sync StatefulResource) error {
 
 				if stateValue := resourceValue.FieldByName("LifecycleState"); stateValue.IsValid() {
 					currentState := stateValue.String()
-					log.Printf("[DEBUG] BaseCrud.setState: state: %#v", currentState)
+					utils.Debugf("BaseCrud.setState: state: %#v", currentState)
 					return s.D.Set("state", currentState)
 				} else if stateValue := resourceValue.FieldByName("State"); stateValue.IsValid() {
 					currentState := stateValue.String()
-					log.Printf("[DEBUG] BaseCrud.setState: state: %#v", currentState)
+					utils.Debugf("BaseCrud.setState: state: %#v", currentState)
 					return s.D.Set("state", currentState)
 				}
 			}
@@ -154,7 +156,7 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 		getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 		getWorkRequestRequest.WorkRequestId = wr.Id
 		getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-		updatedWorkRes, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+		updatedWorkRes, err := client.GetWorkRequest(GetStopContext(), getWorkRequestRequest)
 		if err != nil {
 			return "", false, err
 		}
@@ -165,7 +167,7 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 				return "", false, nil
 			}
 			if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-				return "", false, fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+				return "", false, fmt.Errorf("WorkRequest FAILED, workId: %s. Message: %s", *wr.Id, joinLoadBalancerWorkRequestErrors(wr.ErrorDetails))
 			}
 		}
 		return "", true, nil
@@ -174,6 +176,13 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 }
 
 func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) error {
+	workRequestId := ""
+	if wr.Id != nil {
+		workRequestId = *wr.Id
+	}
+	timeout := getOperationTimeout(d)
+	progress := newWorkRequestProgress(workRequestId, "load balancer work request", timeout)
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
@@ -187,11 +196,14 @@ func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 			getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 			getWorkRequestRequest.WorkRequestId = wr.Id
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+			workRequestResponse, err := client.GetWorkRequest(GetStopContext(), getWorkRequestRequest)
 			wr = &workRequestResponse.WorkRequest
+			if err == nil {
+				progress.logMessage(string(wr.LifecycleState), wr.Message)
+			}
 			return wr, string(wr.LifecycleState), err
 		},
-		Timeout: d.Timeout(schema.TimeoutCreate),
+		Timeout: timeout,
 	}
 
 	// Should not wait when in replay mode
@@ -199,18 +211,53 @@ func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
-		return e
+	if _, e := waitForStateWithContext(GetStopContext(), stateConf, workRequestId, "load balancer work request"); e != nil {
+		return loadBalancerWorkRequestWaitError(e, client, workRequestId)
 	}
 
 	if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-		return fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+		return fmt.Errorf("WorkRequest FAILED, workId: %s. Message: %s", workRequestId, joinLoadBalancerWorkRequestErrors(wr.ErrorDetails))
 	}
 	return nil
 }
 
+// loadBalancerWorkRequestWaitError adds the errors of the work request to the error of a wait that timed out or was
+// interrupted
+func loadBalancerWorkRequestWaitError(e error, client *oci_load_balancer.LoadBalancerClient, workRequestId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), workRequestErrorsTimeout)
+	defer cancel()
+
+	noRetryPolicy := oci_common.NoRetryPolicy()
+	response, err := client.GetWorkRequest(ctx, oci_load_balancer.GetWorkRequestRequest{
+		WorkRequestId: &workRequestId,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: &noRetryPolicy,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%v, workId: %s. Unable to get the errors: %v", e, workRequestId, err)
+	}
+	return withWorkRequestErrors(e, workRequestId, joinLoadBalancerWorkRequestErrors(response.ErrorDetails))
+}
+
+func joinLoadBalancerWorkRequestErrors(errorDetails []oci_load_balancer.WorkRequestError) string {
+	allErrs := make([]string, 0, len(errorDetails))
+	for _, wrkErr := range errorDetails {
+		message := ""
+		if wrkErr.Message != nil {
+			message = *wrkErr.Message
+		}
+		allErrs = append(allErrs, fmt.Sprintf("%s: %s", wrkErr.ErrorCode, message))
+	}
+	return strings.Join(allErrs, "\n")
+}
+
 func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error {
+	ctx, cancel := withOperationContext(sync)
+	defer cancel()
+
 	if e := sync.Create(); e != nil {
+		saveInterruptedCreate(ctx, d, sync)
 		return handleError(sync, e)
 	}
 
@@ -231,7 +278,7 @@ func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error
 		if e := waitForStateRefresh(stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			//We need to SetData() here because if there is an error or timeout in the wait for state after the Create() was successful we want to store the resource in the statefile to avoid dangling resources
 			if setDataErr := sync.SetData(); setDataErr != nil {
-				log.Printf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
+				utils.Logf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
 			}
 			return e
 		}
@@ -264,7 +311,7 @@ func waitForStateRefreshForHybridPolling(workRequestClient *oci_work_requests.Wo
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := waitForStateWithContext(getResourceContext(sync), stateConf, getResourceOCID(sync), operationName); e != nil {
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
@@ -276,6 +323,9 @@ func waitForStateRefreshForHybridPolling(workRequestClient *oci_work_requests.Wo
 		if _, ok := e.(*resource.TimeoutError); ok {
 			e = fmt.Errorf("%s, you may need to increase the Terraform Operation timeouts for your resource to continue polling for longer", e)
 		}
+		if workRequestIds != nil {
+			e = workRequestWaitError(e, workRequestClient, *workRequestIds)
+		}
 		return e
 	}
 
@@ -302,7 +352,7 @@ func ResourceRefreshForHybridPolling(workRequestClient *oci_work_requests.WorkRe
 
 			//We need to SetData() here because if there is an error or timeout in the wait for state after the Create() was successful we want to store the resource in the statefile to avoid dangling resources
 			if setDataErr := sync.SetData(); setDataErr != nil {
-				log.Printf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
+				utils.Logf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
 			}
 
 			return e
@@ -322,6 +372,9 @@ func ResourceRefreshForHybridPolling(workRequestClient *oci_work_requests.WorkRe
 }
 
 func CreateResourceUsingHybridPolling(sync ResourceCreator) error {
+	_, cancel := withOperationContext(sync)
+	defer cancel()
+
 	if e := sync.Create(); e != nil {
 		return handleError(sync, e)
 	}
@@ -337,7 +390,11 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 		}
 	}
 
+	ctx, cancel := withOperationContext(sync)
+	defer cancel()
+
 	if e := sync.Create(); e != nil {
+		saveInterruptedCreate(ctx, d, sync)
 		return handleError(sync, e)
 	}
 
@@ -354,7 +411,7 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 
 			//We need to SetData() here because if there is an error or timeout in the wait for state after the Create() was successful we want to store the resource in the statefile to avoid dangling resources
 			if setDataErr := sync.SetData(); setDataErr != nil {
-				log.Printf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
+				utils.Logf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
 			}
 
 			return e
@@ -374,7 +431,7 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 
 func ReadResource(sync ResourceReader) error {
 	if e := sync.Get(); e != nil {
-		log.Printf("ERROR IN GET: %v\n", e.Error())
+		utils.Logf("[ERROR] Error in Get(): %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
 		return handleError(sync, e)
 	}
@@ -404,6 +461,9 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 		}
 	}
 
+	_, cancel := withOperationContext(sync)
+	defer cancel()
+
 	d.Partial(true)
 	if e := sync.Update(); e != nil {
 
@@ -430,6 +490,10 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
 func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
+	if err := CheckDeletionProtection(d); err != nil {
+		return err
+	}
+
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -437,6 +501,9 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 		}
 	}
 
+	_, cancel := withOperationContext(sync)
+	defer cancel()
+
 	if e := sync.Delete(); e != nil {
 		handleMissingResourceError(sync, &e)
 		return handleError(sync, e)
@@ -519,7 +586,7 @@ func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := waitForStateWithContext(getResourceContext(sync), stateConf, getResourceOCID(sync), operationName); e != nil {
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			if len(target) > 0 {
@@ -545,7 +612,7 @@ func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 
 func FilterMissingResourceError(sync ResourceVoider, err *error) {
 	if err != nil && strings.Contains((*err).Error(), "does not exist") {
-		log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
+		utils.Debugln("Object does not exist, voiding resource and nullifying error")
 		sync.VoidState()
 		*err = nil
 	}
@@ -930,6 +997,12 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 	retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
 	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
 
+	// The timeout is enforced by the StateChangeConf, so that it is reported as a timeout rather than an interruption
+	ctx := GetStopContext()
+
+	operationName := fmt.Sprintf("work request of %s", entityType)
+	progress := newWorkRequestProgress(*workRequestId, operationName, timeout)
+
 	response := oci_work_requests.GetWorkRequestResponse{}
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -944,7 +1017,7 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = workRequestClient.GetWorkRequest(context.Background(),
+			response, err = workRequestClient.GetWorkRequest(ctx,
 				oci_work_requests.GetWorkRequestRequest{
 					WorkRequestId: workRequestId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -952,6 +1025,9 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 					},
 				})
 			wr := &response.WorkRequest
+			if err == nil {
+				progress.logWorkRequest(ctx, workRequestClient, wr)
+			}
 			return wr, string(wr.Status), err
 		},
 		Timeout: timeout,
@@ -959,7 +1035,7 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 
 	var identifier *string
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := waitForStateWithContext(ctx, stateConf, *workRequestId, operationName); e != nil {
 		for _, res := range response.Resources {
 			if strings.Contains(strings.ToLower(*res.EntityType), strings.ToLower(entityType)) {
 				if res.Identifier != nil {
@@ -969,7 +1045,7 @@ func WaitForWorkRequest(workRequestClient *oci_work_requests.WorkRequestClient,
 			}
 		}
 
-		return identifier, e
+		return identifier, workRequestWaitError(e, workRequestClient, *workRequestId)
 	}
 
 	// The work request response contains an array of objects that finished the operation
@@ -1017,25 +1093,51 @@ func workRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.
 }
 
 func getWorkRequestErrors(workRequestClient *oci_work_requests.WorkRequestClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
-	response, err := workRequestClient.ListWorkRequestErrors(context.Background(), oci_work_requests.ListWorkRequestErrorsRequest{
+	request := oci_work_requests.ListWorkRequestErrorsRequest{
 		WorkRequestId: workRequestId,
 		RequestMetadata: oci_common.RequestMetadata{
 			RetryPolicy: retryPolicy,
 		},
-	})
+	}
+	workRequestErrors, err := listWorkRequestErrors(GetStopContext(), workRequestClient, request)
 	if err != nil {
-		return err
+		return fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Unable to list the errors: %v", *workRequestId, entityType, action, err)
 	}
 
-	allErrs := make([]string, 0)
-	for _, wrkErr := range response.Items {
-		allErrs = append(allErrs, *wrkErr.Message)
-	}
-	errorMessage := strings.Join(allErrs, "\n")
+	return formatWorkRequestErrors(*workRequestId, entityType, action, workRequestErrors)
+}
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *workRequestId, entityType, action, errorMessage)
+// workRequestWaitError adds the errors of the work request to the error of a wait that timed out or was interrupted.
+// The errors are listed with a new context, since the stop context is done when the operation was interrupted.
+func workRequestWaitError(e error, workRequestClient *oci_work_requests.WorkRequestClient, workRequestId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), workRequestErrorsTimeout)
+	defer cancel()
 
-	return workRequestErr
+	noRetryPolicy := oci_common.NoRetryPolicy()
+	workRequestErrors, err := listWorkRequestErrors(ctx, workRequestClient, oci_work_requests.ListWorkRequestErrorsRequest{
+		WorkRequestId: &workRequestId,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: &noRetryPolicy,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%v, workId: %s. Unable to list the errors: %v", e, workRequestId, err)
+	}
+	return withWorkRequestErrors(e, workRequestId, joinWorkRequestErrors(workRequestErrors))
+}
+
+func listWorkRequestErrors(ctx context.Context, workRequestClient *oci_work_requests.WorkRequestClient, request oci_work_requests.ListWorkRequestErrorsRequest) ([]oci_work_requests.WorkRequestError, error) {
+	var workRequestErrors []oci_work_requests.WorkRequestError
+	for {
+		response, err := workRequestClient.ListWorkRequestErrors(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		workRequestErrors = append(workRequestErrors, response.Items...)
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return workRequestErrors, nil
+		}
+	}
 }
 
 // Helper to marshal JSON objects from service into strings that can be stored in state.
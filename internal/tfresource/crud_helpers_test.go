@@ -4,11 +4,16 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
 
@@ -91,3 +96,130 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 		return
 	}
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitWaitForStateWithContext_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	refreshes := 0
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PROVISIONING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			refreshes++
+			if refreshes == 1 {
+				cancel()
+			}
+			return refreshes, "PROVISIONING", nil
+		},
+		Timeout: time.Hour,
+	}
+
+	start := time.Now()
+	_, err := waitForStateWithContext(ctx, stateConf, "ocid1.test", "creation")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "interrupted")
+	assert.True(t, time.Since(start) < 5*time.Second, "the wait should stop as soon as the context is cancelled")
+
+	// A context that is already done does not refresh the resource
+	refreshes = 0
+	_, err = waitForStateWithContext(ctx, stateConf, "ocid1.test", "creation")
+	assert.Error(t, err)
+	assert.Equal(t, 0, refreshes)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitWaitForStateWithContext_timeout(t *testing.T) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PROVISIONING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			return 1, "PROVISIONING", nil
+		},
+		Timeout: time.Second,
+	}
+
+	// The timeout of the operation is reported as a timeout, not as an interruption
+	_, err := waitForStateWithContext(context.Background(), stateConf, "ocid1.test", "creation")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout while waiting for state")
+	assert.NotContains(t, err.Error(), "interrupted")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitBaseCrudContext(t *testing.T) {
+	stopCtx, stop := context.WithCancel(context.Background())
+	SetStopContext(stopCtx)
+	defer SetStopContext(context.Background())
+
+	crud := &BaseCrud{}
+	assert.Equal(t, stopCtx, crud.Context())
+
+	ctx, cancel := withOperationContext(crud)
+	defer cancel()
+	assert.Equal(t, ctx, crud.Context())
+	assert.NoError(t, crud.Context().Err())
+
+	stop()
+	assert.Error(t, crud.Context().Err(), "stopping the provider cancels the operations in progress")
+}
+
+type testCreatedResource struct {
+	Id *string
+}
+
+type TestCreateResourceCrud struct {
+	BaseCrud
+	Res *testCreatedResource
+}
+
+func (s *TestCreateResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *TestCreateResourceCrud) Create() error {
+	return fmt.Errorf("context canceled")
+}
+
+func (s *TestCreateResourceCrud) SetData() error {
+	return s.D.Set("state", "PROVISIONING")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitSaveInterruptedCreate(t *testing.T) {
+	d := (&schema.Resource{Schema: map[string]*schema.Schema{"state": {Type: schema.TypeString, Computed: true}}}).Data(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The create request was not sent, there is no resource to save
+	crud := &TestCreateResourceCrud{BaseCrud: BaseCrud{D: d}}
+	saveInterruptedCreate(ctx, d, crud)
+	assert.Equal(t, "", d.Id())
+
+	// The create request was not interrupted
+	id := "ocid1.test.abc"
+	crud.Res = &testCreatedResource{Id: &id}
+	saveInterruptedCreate(context.Background(), d, crud)
+	assert.Equal(t, "", d.Id())
+
+	saveInterruptedCreate(ctx, d, crud)
+	assert.Equal(t, id, d.Id())
+	assert.Equal(t, "PROVISIONING", d.Get("state"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetOperationTimeout(t *testing.T) {
+	d := (&schema.Resource{Timeouts: &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(time.Hour),
+		Update: schema.DefaultTimeout(2 * time.Hour),
+		Delete: schema.DefaultTimeout(3 * time.Hour),
+	}}).Data(nil)
+	assert.Equal(t, time.Hour, getOperationTimeout(d), "the create timeout is used outside of CRUD operations")
+
+	StartResourceOperation(d, "oci_load_balancer_backend_set", "update")
+	assert.Equal(t, 2*time.Hour, getOperationTimeout(d))
+	EndResourceOperation(d)
+
+	StartResourceOperation(d, "oci_load_balancer_backend_set", "delete")
+	defer EndResourceOperation(d)
+	assert.Equal(t, 3*time.Hour, getOperationTimeout(d))
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// errorCategoryEnum is the stable code of the kind of failure, which the pipelines use to classify the errors
+type errorCategoryEnum string
+
+const (
+	QuotaErrorCategory               errorCategoryEnum = "QUOTA"
+	AuthErrorCategory                errorCategoryEnum = "AUTH"
+	ConflictErrorCategory            errorCategoryEnum = "CONFLICT"
+	EventualConsistencyErrorCategory errorCategoryEnum = "EVENTUAL_CONSISTENCY"
+	CapacityErrorCategory            errorCategoryEnum = "CAPACITY"
+	InvalidParameterErrorCategory    errorCategoryEnum = "INVALID_PARAMETER"
+	NotFoundErrorCategory            errorCategoryEnum = "NOT_FOUND"
+	ThrottlingErrorCategory          errorCategoryEnum = "THROTTLING"
+	TimeoutErrorCategory             errorCategoryEnum = "TIMEOUT"
+	ServiceFailureErrorCategory      errorCategoryEnum = "SERVICE_FAILURE"
+	UnexpectedStateErrorCategory     errorCategoryEnum = "UNEXPECTED_STATE"
+	WorkRequestFailureErrorCategory  errorCategoryEnum = "WORK_REQUEST_FAILURE"
+	UnknownErrorCategory             errorCategoryEnum = "UNKNOWN"
+)
+
+// The stable code of the action which usually fixes each category of errors
+var remediationByErrorCategory = map[errorCategoryEnum]string{
+	QuotaErrorCategory:               "REQUEST_LIMIT_INCREASE",
+	AuthErrorCategory:                "CHECK_CREDENTIALS_AND_POLICIES",
+	ConflictErrorCategory:            "RETRY_AFTER_CONFLICTING_OPERATION",
+	EventualConsistencyErrorCategory: "RETRY_LATER",
+	CapacityErrorCategory:            "TRY_ANOTHER_AVAILABILITY_DOMAIN_OR_SHAPE",
+	InvalidParameterErrorCategory:    "FIX_CONFIGURATION",
+	NotFoundErrorCategory:            "CHECK_RESOURCE_EXISTS_AND_POLICIES",
+	ThrottlingErrorCategory:          "INCREASE_RETRY_DURATION",
+	TimeoutErrorCategory:             "INCREASE_TIMEOUT",
+	ServiceFailureErrorCategory:      "CONTACT_SUPPORT",
+	UnexpectedStateErrorCategory:     "CHECK_RESOURCE_STATE",
+	WorkRequestFailureErrorCategory:  "CHECK_WORK_REQUEST_ERRORS",
+	UnknownErrorCategory:             "CONTACT_SUPPORT",
+}
+
+var quotaErrorCodes = []string{"LimitExceeded", "QuotaExceeded"}
+var capacityErrorMessages = []string{"out of host capacity", "out of capacity", "insufficient capacity"}
+
+func getErrorCategory(tfError customError, err error) errorCategoryEnum {
+	message := strings.ToLower(tfError.Message)
+	for _, capacityMessage := range capacityErrorMessages {
+		if strings.Contains(message, capacityMessage) {
+			return CapacityErrorCategory
+		}
+	}
+
+	switch tfError.TypeOfError {
+	case TimeoutError:
+		return TimeoutErrorCategory
+	case UnexpectedStateError:
+		return UnexpectedStateErrorCategory
+	case WorkRequestError:
+		for _, code := range quotaErrorCodes {
+			if strings.Contains(tfError.Message, code) {
+				return QuotaErrorCategory
+			}
+		}
+		return WorkRequestFailureErrorCategory
+	case ServiceError:
+		return getServiceErrorCategory(tfError, err)
+	}
+	return UnknownErrorCategory
+}
+
+func getServiceErrorCategory(tfError customError, err error) errorCategoryEnum {
+	for _, code := range quotaErrorCodes {
+		if tfError.ErrorCodeName == code {
+			return QuotaErrorCategory
+		}
+	}
+	// The error may be caused by an eventually consistent request, like an IAM change, whose effects are still present
+	if oci_common.IsErrorAffectedByEventualConsistency(err) {
+		if endOfWindow := oci_common.EcContext.GetEndOfWindow(); endOfWindow != nil && endOfWindow.After(time.Now()) {
+			return EventualConsistencyErrorCategory
+		}
+	}
+
+	switch {
+	case tfError.ErrorCode == http.StatusUnauthorized || tfError.ErrorCode == http.StatusForbidden:
+		return AuthErrorCategory
+	case tfError.ErrorCode == http.StatusNotFound:
+		return NotFoundErrorCategory
+	case tfError.ErrorCode == http.StatusConflict || tfError.ErrorCode == http.StatusPreconditionFailed:
+		return ConflictErrorCategory
+	case tfError.ErrorCode == http.StatusTooManyRequests:
+		return ThrottlingErrorCategory
+	case tfError.ErrorCode >= http.StatusInternalServerError:
+		return ServiceFailureErrorCategory
+	case tfError.ErrorCode >= http.StatusBadRequest:
+		return InvalidParameterErrorCategory
+	}
+	return UnknownErrorCategory
+}
+
+// ErrorRecord is an error returned by a resource or a data source, it is written as a JSON line to the file set in
+// OCI_TF_ERROR_PATH
+type ErrorRecord struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Type         string    `json:"type"`
+	Category     string    `json:"category"`
+	Remediation  string    `json:"remediation"`
+	HttpStatus   int       `json:"http_status,omitempty"`
+	ServiceCode  string    `json:"service_code,omitempty"`
+	OpcRequestId string    `json:"opc_request_id,omitempty"`
+	ResourceOCID string    `json:"resource_ocid,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	Service      string    `json:"service,omitempty"`
+	Message      string    `json:"message"`
+}
+
+func newErrorRecord(tfError customError) ErrorRecord {
+	record := ErrorRecord{
+		Timestamp:    time.Now(),
+		Type:         string(tfError.TypeOfError),
+		Category:     string(tfError.Category),
+		Remediation:  tfError.Remediation,
+		HttpStatus:   tfError.ErrorCode,
+		OpcRequestId: tfError.OpcRequestID,
+		ResourceOCID: tfError.ResourceOCID,
+		ResourceType: tfError.ResourceType,
+		Service:      tfError.Service,
+		Message:      tfError.Message,
+	}
+	if tfError.TypeOfError == ServiceError {
+		record.ServiceCode = tfError.ErrorCodeName
+	}
+	return record
+}
+
+var errorFileMutex sync.Mutex
+var errorFileOnce sync.Once
+var errorEncoder *json.Encoder
+
+// recordError adds the error to the file set in OCI_TF_ERROR_PATH, it does nothing when the variable is not set
+func recordError(tfError customError) {
+	errorFileOnce.Do(func() {
+		errorPath := os.Getenv(globalvar.EnvOCITFErrorFile)
+		if errorPath == "" {
+			return
+		}
+		errorFile, err := os.OpenFile(errorPath, syscall.O_CREAT|syscall.O_WRONLY|syscall.O_APPEND, 0666)
+		if err != nil {
+			utils.Logf("[WARN] Unable to open the error file %s: %v", errorPath, err)
+			return
+		}
+		errorEncoder = json.NewEncoder(errorFile)
+	})
+	if errorEncoder == nil {
+		return
+	}
+
+	errorFileMutex.Lock()
+	defer errorFileMutex.Unlock()
+	if err := errorEncoder.Encode(newErrorRecord(tfError)); err != nil {
+		utils.Logf("[WARN] Unable to write to the error file: %v", err)
+	}
+}
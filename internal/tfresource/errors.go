@@ -5,7 +5,6 @@ package tfresource
 
 import (
 	"fmt"
-	"log"
 	"reflect"
 	"regexp"
 	"strings"
@@ -14,6 +13,7 @@ import (
 	oci_common "github.com/oracle/oci-go-sdk/v55/common"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 )
 
 type errorTypeEnum string
@@ -23,6 +23,7 @@ const (
 	TimeoutError         errorTypeEnum = "TimeoutError"
 	UnexpectedStateError errorTypeEnum = "UnexpectedStateError"
 	WorkRequestError     errorTypeEnum = "WorkRequestError"
+	TerraformError       errorTypeEnum = "TerraformError"
 )
 
 type customError struct {
@@ -35,6 +36,9 @@ type customError struct {
 	ResourceOCID  string
 	Suggestion    string
 	VersionError  string
+	Category      errorCategoryEnum
+	Remediation   string
+	ResourceType  string
 }
 
 // Create new error format for Terraform output
@@ -51,6 +55,7 @@ func newCustomError(sync interface{}, err error) error {
 			Message:       failure.GetMessage(),
 			OpcRequestID:  failure.GetOpcRequestID(),
 			Service:       getServiceName(sync),
+			ResourceOCID:  getResourceOCID(sync),
 		}
 	} else if strings.Contains(errorMessage, "timeout while waiting for state") {
 		// Timeout error
@@ -78,15 +83,35 @@ func newCustomError(sync interface{}, err error) error {
 			ResourceOCID:  getResourceOCID(sync),
 		}
 	} else {
-		// Terraform error return as is
+		// Terraform error return as is, it is only recorded
+		recordError(customError{
+			TypeOfError:  TerraformError,
+			Message:      errorMessage,
+			Service:      getServiceName(sync),
+			Category:     UnknownErrorCategory,
+			Remediation:  remediationByErrorCategory[UnknownErrorCategory],
+			ResourceType: getResourceType(sync),
+		})
 		return err
 	}
 
 	tfError.VersionError = GetVersionAndDateError()
 	tfError.Suggestion = getSuggestionFromError(tfError)
+	tfError.Category = getErrorCategory(tfError, err)
+	tfError.Remediation = remediationByErrorCategory[tfError.Category]
+	tfError.ResourceType = getResourceType(sync)
+	recordError(tfError)
 	return tfError.Error()
 }
 
+// getResourceType returns the type of the resource whose operation is in progress, if any
+func getResourceType(sync interface{}) string {
+	if operation, ok := GetResourceOperation(getResourceContext(sync)); ok {
+		return operation.ResourceType
+	}
+	return ""
+}
+
 func (tfE customError) Error() error {
 	switch tfE.TypeOfError {
 	case ServiceError:
@@ -95,31 +120,35 @@ func (tfE customError) Error() error {
 			"Service: %s \n"+
 			"Error Message: %s \n"+
 			"OPC request ID: %s \n"+
+			"Error Category: %s, Remediation: %s \n"+
 			"Suggestion: %s\n",
-			tfE.ErrorCode, tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.OpcRequestID, tfE.Suggestion)
+			tfE.ErrorCode, tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.OpcRequestID, tfE.Category, tfE.Remediation, tfE.Suggestion)
 	case TimeoutError:
 		return fmt.Errorf("%s \n"+
 			"%s \n"+
 			"Service: %s \n"+
 			"Error Message: %s \n"+
+			"Error Category: %s, Remediation: %s \n"+
 			"Suggestion: %s\n",
-			tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.Suggestion)
+			tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.Category, tfE.Remediation, tfE.Suggestion)
 	case UnexpectedStateError:
 		return fmt.Errorf("%s \n"+
 			"%s \n"+
 			"Service: %s \n"+
 			"Error Message: %s \n"+
 			"Resource OCID: %s \n"+
+			"Error Category: %s, Remediation: %s \n"+
 			"Suggestion: %s\n",
-			tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.ResourceOCID, tfE.Suggestion)
+			tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.ResourceOCID, tfE.Category, tfE.Remediation, tfE.Suggestion)
 	case WorkRequestError:
 		return fmt.Errorf("%s \n"+
 			"%s \n"+
 			"Service: %s \n"+
 			"Error Message: %s \n"+
 			"Resource OCID: %s \n"+
+			"Error Category: %s, Remediation: %s \n"+
 			"Suggestion: %s\n",
-			tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.ResourceOCID, tfE.Suggestion)
+			tfE.ErrorCodeName, tfE.VersionError, tfE.Service, tfE.Message, tfE.ResourceOCID, tfE.Category, tfE.Remediation, tfE.Suggestion)
 	default:
 		return fmt.Errorf(tfE.Message)
 	}
@@ -138,7 +167,7 @@ func handleMissingResourceError(sync ResourceVoider, err *error) {
 			strings.Contains((*err).Error(), "not found") ||
 			(strings.Contains((*err).Error(), "Load balancer") && strings.Contains((*err).Error(), " has no ")) ||
 			strings.Contains(strings.ToLower((*err).Error()), "status code: 404") { // status code: 404 is not enough because the load balancer error responses don't include it for some reason
-			log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
+			utils.Debugln("Object does not exist, voiding resource and nullifying error")
 			if sync != nil {
 				sync.VoidState()
 			}
@@ -169,7 +198,7 @@ func getServiceName(sync interface{}) string {
 		serviceName := syncTypeName[strings.Index(syncTypeName, ".")+1 : strings.Index(syncTypeName, "DataSourceCrud")]
 		return removeDuplicate(serviceName)
 	}
-	log.Printf("[DEBUG] Can't get the service name for: %v", syncTypeName)
+	utils.Debugf("Can't get the service name for: %v", syncTypeName)
 	return ""
 }
 
@@ -191,7 +220,7 @@ func removeDuplicate(name string) string {
 func getResourceOCID(sync interface{}) string {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Println("[WARN] ID() function panic recovered!", r)
+			utils.Logf("[WARN] ID() function panic recovered! %v", r)
 		}
 	}()
 	if syn, ok := sync.(StatefulResource); ok {
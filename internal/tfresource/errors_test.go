@@ -4,8 +4,18 @@
 package tfresource
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
 	"github.com/stretchr/testify/assert"
@@ -19,3 +29,88 @@ func TestUnitGetVersionAndDateError(t *testing.T) {
 	assert.Contains(t, versionError, globalvar.ReleaseDate)
 	assert.NotContains(t, versionError, "Update(s) behind to current")
 }
+
+type testErrorDispatcher struct {
+	statusCode int
+	body       string
+}
+
+func (d testErrorDispatcher) Do(request *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: d.statusCode,
+		Header:     http.Header{"Opc-Request-Id": []string{"opc-request-id"}},
+		Body:       ioutil.NopCloser(strings.NewReader(d.body)),
+		Request:    request,
+	}, nil
+}
+
+type testNoopSigner struct{}
+
+func (testNoopSigner) Sign(*http.Request) error {
+	return nil
+}
+
+func newTestServiceError(statusCode int, code string, message string) error {
+	client := oci_common.BaseClient{
+		HTTPClient: testErrorDispatcher{statusCode: statusCode, body: fmt.Sprintf(`{"code":%q,"message":%q}`, code, message)},
+		Signer:     testNoopSigner{},
+		Host:       "http://localhost",
+		UserAgent:  "terraform-provider-oci-test",
+	}
+	request, _ := http.NewRequest(http.MethodGet, "http://localhost/20160918/vcns", nil)
+	_, err := client.Call(context.Background(), request)
+	return err
+}
+
+type testErrorResourceCrud struct{}
+
+// issue-routing-tag: terraform/default
+func TestUnitErrorCategory(t *testing.T) {
+	errorPath := filepath.Join(t.TempDir(), "errors.json")
+	os.Setenv(globalvar.EnvOCITFErrorFile, errorPath)
+	defer os.Unsetenv(globalvar.EnvOCITFErrorFile)
+
+	tests := []struct {
+		err      error
+		category errorCategoryEnum
+	}{
+		{newTestServiceError(400, "LimitExceeded", "The limit is exceeded"), QuotaErrorCategory},
+		{newTestServiceError(400, "InvalidParameter", "The cidr block is invalid"), InvalidParameterErrorCategory},
+		{newTestServiceError(401, "NotAuthenticated", "The required information to complete authentication was not provided"), AuthErrorCategory},
+		{newTestServiceError(404, "NotAuthorizedOrNotFound", "Authorization failed or requested resource not found"), NotFoundErrorCategory},
+		{newTestServiceError(409, "IncorrectState", "The resource is in an incorrect state"), ConflictErrorCategory},
+		{newTestServiceError(429, "TooManyRequests", "Too many requests"), ThrottlingErrorCategory},
+		{newTestServiceError(500, "InternalError", "Out of host capacity."), CapacityErrorCategory},
+		{newTestServiceError(503, "ServiceUnavailable", "The service is unavailable"), ServiceFailureErrorCategory},
+		{fmt.Errorf("timeout while waiting for state to become 'AVAILABLE'"), TimeoutErrorCategory},
+		{fmt.Errorf("work request did not succeed, workId: ocid1.workrequest.test. Message: QuotaExceeded: The quota is exceeded"), QuotaErrorCategory},
+	}
+	for _, test := range tests {
+		err := newCustomError(testErrorResourceCrud{}, test.err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("Error Category: %s, Remediation: %s", test.category, remediationByErrorCategory[test.category]))
+	}
+
+	// Terraform errors, like the interrupted operations, are returned as is and are recorded in the unknown category
+	err := newCancelledError(context.Canceled)
+	assert.Equal(t, err, newCustomError(testErrorResourceCrud{}, err))
+
+	content, err := ioutil.ReadFile(errorPath)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, len(tests)+1)
+
+	var record ErrorRecord
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "QUOTA", record.Category)
+	assert.Equal(t, "REQUEST_LIMIT_INCREASE", record.Remediation)
+	assert.Equal(t, 400, record.HttpStatus)
+	assert.Equal(t, "LimitExceeded", record.ServiceCode)
+	assert.Equal(t, "opc-request-id", record.OpcRequestId)
+
+	record = ErrorRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[len(tests)]), &record))
+	assert.Equal(t, "TerraformError", record.Type)
+	assert.Equal(t, "UNKNOWN", record.Category)
+	assert.Equal(t, "CONTACT_SUPPORT", record.Remediation)
+	assert.Equal(t, "the operation was interrupted before it completed: context canceled", record.Message)
+}
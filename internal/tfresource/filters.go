@@ -4,7 +4,6 @@
 package tfresource
 
 import (
-	"log"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -13,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 )
 
 func DataSourceFiltersSchema() *schema.Schema {
@@ -63,7 +64,7 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 		var pathElements []string
 		var err error
 		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
+			utils.Logf("[WARN] %v", err)
 			pathElements = []string{keyword}
 		}
 
@@ -79,7 +80,7 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 				if err != nil {
 					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
 					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
+					utils.Logf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
 					return false
 				}
 				return re.MatchString(propertyVal)
@@ -113,7 +114,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 		var pathElements []string
 		var err error
 		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
+			utils.Logf("[WARN] %v", err)
 			pathElements = []string{keyword}
 		}
 
@@ -129,7 +130,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 				if err != nil {
 					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
 					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
+					utils.Logf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
 					return false
 				}
 				return re.MatchString(propertyVal)
@@ -217,7 +218,7 @@ func checkAndConvertNestedStructure(element interface{}) (map[string]interface{}
 func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName string) ([]string, error) {
 
 	if resourceSchema == nil {
-		log.Printf(`[WARN] schema is nil for filter name %s \n`, filterName)
+		utils.Logf(`[WARN] schema is nil for filter name %s \n`, filterName)
 		return nil, fmt.Errorf("schema is nil for filter name %s", filterName)
 	}
 
@@ -225,12 +226,12 @@ func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName s
 
 	//validate tokens
 	if len(tokenizedFields) == 0 {
-		log.Printf(`[WARN] Invalid filter name "%s"  \n`, filterName)
+		utils.Logf(`[WARN] Invalid filter name "%s"  \n`, filterName)
 		return nil, fmt.Errorf("invalid filter name %s", filterName)
 	}
 
 	if resourceSchema[tokenizedFields[0]] == nil {
-		log.Printf(`[WARN] Schema is nil for token %s for filter name "%s"\n`, tokenizedFields[0], filterName)
+		utils.Logf(`[WARN] Schema is nil for token %s for filter name "%s"\n`, tokenizedFields[0], filterName)
 		return nil, fmt.Errorf("schema is nil for token %s for filter name %s", tokenizedFields[0], filterName)
 	}
 
@@ -296,7 +297,7 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 		case reflect.Bool:
 			fBool, err := strconv.ParseBool(fVal.(string))
 			if err != nil {
-				log.Println("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
+				utils.Logln("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
 				return false
 			}
 			if val.Bool() == fBool {
@@ -307,7 +308,7 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 			// or int like `values = [300, "3600"]` but terraform will converts to string, so use ParseInt
 			fInt, err := strconv.ParseInt(fVal.(string), 10, 64)
 			if err != nil {
-				log.Println("[WARN] Filtering against Type Int field with non-int filter value")
+				utils.Logln("[WARN] Filtering against Type Int field with non-int filter value")
 				return false
 			}
 			if val.Int() == fInt {
@@ -317,7 +318,7 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 			// same comment as above for Ints
 			fFloat, err := strconv.ParseFloat(fVal.(string), 64)
 			if err != nil {
-				log.Println("[WARN] Filtering against Type Float field with non-float filter value")
+				utils.Logln("[WARN] Filtering against Type Float field with non-float filter value")
 				return false
 			}
 			if val.Float() == fFloat {
@@ -5,7 +5,6 @@ package tfresource
 
 import (
 	"fmt"
-	"log"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -91,6 +90,17 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 			backoffDuration = finalBackoffDuration
 		}
 	}
+
+	// The service knows best when it will be able to handle the request again
+	if retryAfter, ok := getRetryAfterDuration(response); ok {
+		backoffDuration = retryAfter
+		if backoffDuration < minRetryBackoff {
+			backoffDuration = minRetryBackoff
+		}
+		utils.Debugf("Waiting %v before retrying as requested by the service", backoffDuration)
+	}
+	recordRetry(service, backoffDuration)
+	recordRetryAttempt(response)
 	utils.Logf("Time elapsed for retry: %v;  Expected retry duration: %v \n", timeWaited.Round(time.Second), expectedRetryDuration.Round(time.Second))
 	return backoffDuration
 }
@@ -100,6 +110,16 @@ func GetElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 }
 
 func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+	expectedRetryDuration := getServiceExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
+
+	// Apply the retry configuration of the service from the provider block
+	if config, ok := getServiceRetryConfig(service); ok {
+		return config.getExpectedRetryDuration(response, disableNotFoundRetries, expectedRetryDuration)
+	}
+	return expectedRetryDuration
+}
+
+func getServiceExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
 	// Get the override retry duration function if it exists. This gives the most granular control over what value to return, and is passed
 	// into GetRetryPolicy function as an optional argument to override retry durations on a per API basis.
 	if len(optionals) > 0 {
@@ -121,12 +141,12 @@ func GetDefaultExpectedRetryDuration(response oci_common.OCIOperationResponse, d
 	defaultRetryTime := ShortRetryTime
 
 	if oci_common.IsNetworkError(response.Error) {
-		log.Printf("[DEBUG] Retrying for network error...")
+		utils.Debugf("Retrying for network error...")
 		return defaultRetryTime
 	}
 
 	if ok, remainingEc := isRetriableByEc(response); ok && remainingEc != nil {
-		log.Printf("[DEBUG] Retrying Eventual consistency...")
+		utils.Debugf("Retrying Eventual consistency...")
 		return *remainingEc
 	}
 
@@ -148,7 +168,7 @@ func GetDefaultExpectedRetryDuration(response oci_common.OCIOperationResponse, d
 		return 0
 	case 409:
 		if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-			log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+			utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 			return 0
 		}
 		if e != nil && (strings.Contains(e.Error(), "InvalidatedRetryToken") ||
@@ -218,7 +238,7 @@ func getIdentityExpectedRetryDuration(response oci_common.OCIOperationResponse,
 	}
 
 	if ok, remainingEc := isRetriableByEc(response); ok && remainingEc != nil {
-		log.Printf("[DEBUG] Retrying Eventual consistency...")
+		utils.Debugf("Retrying Eventual consistency...")
 		return *remainingEc
 	}
 
@@ -240,7 +260,7 @@ func getIdentityExpectedRetryDuration(response oci_common.OCIOperationResponse,
 			}
 		}
 		if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-			log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+			utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 			return 0
 		}
 	}
@@ -254,7 +274,7 @@ func getDatabaseExpectedRetryDuration(response oci_common.OCIOperationResponse,
 	}
 
 	if ok, remainingEc := isRetriableByEc(response); ok && remainingEc != nil {
-		log.Printf("[DEBUG] Retrying Eventual consistency...")
+		utils.Debugf("Retrying Eventual consistency...")
 		return *remainingEc
 	}
 
@@ -265,7 +285,7 @@ func getDatabaseExpectedRetryDuration(response oci_common.OCIOperationResponse,
 	switch statusCode := response.Response.HTTPResponse().StatusCode; statusCode {
 	case 409:
 		if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-			log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+			utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 			return 0
 		}
 		if e := response.Error; e != nil {
@@ -286,7 +306,7 @@ func getObjectstorageServiceExpectedRetryDuration(response oci_common.OCIOperati
 	}
 
 	if ok, remainingEc := isRetriableByEc(response); ok && remainingEc != nil {
-		log.Printf("[DEBUG] Retrying Eventual consistency...")
+		utils.Debugf("Retrying Eventual consistency...")
 		return *remainingEc
 	}
 
@@ -304,7 +324,7 @@ func getObjectstorageServiceExpectedRetryDuration(response oci_common.OCIOperati
 			}
 		}
 		if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-			log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+			utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 			return 0
 		}
 	case 500:
@@ -335,6 +355,17 @@ func getLogAnalyticsExpectedRetryDuration(response oci_common.OCIOperationRespon
 
 func ShouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, optionals ...interface {
 }) bool {
+	if isCircuitOpenError(response.Error) {
+		return false
+	}
+	if circuitOpen := recordRetryResponse(service, response); circuitOpen && !httpreplay.ShouldRetryImmediately() {
+		utils.Debugf("Not retrying, the circuit breaker of service %s is open", service)
+		return false
+	}
+	if config, ok := getServiceRetryConfig(service); ok && config.isAttemptLimitReached(response) {
+		utils.Debugf("Not retrying, the maximum number of attempts for service %s was reached", service)
+		return false
+	}
 	return GetElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
 }
 
@@ -379,7 +410,7 @@ func getSubnetExpectedRetryDuration(response oci_common.OCIOperationResponse, di
 				switch statusCode := response.Response.HTTPResponse().StatusCode; statusCode {
 				case 409:
 					if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-						log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+						utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 						return 0
 					}
 					if e := response.Error; e != nil {
@@ -468,7 +499,7 @@ func getWaasCertificateExpectedRetryDuration(response oci_common.OCIOperationRes
 				switch statusCode := response.Response.HTTPResponse().StatusCode; statusCode {
 				case 409:
 					if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-						log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+						utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 						return 0
 					}
 					if e := response.Error; e != nil && strings.Contains(e.Error(), "IncorrectState") {
@@ -492,7 +523,7 @@ func GetDbHomeRetryDurationFunction(retryTimeout time.Duration) expectedRetryDur
 		switch statusCode := response.Response.HTTPResponse().StatusCode; statusCode {
 		case 409:
 			if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
-				log.Printf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
+				utils.Logf("[ERROR] Resource is in conflict state due to multiple update request: %v", e.Error())
 				return 0
 			}
 			if e := response.Error; e != nil {
@@ -0,0 +1,261 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	retryAfterHeader    = "Retry-After"
+	opcRetryAfterHeader = "opc-retry-after"
+)
+
+// CircuitBreakerThreshold is the number of consecutive 5xx responses from a service after which its requests fail without
+// being sent until CircuitBreakerCooldown has passed. The circuit breaker is disabled unless the provider sets a threshold.
+var CircuitBreakerThreshold = 0
+var CircuitBreakerCooldown = 1 * time.Minute
+
+// MaxRetryAfterDuration is the longest wait requested by a Retry-After header that is honored
+var MaxRetryAfterDuration = 1 * time.Minute
+
+type serviceRetryStats struct {
+	retries             int
+	serverErrors        int
+	throttledResponses  int
+	backoffDuration     time.Duration
+	circuitBreakerTrips int
+
+	consecutiveServerErrors int
+	circuitOpenedAt         time.Time
+}
+
+func (s *serviceRetryStats) isCircuitOpen(now time.Time) bool {
+	return !s.circuitOpenedAt.IsZero() && now.Sub(s.circuitOpenedAt) < CircuitBreakerCooldown
+}
+
+var retryStatsMutex sync.Mutex
+var retryStatsByService = map[string]*serviceRetryStats{}
+
+// circuitBreakerServiceByHost maps the host of the requests to the service of their retry policy, so that the circuit
+// breaker can be checked before the requests are sent
+var circuitBreakerServiceByHost = map[string]string{}
+
+// CircuitOpenError is returned for the requests to a service whose circuit breaker is open, they are not retried
+type CircuitOpenError struct {
+	Service string
+	Until   time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("the request was not sent, service %s returned %d consecutive server errors and will not be called until %s",
+		e.Service, CircuitBreakerThreshold, e.Until.Format(time.RFC3339))
+}
+
+func isCircuitOpenError(err error) bool {
+	var circuitOpenError *CircuitOpenError
+	return errors.As(err, &circuitOpenError)
+}
+
+// CheckCircuitBreaker returns a CircuitOpenError if the circuit breaker of the service of the request is open, it is
+// called by the SDK clients before a request is sent
+func CheckCircuitBreaker(request *http.Request) error {
+	if request.URL == nil || httpreplay.ShouldRetryImmediately() {
+		return nil
+	}
+
+	retryStatsMutex.Lock()
+	defer retryStatsMutex.Unlock()
+
+	service, ok := circuitBreakerServiceByHost[request.URL.Host]
+	if !ok {
+		return nil
+	}
+	stats := getServiceRetryStats(service)
+	if !stats.isCircuitOpen(time.Now()) {
+		return nil
+	}
+	return &CircuitOpenError{Service: service, Until: stats.circuitOpenedAt.Add(CircuitBreakerCooldown)}
+}
+
+func getServiceRetryStats(service string) *serviceRetryStats {
+	stats, ok := retryStatsByService[service]
+	if !ok {
+		stats = &serviceRetryStats{}
+		retryStatsByService[service] = stats
+	}
+	return stats
+}
+
+// recordRetryResponse updates the circuit breaker of the service with the response of an attempt, and returns true if
+// the circuit breaker is open, in which case the operation should fail without being retried
+func recordRetryResponse(service string, response oci_common.OCIOperationResponse) bool {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return false
+	}
+	statusCode := response.Response.HTTPResponse().StatusCode
+
+	retryStatsMutex.Lock()
+	defer retryStatsMutex.Unlock()
+
+	if request := response.Response.HTTPResponse().Request; request != nil && request.URL != nil && request.URL.Host != "" {
+		circuitBreakerServiceByHost[request.URL.Host] = service
+	}
+	stats := getServiceRetryStats(service)
+	now := time.Now()
+	if statusCode == http.StatusTooManyRequests {
+		stats.throttledResponses++
+		markRunSummaryChanged()
+	}
+	if statusCode < 500 {
+		stats.consecutiveServerErrors = 0
+		stats.circuitOpenedAt = time.Time{}
+		return false
+	}
+
+	markRunSummaryChanged()
+	stats.serverErrors++
+	stats.consecutiveServerErrors++
+	if CircuitBreakerThreshold > 0 && stats.consecutiveServerErrors >= CircuitBreakerThreshold && !stats.isCircuitOpen(now) {
+		// Also reopens the circuit when the first attempt after the cooldown fails again
+		stats.circuitOpenedAt = now
+		stats.circuitBreakerTrips++
+		utils.Logf("[WARN] Service %s returned %d consecutive server errors, its requests will fail without being sent for %v", service, stats.consecutiveServerErrors, CircuitBreakerCooldown)
+	}
+	return stats.isCircuitOpen(now)
+}
+
+func recordRetry(service string, backoffDuration time.Duration) {
+	retryStatsMutex.Lock()
+	defer retryStatsMutex.Unlock()
+
+	markRunSummaryChanged()
+	stats := getServiceRetryStats(service)
+	stats.retries++
+	stats.backoffDuration += backoffDuration
+}
+
+type requestAttemptsKey struct{}
+
+// requestAttempts keeps the attempt number of the requests that are about to be retried by the SDK, it is carried by the
+// context of an operation since the SDK sends each attempt of a request with the context it was called with
+type requestAttempts struct {
+	mutex sync.Mutex
+	next  map[string]uint
+}
+
+// WithRequestAttempts returns a context in which the attempt numbers of the requests sent with it are tracked
+func WithRequestAttempts(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestAttemptsKey{}, &requestAttempts{next: map[string]uint{}})
+}
+
+func getRequestAttempts(request *http.Request) (*requestAttempts, string, bool) {
+	if request == nil || request.URL == nil {
+		return nil, "", false
+	}
+	attempts, ok := request.Context().Value(requestAttemptsKey{}).(*requestAttempts)
+	return attempts, request.Method + " " + request.URL.String(), ok
+}
+
+// recordRetryAttempt notes that the request of the response is going to be sent again, the retried request is sent by
+// the SDK with the same context, method and URL
+func recordRetryAttempt(response oci_common.OCIOperationResponse) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return
+	}
+	attempts, key, ok := getRequestAttempts(response.Response.HTTPResponse().Request)
+	if !ok {
+		return
+	}
+
+	attempts.mutex.Lock()
+	defer attempts.mutex.Unlock()
+	attempts.next[key] = response.AttemptNumber + 1
+}
+
+// GetRequestAttempt returns the attempt number of a request sent by an SDK client, which is 1 unless the request is a retry
+func GetRequestAttempt(request *http.Request) uint {
+	attempts, key, ok := getRequestAttempts(request)
+	if !ok {
+		return 1
+	}
+
+	attempts.mutex.Lock()
+	defer attempts.mutex.Unlock()
+	if attempt, ok := attempts.next[key]; ok {
+		delete(attempts.next, key)
+		return attempt
+	}
+	return 1
+}
+
+// getRetryAfterDuration returns the wait time requested by the service in the Retry-After or opc-retry-after header of a
+// 429 or 503 response, up to MaxRetryAfterDuration. The header is either a number of seconds or an HTTP date.
+func getRetryAfterDuration(response oci_common.OCIOperationResponse) (time.Duration, bool) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return 0, false
+	}
+	httpResponse := response.Response.HTTPResponse()
+	if httpResponse.StatusCode != http.StatusTooManyRequests && httpResponse.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	for _, header := range []string{opcRetryAfterHeader, retryAfterHeader} {
+		value := httpResponse.Header.Get(header)
+		if value == "" {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return capRetryAfterDuration(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			if wait := time.Until(date); wait > 0 {
+				return capRetryAfterDuration(wait), true
+			}
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+func capRetryAfterDuration(wait time.Duration) time.Duration {
+	if wait > MaxRetryAfterDuration {
+		utils.Debugf("The service requested to wait %v before retrying, waiting %v instead", wait, MaxRetryAfterDuration)
+		return MaxRetryAfterDuration
+	}
+	return wait
+}
+
+// LogRetrySummary logs the retries made for each service, it is called when the provider becomes idle or the export
+// command exits
+func LogRetrySummary() {
+	retryStatsMutex.Lock()
+	defer retryStatsMutex.Unlock()
+
+	services := make([]string, 0, len(retryStatsByService))
+	for service, stats := range retryStatsByService {
+		if stats.retries > 0 || stats.serverErrors > 0 || stats.throttledResponses > 0 {
+			services = append(services, service)
+		}
+	}
+	sort.Strings(services)
+
+	for _, service := range services {
+		stats := retryStatsByService[service]
+		utils.Logf("Retry summary for service %s: %d retries, %d server errors, %d throttled responses, %v spent waiting, circuit breaker opened %d times\n",
+			service, stats.retries, stats.serverErrors, stats.throttledResponses, stats.backoffDuration.Round(time.Second), stats.circuitBreakerTrips)
+	}
+}
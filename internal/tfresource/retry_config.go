@@ -0,0 +1,99 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+)
+
+// ServiceRetryConfig overrides the retry behavior of the operations of a service, it is set from the `retry` blocks of the provider
+type ServiceRetryConfig struct {
+	// MaxAttempts is the maximum number of attempts of an operation, including the first one. Zero means no limit.
+	MaxAttempts int
+	// MaxDuration replaces the duration for which retriable errors are retried
+	MaxDuration *time.Duration
+	// RetriableStatusCodes are the only HTTP status codes that are retried, when set
+	RetriableStatusCodes []int
+	// RetryNotFound overrides whether 404 responses are retried
+	RetryNotFound *bool
+}
+
+// RetryServiceNames are the service names used in GetRetryPolicy, which the `retry` blocks of the provider can configure
+var RetryServiceNames = []string{
+	"ai_anomaly_detection", "analytics", "apigateway", "apm", "apm_config", "apm_synthetics", "appmgmt_control",
+	"artifacts", "audit", "auto_scaling", "bastion", "bds", "blockchain", "budget", "catalog", "catalogPrivateEndpoint",
+	"certificates_management", "cloud_guard", "computeinstanceagent", "containerengine", "core",
+	"data_labeling_service", "data_safe", "database", "database_management", "database_migration", "database_tools",
+	"datacatalog", "dataflow", "dataintegration", "datasafeprivateendpoints", "datascience", "devops", "dns",
+	"domain", "email", "events", "file_storage", "functions", "generic_artifacts_content", "golden_gate",
+	"health_checks", "identity", "identity_data_plane", "integration", "jms", "kms", "limits", "load_balancer",
+	"log_analytics", "logging", "management_agent", "management_dashboard", "marketplace", "metering_computation",
+	"migration", "monitoring", "mysql", "network_load_balancer", "nosql", "object_storage", "oce", "ocvp", "oda",
+	"ons", "operator_access_control", "opsi", "optimizer", "osmanagement", "resourcemanager", "sch",
+	"serviceConnector", "service_catalog", "service_manager_proxy", "streaming", "usage_proxy", "vault", "visual_builder",
+	"vulnerability_scanning", "waas", "waf", "work_request",
+}
+
+// ServiceRetryConfigs maps the service names used in GetRetryPolicy (e.g. "core", "database", "identity") to their retry configuration
+var ServiceRetryConfigs map[string]*ServiceRetryConfig
+
+func getServiceRetryConfig(service string) (*ServiceRetryConfig, bool) {
+	config, ok := ServiceRetryConfigs[service]
+	return config, ok && config != nil
+}
+
+func (c *ServiceRetryConfig) isAttemptLimitReached(response oci_common.OCIOperationResponse) bool {
+	return c.MaxAttempts > 0 && response.AttemptNumber >= uint(c.MaxAttempts)
+}
+
+func (c *ServiceRetryConfig) isRetriableStatusCode(statusCode int) bool {
+	for _, retriableStatusCode := range c.RetriableStatusCodes {
+		if retriableStatusCode == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ServiceRetryConfig) retryDuration(defaultDuration time.Duration) time.Duration {
+	if c.MaxDuration != nil {
+		return *c.MaxDuration
+	}
+	return defaultDuration
+}
+
+// getExpectedRetryDuration applies the configuration to the retry duration computed by the service specific retry functions
+func (c *ServiceRetryConfig) getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, expectedRetryDuration time.Duration) time.Duration {
+	statusCode := 0
+	if response.Response != nil && response.Response.HTTPResponse() != nil {
+		statusCode = response.Response.HTTPResponse().StatusCode
+	}
+	if statusCode >= 200 && statusCode < 300 {
+		return 0
+	}
+
+	if statusCode == 404 && c.RetryNotFound != nil {
+		// Operations that expect the resource to be gone, like reads after a delete, never retry 404s
+		if !*c.RetryNotFound || disableNotFoundRetries {
+			return 0
+		}
+		return c.retryDuration(ShortRetryTime)
+	}
+
+	if statusCode != 0 && len(c.RetriableStatusCodes) > 0 {
+		if !c.isRetriableStatusCode(statusCode) {
+			return 0
+		}
+		if expectedRetryDuration <= 0 {
+			expectedRetryDuration = ShortRetryTime
+		}
+	}
+
+	if expectedRetryDuration > 0 {
+		return c.retryDuration(expectedRetryDuration)
+	}
+	return expectedRetryDuration
+}
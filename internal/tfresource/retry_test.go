@@ -4,15 +4,23 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
@@ -222,6 +230,8 @@ func TestUnitRetryLoop_concurrent(t *testing.T) {
 	LongRetryTime = 15 * time.Second
 	tmp := time.Duration(30 * time.Second)
 	ConfiguredRetryDuration = &tmp
+	// The loops expect every 500 to be retried for the whole duration
+	CircuitBreakerThreshold = 0
 	r := retryTestInput{
 		serviceName:              "core",
 		httpResponseStatusCode:   500,
@@ -459,3 +469,216 @@ func TestUnitRetryDbHomeWithInvalidatedRetryTokenError(t *testing.T) {
 	}
 	retryLoop(t, &r)
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryAfterHeader(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 15 * time.Second
+	LongRetryTime = 15 * time.Second
+	ConfiguredRetryDuration = nil
+
+	startTime := time.Now()
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: map[string][]string{"Opc-Retry-After": {"7"}}}, fmt.Errorf("Too many requests. "), 1)
+	assert.Equal(t, 7*time.Second, GetRetryBackoffDuration(response, false, "core", startTime))
+
+	retryAfter := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 503, header: map[string][]string{"Retry-After": {retryAfter}}}, fmt.Errorf("Service unavailable. "), 1)
+	backoff := GetRetryBackoffDuration(response, false, "core", startTime)
+	assert.True(t, backoff > 8*time.Second && backoff <= 10*time.Second, "unexpected backoff %v", backoff)
+
+	// The wait requested by the service is capped
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: map[string][]string{"Retry-After": {"86400"}}}, fmt.Errorf("Too many requests. "), 1)
+	assert.Equal(t, MaxRetryAfterDuration, GetRetryBackoffDuration(response, false, "core", startTime))
+
+	// The header is only honored for throttling and unavailability responses
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500, header: map[string][]string{"Retry-After": {"100"}}}, fmt.Errorf("Internal error. "), 1)
+	assert.True(t, GetRetryBackoffDuration(response, false, "core", startTime) <= 2*time.Second)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryCircuitBreaker(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 15 * time.Second
+	LongRetryTime = 15 * time.Second
+	ConfiguredRetryDuration = nil
+	CircuitBreakerThreshold = 3
+	defer func() { CircuitBreakerThreshold = 0 }()
+
+	service := "circuit_breaker_test"
+	startTime := time.Now()
+	serverError := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 500}, fmt.Errorf("Internal error. "), 1)
+	success := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 200}, nil, 1)
+
+	assert.True(t, ShouldRetry(serverError, false, service, startTime))
+	assert.True(t, ShouldRetry(serverError, false, service, startTime))
+	assert.False(t, ShouldRetry(serverError, false, service, startTime), "expected the circuit breaker to open")
+	assert.False(t, ShouldRetry(serverError, false, service, time.Now()), "expected other operations to fail fast")
+
+	// A successful response closes the circuit breaker
+	assert.False(t, ShouldRetry(success, false, service, startTime))
+	assert.True(t, ShouldRetry(serverError, false, service, startTime))
+
+	retryStatsMutex.Lock()
+	stats := retryStatsByService[service]
+	assert.Equal(t, 5, stats.serverErrors)
+	assert.Equal(t, 1, stats.circuitBreakerTrips)
+	retryStatsMutex.Unlock()
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCheckCircuitBreaker(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 15 * time.Second
+	LongRetryTime = 15 * time.Second
+	ConfiguredRetryDuration = nil
+	CircuitBreakerThreshold = 2
+	defer func() { CircuitBreakerThreshold = 0 }()
+
+	service := "check_circuit_breaker_test"
+	startTime := time.Now()
+	request, _ := http.NewRequest(http.MethodGet, "https://circuitbreaker.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	otherRequest, _ := http.NewRequest(http.MethodGet, "https://other.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	serverError := common.NewOCIOperationResponse(testRequestResponse{&http.Response{StatusCode: 500, Request: request}}, fmt.Errorf("Internal error. "), 1)
+
+	assert.NoError(t, CheckCircuitBreaker(request))
+	assert.True(t, ShouldRetry(serverError, false, service, startTime))
+	assert.NoError(t, CheckCircuitBreaker(request))
+	assert.False(t, ShouldRetry(serverError, false, service, startTime), "expected the circuit breaker to open")
+
+	// The requests to the service fail before being sent, and are not retried
+	err := CheckCircuitBreaker(request)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), service)
+	assert.False(t, ShouldRetry(common.NewOCIOperationResponse(nil, err, 1), false, service, time.Now()))
+	assert.NoError(t, CheckCircuitBreaker(otherRequest))
+
+	// The requests are sent again after the cooldown
+	retryStatsMutex.Lock()
+	retryStatsByService[service].circuitOpenedAt = time.Now().Add(-CircuitBreakerCooldown)
+	retryStatsMutex.Unlock()
+	assert.NoError(t, CheckCircuitBreaker(request))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitServiceRetryConfig(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 15 * time.Second
+	LongRetryTime = 30 * time.Second
+	ConfiguredRetryDuration = nil
+
+	maxDuration := 5 * time.Minute
+	retryNotFound := true
+	ServiceRetryConfigs = map[string]*ServiceRetryConfig{
+		"identity": {MaxDuration: &maxDuration, RetryNotFound: &retryNotFound},
+		"database": {MaxAttempts: 2, RetriableStatusCodes: []int{409, 500}},
+	}
+	defer func() { ServiceRetryConfigs = nil }()
+
+	startTime := time.Now()
+	notFound := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 404}, fmt.Errorf("NotAuthorizedOrNotFound"), 1)
+	assert.Equal(t, maxDuration, getExpectedRetryDuration(notFound, false, "identity"))
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(notFound, true, "identity"), "reads after delete should not retry 404s")
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(notFound, false, "core"), "services without configuration keep their defaults")
+
+	throttled := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429}, fmt.Errorf("TooManyRequests"), 1)
+	assert.Equal(t, maxDuration, getExpectedRetryDuration(throttled, false, "identity"))
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(throttled, false, "database"), "429 is not a retriable status code of database")
+
+	conflict := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, fmt.Errorf("Conflict"), 1)
+	assert.True(t, ShouldRetry(conflict, false, "database", startTime))
+	conflict = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, fmt.Errorf("Conflict"), 2)
+	assert.False(t, ShouldRetry(conflict, false, "database", startTime), "the maximum number of attempts was reached")
+}
+
+type testRequestResponse struct {
+	response *http.Response
+}
+
+func (r testRequestResponse) HTTPResponse() *http.Response {
+	return r.response
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRequestAttempt(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 15 * time.Second
+	LongRetryTime = 15 * time.Second
+	ConfiguredRetryDuration = nil
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn", nil)
+	request = request.WithContext(WithRequestAttempts(ctx))
+	assert.Equal(t, uint(1), GetRequestAttempt(request))
+
+	// The same request sent concurrently by another operation has its own attempts
+	otherRequest := request.WithContext(WithRequestAttempts(ctx))
+
+	response := common.NewOCIOperationResponse(testRequestResponse{&http.Response{StatusCode: 500, Request: request}}, fmt.Errorf("Internal error. "), 2)
+	GetRetryBackoffDuration(response, false, "core", time.Now())
+	assert.Equal(t, uint(1), GetRequestAttempt(otherRequest))
+	assert.Equal(t, uint(3), GetRequestAttempt(request))
+
+	// The attempt is only reported for the request that is retried
+	assert.Equal(t, uint(1), GetRequestAttempt(request))
+
+	// The attempts are not tracked without an operation context
+	request = request.WithContext(ctx)
+	response = common.NewOCIOperationResponse(testRequestResponse{&http.Response{StatusCode: 500, Request: request}}, fmt.Errorf("Internal error. "), 2)
+	GetRetryBackoffDuration(response, false, "core", time.Now())
+	assert.Equal(t, uint(1), GetRequestAttempt(request))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitLogRunSummaries(t *testing.T) {
+	logRunSummaries()
+	assert.False(t, logRunSummaries(), "the summaries are only logged when they changed")
+
+	recordRetry("run_summary_test", time.Second)
+	d := (&schema.Resource{}).Data(nil)
+	other := (&schema.Resource{}).Data(nil)
+	StartResourceOperation(d, "oci_core_vcn", "create")
+	StartResourceOperation(other, "oci_core_vcn", "create")
+	EndResourceOperation(d)
+	assert.NotEqual(t, atomic.LoadUint64(&runSummaryVersion), loggedRunSummaryVersion, "an operation is still in progress")
+
+	// The summaries are logged when the last operation in progress ends
+	EndResourceOperation(other)
+	assert.Equal(t, atomic.LoadUint64(&runSummaryVersion), loggedRunSummaryVersion)
+	assert.False(t, logRunSummaries())
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryServiceNames(t *testing.T) {
+	serviceNames := map[string]bool{}
+	for _, service := range RetryServiceNames {
+		serviceNames[service] = true
+	}
+
+	// Every service name passed to GetRetryPolicy can be configured by a retry block
+	retryPolicyRegex := regexp.MustCompile(`GetRetryPolicy\([^,]+, *"([A-Za-z_]+)"`)
+	err := filepath.Walk(filepath.Join("..", "service"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range retryPolicyRegex.FindAllStringSubmatch(string(content), -1) {
+			assert.True(t, serviceNames[match[1]], "%s is not one of the RetryServiceNames, used in %s", match[1], path)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
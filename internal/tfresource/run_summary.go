@@ -0,0 +1,37 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Terraform stops the provider process as soon as the last operation of a run returns, so the summaries of the run can
+// not be logged when the process exits. They are logged each time the provider becomes idle instead, if they changed
+// since they were last logged.
+var operationsInProgress int32
+var runSummaryVersion uint64
+var loggedRunSummaryVersion uint64
+var runSummaryMutex sync.Mutex
+
+// markRunSummaryChanged notes that the summaries of the run changed since they were last logged
+func markRunSummaryChanged() {
+	atomic.AddUint64(&runSummaryVersion, 1)
+}
+
+// logRunSummaries logs the summaries of the run, it returns false if they did not change since they were last logged
+func logRunSummaries() bool {
+	runSummaryMutex.Lock()
+	defer runSummaryMutex.Unlock()
+
+	version := atomic.LoadUint64(&runSummaryVersion)
+	if version == loggedRunSummaryVersion {
+		return false
+	}
+	loggedRunSummaryVersion = version
+	LogRetrySummary()
+	LogTraceSummary()
+	return true
+}
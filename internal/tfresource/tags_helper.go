@@ -11,10 +11,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+// Tags configured in the provider block that are merged into the tags of every taggable resource on Create and Update
+var DefaultFreeformTags map[string]interface{}
+var DefaultDefinedTags map[string]interface{}
+
+// Defined tag namespaces or "namespace.key" names that are managed outside of Terraform, e.g. "Oracle-Tags" or "Oracle-Tags.CreatedBy".
+// These tags are left out of the state and never show up in diffs.
+var IgnoredDefinedTags []string
+
+// A freeform tag key or a "namespace.key" defined tag name; resources carrying this tag are never deleted by the provider
+var DeletionProtectionTagKey string
+
 func DefinedTagsToMap(definedTags map[string]map[string]interface{}) map[string]interface{} {
+	return RemoveIgnoredDefinedTags(namespacedTagsToMap(definedTags))
+}
+
+func namespacedTagsToMap(namespacedTags map[string]map[string]interface{}) map[string]interface{} {
 	var tags = make(map[string]interface{})
-	if len(definedTags) > 0 {
-		for namespace, keys := range definedTags {
+	if len(namespacedTags) > 0 {
+		for namespace, keys := range namespacedTags {
 			for key, value := range keys {
 				tags[namespace+"."+key] = value
 			}
@@ -23,6 +38,33 @@ func DefinedTagsToMap(definedTags map[string]map[string]interface{}) map[string]
 	return tags
 }
 
+// IsIgnoredDefinedTag returns true if the "namespace.key" tag name matches one of the IgnoredDefinedTags, ignoring case
+func IsIgnoredDefinedTag(tagName string) bool {
+	for _, ignored := range IgnoredDefinedTags {
+		if strings.EqualFold(tagName, ignored) {
+			return true
+		}
+		if !strings.Contains(ignored, ".") && len(tagName) > len(ignored) && strings.EqualFold(tagName[:len(ignored)+1], ignored+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveIgnoredDefinedTags returns a copy of the "namespace.key" tags map without the IgnoredDefinedTags
+func RemoveIgnoredDefinedTags(tags map[string]interface{}) map[string]interface{} {
+	if len(IgnoredDefinedTags) == 0 {
+		return tags
+	}
+	result := make(map[string]interface{}, len(tags))
+	for key, value := range tags {
+		if !IsIgnoredDefinedTag(key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
 func MapToDefinedTags(rawMap map[string]interface{}) (map[string]map[string]interface{}, error) {
 	definedTags := make(map[string]map[string]interface{})
 	if len(rawMap) > 0 {
@@ -42,10 +84,6 @@ func MapToDefinedTags(rawMap map[string]interface{}) (map[string]map[string]inte
 }
 
 func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
-	if old != "" && new != "" {
-		return false
-	}
-
 	// Find the specific defined_tag key name (mainly if a resource supports tagging at multiple levels)
 	// For example: "create_vnic_details.0.defined_tags.mynamespace.mykey" => "create_vnic_details.0.defined_tags"
 	keyParts := strings.Split(key, ".")
@@ -56,9 +94,21 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 			break
 		}
 	}
+	definedTagsKey := strings.Join(definedTagKeyParts, ".")
+
+	// Tags managed outside of Terraform never show up in diffs
+	if IsIgnoredDefinedTag(strings.TrimPrefix(key, definedTagsKey+".")) {
+		return true
+	}
+
+	// The count of the map may differ when provider default tags were merged into the resource, or when ignored tags
+	// are present, so compare the full maps in that case
+	if old != "" && new != "" && !isMapCountKey(key) {
+		return false
+	}
 
 	//Old value comes from refreshed state, while new value comes from config
-	oldRaw, newRaw := d.GetChange(strings.Join(definedTagKeyParts, "."))
+	oldRaw, newRaw := d.GetChange(definedTagsKey)
 	if newRaw == nil || oldRaw == nil {
 		return false
 	}
@@ -69,8 +119,13 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 		return false
 	}
 
-	lowerCaseNewValueMap := ToLowerCaseKeyMap(newValue)
-	lowerCaseOldValueMap := ToLowerCaseKeyMap(oldValue)
+	// Provider default tags are only merged into the top level defined_tags of a resource
+	if len(definedTagKeyParts) == 1 {
+		newValue = MergeDefinedTags(DefaultDefinedTags, newValue)
+	}
+
+	lowerCaseNewValueMap := ToLowerCaseKeyMap(RemoveIgnoredDefinedTags(newValue))
+	lowerCaseOldValueMap := ToLowerCaseKeyMap(RemoveIgnoredDefinedTags(oldValue))
 
 	if reflect.DeepEqual(lowerCaseOldValueMap, lowerCaseNewValueMap) {
 		return true
@@ -78,6 +133,119 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 	return false
 }
 
+// FreeformTagsDiffSuppressFunction suppresses the diff on freeform_tags when the only difference between the state and the config
+// are the tags that were injected from the provider level default_freeform_tags
+func FreeformTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	if len(DefaultFreeformTags) == 0 {
+		return false
+	}
+	if old != "" && new != "" && !isMapCountKey(key) {
+		return false
+	}
+
+	oldRaw, newRaw := d.GetChange("freeform_tags")
+	if newRaw == nil || oldRaw == nil {
+		return false
+	}
+
+	newValue, newValueOk := newRaw.(map[string]interface{})
+	oldValue, oldValueOk := oldRaw.(map[string]interface{})
+	if !newValueOk || !oldValueOk {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, MergeFreeformTags(DefaultFreeformTags, newValue))
+}
+
+// MergeFreeformTags returns the union of the default tags and the resource tags. Resource tags take precedence over the defaults.
+func MergeFreeformTags(defaultTags map[string]interface{}, resourceTags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(resourceTags))
+	for key, value := range defaultTags {
+		result[key] = value
+	}
+	for key, value := range resourceTags {
+		result[key] = value
+	}
+	return result
+}
+
+// MergeDefinedTags returns the union of the default tags and the resource tags. Defined tag keys are case insensitive, so a
+// resource tag overrides a default tag that only differs in case.
+func MergeDefinedTags(defaultTags map[string]interface{}, resourceTags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(resourceTags))
+	resourceKeys := make(map[string]bool, len(resourceTags))
+	for key := range resourceTags {
+		resourceKeys[strings.ToLower(key)] = true
+	}
+	for key, value := range defaultTags {
+		if !resourceKeys[strings.ToLower(key)] {
+			result[key] = value
+		}
+	}
+	for key, value := range resourceTags {
+		result[key] = value
+	}
+	return result
+}
+
+// MergeDefaultTags merges the provider level default tags into the freeform_tags and defined_tags of the resource, so that
+// they are sent to the service by the Create and Update operations of the resource.
+func MergeDefaultTags(d *schema.ResourceData, hasFreeformTags bool, hasDefinedTags bool) error {
+	if hasFreeformTags && len(DefaultFreeformTags) > 0 {
+		freeformTags := map[string]interface{}{}
+		if raw, ok := d.GetOk("freeform_tags"); ok {
+			freeformTags = raw.(map[string]interface{})
+		}
+		if err := d.Set("freeform_tags", MergeFreeformTags(DefaultFreeformTags, freeformTags)); err != nil {
+			return err
+		}
+	}
+
+	if hasDefinedTags && len(DefaultDefinedTags) > 0 {
+		definedTags := map[string]interface{}{}
+		if raw, ok := d.GetOk("defined_tags"); ok {
+			definedTags = raw.(map[string]interface{})
+		}
+		if err := d.Set("defined_tags", MergeDefinedTags(DefaultDefinedTags, definedTags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckDeletionProtection returns an error if the resource in the state carries the DeletionProtectionTagKey tag
+func CheckDeletionProtection(d *schema.ResourceData) error {
+	if tagName, tagsAttrName, ok := getDeletionProtectionTag(d.Get); ok {
+		return fmt.Errorf("%s is protected from deletion by the %s tag in %s. Remove the tag in a separate apply before destroying or replacing the resource", d.Id(), tagName, tagsAttrName)
+	}
+	return nil
+}
+
+// getDeletionProtectionTag returns the name of the DeletionProtectionTagKey tag and the attribute it is found in
+func getDeletionProtectionTag(get func(string) interface{}) (string, string, bool) {
+	if DeletionProtectionTagKey == "" {
+		return "", "", false
+	}
+
+	for _, tagsAttrName := range []string{"freeform_tags", "defined_tags"} {
+		tags, ok := get(tagsAttrName).(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range tags {
+			if strings.EqualFold(key, DeletionProtectionTagKey) {
+				return key, tagsAttrName, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func isMapCountKey(key string) bool {
+	return strings.HasSuffix(key, ".%")
+}
+
 func ToLowerCaseKeyMap(original map[string]interface{}) map[string]interface{} {
 	lowercaseKeyMap := make(map[string]interface{}, len(original))
 	for key, value := range original {
@@ -87,7 +255,7 @@ func ToLowerCaseKeyMap(original map[string]interface{}) map[string]interface{} {
 }
 
 func SystemTagsToMap(systemTags map[string]map[string]interface{}) map[string]interface{} {
-	return DefinedTagsToMap(systemTags)
+	return namespacedTagsToMap(systemTags)
 }
 
 func MapToSystemTags(rawMap map[string]interface{}) (map[string]map[string]interface{}, error) {
@@ -0,0 +1,160 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func tagsTestSchema() schema.InternalMap {
+	return map[string]*schema.Schema{
+		"freeform_tags": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: FreeformTagsDiffSuppressFunction,
+			Elem:             schema.TypeString,
+		},
+		"defined_tags": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: DefinedTagsDiffSuppressFunction,
+			Elem:             schema.TypeString,
+		},
+	}
+}
+
+func tagsTestResourceData(t *testing.T, state map[string]string) *schema.ResourceData {
+	d, err := tagsTestSchema().Data(&terraform.InstanceState{ID: "ocid1.test", Attributes: state}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating resource data: %v", err)
+	}
+	return d
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitMergeDefinedTags(t *testing.T) {
+	defaults := map[string]interface{}{"Ops.CostCenter": "42", "Ops.Owner": "team"}
+	resourceTags := map[string]interface{}{"ops.costcenter": "7"}
+
+	merged := MergeDefinedTags(defaults, resourceTags)
+	assert.Equal(t, map[string]interface{}{"ops.costcenter": "7", "Ops.Owner": "team"}, merged)
+	assert.Equal(t, defaults, MergeDefinedTags(defaults, nil))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitMergeDefaultTags(t *testing.T) {
+	DefaultFreeformTags = map[string]interface{}{"owner": "team", "env": "dev"}
+	DefaultDefinedTags = map[string]interface{}{"Ops.CostCenter": "42"}
+	defer func() {
+		DefaultFreeformTags = nil
+		DefaultDefinedTags = nil
+	}()
+
+	d := tagsTestResourceData(t, map[string]string{
+		"freeform_tags.%":   "1",
+		"freeform_tags.env": "prod",
+	})
+	assert.NoError(t, MergeDefaultTags(d, true, true))
+	assert.Equal(t, map[string]interface{}{"owner": "team", "env": "prod"}, d.Get("freeform_tags"))
+	assert.Equal(t, map[string]interface{}{"Ops.CostCenter": "42"}, d.Get("defined_tags"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDefaultTagsDiffSuppress(t *testing.T) {
+	state := &terraform.InstanceState{ID: "ocid1.test", Attributes: map[string]string{
+		"freeform_tags.%":                "2",
+		"freeform_tags.env":              "prod",
+		"freeform_tags.owner":            "team",
+		"defined_tags.%":                 "2",
+		"defined_tags.Ops.CostCenter":    "42",
+		"defined_tags.Oracle-Tags.Owner": "me",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"freeform_tags": map[string]interface{}{"env": "prod"},
+		"defined_tags":  map[string]interface{}{"Oracle-Tags.Owner": "me"},
+	})
+
+	diff, err := tagsTestSchema().Diff(state, config, nil, nil, true)
+	assert.NoError(t, err)
+	assert.False(t, diff.Empty(), "expected a diff without provider default tags")
+
+	DefaultFreeformTags = map[string]interface{}{"owner": "team"}
+	DefaultDefinedTags = map[string]interface{}{"ops.costcenter": "42"}
+	defer func() {
+		DefaultFreeformTags = nil
+		DefaultDefinedTags = nil
+	}()
+
+	diff, err = tagsTestSchema().Diff(state, config, nil, nil, true)
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty(), "expected provider default tags to be suppressed, got %v", diff)
+
+	DefaultFreeformTags = map[string]interface{}{"owner": "other-team"}
+	diff, err = tagsTestSchema().Diff(state, config, nil, nil, true)
+	assert.NoError(t, err)
+	assert.False(t, diff.Empty(), "expected a diff when the default tag value changed")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitIgnoredDefinedTags(t *testing.T) {
+	IgnoredDefinedTags = []string{"oracle-tags.CreatedBy", "Operations"}
+	defer func() { IgnoredDefinedTags = nil }()
+
+	assert.True(t, IsIgnoredDefinedTag("Oracle-Tags.CreatedBy"))
+	assert.False(t, IsIgnoredDefinedTag("Oracle-Tags.CreatedOn"))
+	assert.True(t, IsIgnoredDefinedTag("operations.CostCenter"))
+	assert.False(t, IsIgnoredDefinedTag("OperationsTeam.CostCenter"))
+
+	tags := DefinedTagsToMap(map[string]map[string]interface{}{
+		"Oracle-Tags": {"CreatedBy": "oke", "CreatedOn": "today"},
+		"Operations":  {"CostCenter": "42"},
+	})
+	assert.Equal(t, map[string]interface{}{"Oracle-Tags.CreatedOn": "today"}, tags)
+
+	state := &terraform.InstanceState{ID: "ocid1.test", Attributes: map[string]string{
+		"freeform_tags.%":                    "0",
+		"defined_tags.%":                     "2",
+		"defined_tags.Oracle-Tags.CreatedBy": "oke",
+		"defined_tags.Oracle-Tags.CreatedOn": "today",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"defined_tags": map[string]interface{}{"Oracle-Tags.CreatedOn": "today"},
+	})
+	diff, err := tagsTestSchema().Diff(state, config, nil, nil, true)
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty(), "expected ignored defined tags to be suppressed, got %v", diff)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCheckDeletionProtection(t *testing.T) {
+	d := tagsTestResourceData(t, map[string]string{
+		"freeform_tags.%":            "1",
+		"freeform_tags.env":          "prod",
+		"defined_tags.%":             "1",
+		"defined_tags.Ops.Protected": "true",
+	})
+	assert.NoError(t, CheckDeletionProtection(d))
+
+	DeletionProtectionTagKey = "ops.protected"
+	defer func() { DeletionProtectionTagKey = "" }()
+	err := CheckDeletionProtection(d)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Ops.Protected")
+
+	DeletionProtectionTagKey = "env"
+	assert.Error(t, CheckDeletionProtection(d))
+
+	DeletionProtectionTagKey = "owner"
+	assert.NoError(t, CheckDeletionProtection(d))
+
+	// Resources that are not taggable are never protected
+	d, _ = schema.InternalMap(map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}).Data(&terraform.InstanceState{ID: "ocid1.test"}, nil)
+	assert.NoError(t, CheckDeletionProtection(d))
+}
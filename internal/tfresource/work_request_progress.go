@@ -0,0 +1,153 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v55/workrequests"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// Number of the most recent log entries read when the progress of a work request changes
+const workRequestLogEntriesPerPoll = 10
+
+// Timeout of the requests listing the errors of a work request after its wait timed out or was interrupted
+const workRequestErrorsTimeout = 30 * time.Second
+
+// workRequestProgress logs the progress of a work request at each poll, so that long operations, like the Exadata
+// ones, do not look hung. The log entries of the work request are only listed when its status or percent_complete changes.
+type workRequestProgress struct {
+	workRequestId       string
+	operationName       string
+	start               time.Time
+	timeout             time.Duration
+	lastLogTime         time.Time
+	lastMessage         string
+	lastStatus          string
+	lastPercentComplete *float32
+}
+
+func newWorkRequestProgress(workRequestId string, operationName string, timeout time.Duration) *workRequestProgress {
+	return &workRequestProgress{
+		workRequestId: workRequestId,
+		operationName: operationName,
+		start:         time.Now(),
+		timeout:       timeout,
+	}
+}
+
+func (p *workRequestProgress) progressMessage(status string, percentComplete *float32, now time.Time) string {
+	elapsed := now.Sub(p.start).Round(time.Second)
+	remaining := (p.timeout - elapsed).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	message := fmt.Sprintf("%s %s: %s", p.operationName, p.workRequestId, status)
+	if percentComplete != nil {
+		message += fmt.Sprintf(", %.0f%% complete", *percentComplete)
+	}
+	return message + fmt.Sprintf(", elapsed %s, remaining timeout %s", elapsed, remaining)
+}
+
+// progressChanged returns true at the first poll and when the status or the percent_complete of the work request changed
+// since the previous poll
+func (p *workRequestProgress) progressChanged(status string, percentComplete *float32) bool {
+	changed := status != p.lastStatus ||
+		(percentComplete == nil) != (p.lastPercentComplete == nil) ||
+		(percentComplete != nil && *percentComplete != *p.lastPercentComplete)
+	p.lastStatus = status
+	if percentComplete != nil {
+		value := *percentComplete
+		p.lastPercentComplete = &value
+	} else {
+		p.lastPercentComplete = nil
+	}
+	return changed
+}
+
+// newLogEntries returns the entries logged since the previous poll, oldest first
+func (p *workRequestProgress) newLogEntries(entries []oci_work_requests.WorkRequestLogEntry) []oci_work_requests.WorkRequestLogEntry {
+	var result []oci_work_requests.WorkRequestLogEntry
+	for _, entry := range entries {
+		if entry.Timestamp != nil && entry.Message != nil && entry.Timestamp.Time.After(p.lastLogTime) {
+			result = append(result, entry)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp.Time.Before(result[j].Timestamp.Time)
+	})
+	if len(result) > 0 {
+		p.lastLogTime = result[len(result)-1].Timestamp.Time
+	}
+	return result
+}
+
+func (p *workRequestProgress) logWorkRequest(ctx context.Context, client *oci_work_requests.WorkRequestClient, wr *oci_work_requests.WorkRequest) {
+	utils.Logf("%s", p.progressMessage(string(wr.Status), wr.PercentComplete, time.Now()))
+
+	// The log entries are not part of the recorded scenarios
+	if httpreplay.ModeRecordReplay() || !p.progressChanged(string(wr.Status), wr.PercentComplete) {
+		return
+	}
+	noRetryPolicy := oci_common.NoRetryPolicy()
+	response, err := client.ListWorkRequestLogs(ctx, oci_work_requests.ListWorkRequestLogsRequest{
+		WorkRequestId: &p.workRequestId,
+		Limit:         oci_common.Int(workRequestLogEntriesPerPoll),
+		SortOrder:     oci_work_requests.ListWorkRequestLogsSortOrderDesc,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: &noRetryPolicy,
+		},
+	})
+	if err != nil {
+		utils.Debugf("Unable to list the log entries of %s %s: %v", p.operationName, p.workRequestId, err)
+		return
+	}
+	for _, entry := range p.newLogEntries(response.Items) {
+		utils.Logf("%s %s: %s %s", p.operationName, p.workRequestId, entry.Timestamp.Format(time.RFC3339), *entry.Message)
+	}
+}
+
+// logMessage logs the progress of the work requests which only have a status message, like the load balancer ones
+func (p *workRequestProgress) logMessage(status string, message *string) {
+	utils.Logf("%s", p.progressMessage(status, nil, time.Now()))
+	if message != nil && *message != "" && *message != p.lastMessage {
+		p.lastMessage = *message
+		utils.Logf("%s %s: %s", p.operationName, p.workRequestId, *message)
+	}
+}
+
+func formatWorkRequestErrors(workRequestId string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum, workRequestErrors []oci_work_requests.WorkRequestError) error {
+	return fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", workRequestId, entityType, action, joinWorkRequestErrors(workRequestErrors))
+}
+
+func joinWorkRequestErrors(workRequestErrors []oci_work_requests.WorkRequestError) string {
+	allErrs := make([]string, 0, len(workRequestErrors))
+	for _, wrkErr := range workRequestErrors {
+		message := ""
+		if wrkErr.Message != nil {
+			message = *wrkErr.Message
+		}
+		if wrkErr.Code != nil {
+			message = fmt.Sprintf("%s: %s", *wrkErr.Code, message)
+		}
+		allErrs = append(allErrs, message)
+	}
+	return strings.Join(allErrs, "\n")
+}
+
+// withWorkRequestErrors adds the work request OCID and its errors, if any, to the error
+func withWorkRequestErrors(e error, workRequestId string, errorMessage string) error {
+	if errorMessage == "" {
+		return fmt.Errorf("%v, workId: %s", e, workRequestId)
+	}
+	return fmt.Errorf("%v, workId: %s. Message: %s", e, workRequestId, errorMessage)
+}
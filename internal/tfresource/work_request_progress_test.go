@@ -0,0 +1,84 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v55/common"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/v55/loadbalancer"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v55/workrequests"
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitWorkRequestProgress(t *testing.T) {
+	progress := newWorkRequestProgress("ocid1.workrequest.test", "work request of database", 2*time.Hour)
+
+	var percentComplete float32 = 45
+	assert.Equal(t, "work request of database ocid1.workrequest.test: IN_PROGRESS, 45% complete, elapsed 10m0s, remaining timeout 1h50m0s",
+		progress.progressMessage("IN_PROGRESS", &percentComplete, progress.start.Add(10*time.Minute)))
+	assert.Equal(t, "work request of database ocid1.workrequest.test: ACCEPTED, elapsed 3h0m0s, remaining timeout 0s",
+		progress.progressMessage("ACCEPTED", nil, progress.start.Add(3*time.Hour)))
+
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	logEntry := func(minutes int, message string) oci_work_requests.WorkRequestLogEntry {
+		return oci_work_requests.WorkRequestLogEntry{
+			Timestamp: &oci_common.SDKTime{Time: start.Add(time.Duration(minutes) * time.Minute)},
+			Message:   oci_common.String(message),
+		}
+	}
+
+	// The entries are listed from the most recent
+	entries := progress.newLogEntries([]oci_work_requests.WorkRequestLogEntry{logEntry(2, "second"), logEntry(1, "first")})
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "first", *entries[0].Message)
+	assert.Equal(t, "second", *entries[1].Message)
+
+	// Only the entries logged since the previous poll are returned
+	entries = progress.newLogEntries([]oci_work_requests.WorkRequestLogEntry{logEntry(3, "third"), logEntry(2, "second"), logEntry(1, "first")})
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "third", *entries[0].Message)
+	assert.Empty(t, progress.newLogEntries([]oci_work_requests.WorkRequestLogEntry{logEntry(3, "third")}))
+
+	// The log entries are only listed when the status or percent_complete changes
+	var percent float32 = 10
+	assert.True(t, progress.progressChanged("ACCEPTED", nil))
+	assert.False(t, progress.progressChanged("ACCEPTED", nil))
+	assert.True(t, progress.progressChanged("IN_PROGRESS", &percent))
+	assert.False(t, progress.progressChanged("IN_PROGRESS", &percent))
+	percent = 20
+	assert.True(t, progress.progressChanged("IN_PROGRESS", &percent))
+	assert.True(t, progress.progressChanged("SUCCEEDED", &percent))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFormatWorkRequestErrors(t *testing.T) {
+	err := formatWorkRequestErrors("ocid1.workrequest.test", "database", oci_work_requests.WorkRequestResourceActionTypeCreated, []oci_work_requests.WorkRequestError{
+		{Code: oci_common.String("InternalError"), Message: oci_common.String("The operation failed")},
+		{Code: oci_common.String("LimitExceeded"), Message: oci_common.String("The limit is exceeded")},
+	})
+	assert.Equal(t, "work request did not succeed, workId: ocid1.workrequest.test, entity: database, action: CREATED. "+
+		"Message: InternalError: The operation failed\nLimitExceeded: The limit is exceeded", err.Error())
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitWithWorkRequestErrors(t *testing.T) {
+	e := fmt.Errorf("timeout while waiting for state to become 'SUCCEEDED'")
+	assert.Equal(t, "timeout while waiting for state to become 'SUCCEEDED', workId: ocid1.workrequest.test",
+		withWorkRequestErrors(e, "ocid1.workrequest.test", "").Error())
+
+	errorMessage := joinWorkRequestErrors([]oci_work_requests.WorkRequestError{
+		{Code: oci_common.String("InternalError"), Message: oci_common.String("The operation failed")},
+	})
+	assert.Equal(t, "timeout while waiting for state to become 'SUCCEEDED', workId: ocid1.workrequest.test. Message: InternalError: The operation failed",
+		withWorkRequestErrors(e, "ocid1.workrequest.test", errorMessage).Error())
+
+	assert.Equal(t, "BAD_INPUT: The listener is invalid\nSTATUS_CODE_ERROR: ", joinLoadBalancerWorkRequestErrors([]oci_load_balancer.WorkRequestError{
+		{ErrorCode: oci_load_balancer.WorkRequestErrorErrorCodeBadInput, Message: oci_common.String("The listener is invalid")},
+		{ErrorCode: "STATUS_CODE_ERROR"},
+	}))
+}
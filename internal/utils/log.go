@@ -4,13 +4,17 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
 
@@ -41,14 +45,28 @@ const INFO = 1
 //DEBUG debug logging messages
 const DEBUG = 2
 
+//JSONLogFormat the value of the "OCI_TF_LOG_FORMAT" environment variable for JSON records
+const JSONLogFormat = "json"
+
+//structuredTFProviderLogger is implemented by the loggers that can log fields along with a message
+type structuredTFProviderLogger interface {
+	//LogFields logs the message and the fields if the current log level is loglevel
+	LogFields(logLevel int, message string, fields map[string]interface{}) error
+}
+
 //defaultTFProviderLogger the default implementation of the TFProviderLogger
 type defaultTFProviderLogger struct {
 	currentLoggingLevel int
+	jsonFormat          bool
 	debugLogger         *log.Logger
 	infoLogger          *log.Logger
+	jsonLogger          *log.Logger
 	nullLogger          *log.Logger
 }
 
+// messageLevelRegex matches the level prefix of the messages written for the Terraform log, e.g. "[WARN] "
+var messageLevelRegex = regexp.MustCompile(`^\[(TRACE|DEBUG|INFO|WARN|ERROR)\]\s*`)
+
 var defaultTFLogger TFProviderLogger
 var loggerLock sync.Mutex
 
@@ -68,6 +86,7 @@ func SetTFProviderLogger(logger TFProviderLogger) {
 // NewTFProviderLogger creates a defaultTFProviderLogger
 // The value of the "OCI_TF_LOG" environment variable controls the logging level.
 // Default logging level is INFO "i"
+// Setting the "OCI_TF_LOG_FORMAT" environment variable to "json" writes every message as a JSON record
 func NewTFProviderLogger() (defaultTFProviderLogger, error) {
 	logger := defaultTFProviderLogger{}
 
@@ -79,7 +98,9 @@ func NewTFProviderLogger() (defaultTFProviderLogger, error) {
 	logger.currentLoggingLevel = NONE
 	logger.debugLogger = log.New(logOutput, "DEBUG ", log.Ldate|log.Lmicroseconds)
 	logger.infoLogger = log.New(logOutput, "INFO ", log.Ldate|log.Lmicroseconds)
+	logger.jsonLogger = log.New(logOutput, "", 0)
 	logger.nullLogger = log.New(ioutil.Discard, "", log.Ldate|log.Lmicroseconds)
+	logger.jsonFormat = strings.EqualFold(os.Getenv(globalvar.EnvOCITFLogFormat), JSONLogFormat)
 
 	logLevel := GetEnvSettingWithDefault("TF_LOG", "i")
 
@@ -87,10 +108,11 @@ func NewTFProviderLogger() (defaultTFProviderLogger, error) {
 	case "null":
 		logger.currentLoggingLevel = NONE
 		break
-	case "i", "info", "INFO":
+	case "i", "info", "warn", "error":
 		logger.currentLoggingLevel = INFO
 		break
-	case "d", "debug", "DEBUG":
+	// Terraform's TRACE level includes its DEBUG messages, the debug messages of the provider are logged at both levels
+	case "d", "debug", "trace":
 		logger.currentLoggingLevel = DEBUG
 		break
 	default:
@@ -123,11 +145,57 @@ func (l defaultTFProviderLogger) LogLevel() int {
 }
 
 func (l defaultTFProviderLogger) Log(logLevel int, format string, v ...interface{}) error {
+	if l.jsonFormat {
+		return l.LogFields(logLevel, fmt.Sprintf(format, v...), nil)
+	}
 	logger := l.getLoggerForLevel(logLevel)
 	_ = logger.Output(4, fmt.Sprintf(format, v...))
 	return nil
 }
 
+//LogFields logs a JSON record with the message and the fields in JSON format, or the message followed by the fields otherwise
+func (l defaultTFProviderLogger) LogFields(logLevel int, message string, fields map[string]interface{}) error {
+	if logLevel == NONE || logLevel > l.currentLoggingLevel {
+		return nil
+	}
+
+	if !l.jsonFormat {
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var sb strings.Builder
+		sb.WriteString(strings.TrimSpace(message))
+		for _, key := range keys {
+			sb.WriteString(fmt.Sprintf(" %s=%v", key, fields[key]))
+		}
+		_ = l.getLoggerForLevel(logLevel).Output(4, sb.String())
+		return nil
+	}
+
+	record := make(map[string]interface{}, len(fields)+3)
+	for key, value := range fields {
+		record[key] = value
+	}
+	record["level"] = "INFO"
+	if logLevel == DEBUG {
+		record["level"] = "DEBUG"
+	}
+	if match := messageLevelRegex.FindStringSubmatch(message); match != nil {
+		record["level"] = match[1]
+		message = message[len(match[0]):]
+	}
+	record["message"] = strings.TrimSpace(message)
+	record["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return l.jsonLogger.Output(4, string(line))
+}
+
 // Log logs v
 func Log(v ...interface{}) {
 	_ = defaultTFLogger.Log(INFO, "%v", v...)
@@ -143,6 +211,25 @@ func Logf(format string, v ...interface{}) {
 	_ = defaultTFLogger.Log(INFO, format, v...)
 }
 
+// LogFields logs the message and the fields, as a single JSON record in JSON format
+func LogFields(logLevel int, message string, fields map[string]interface{}) {
+	if logger, ok := defaultTFLogger.(structuredTFProviderLogger); ok {
+		_ = logger.LogFields(logLevel, message, fields)
+		return
+	}
+	_ = defaultTFLogger.Log(logLevel, "%s %v", message, fields)
+}
+
+// LogRequest logs the fields of an SDK request. Requests are logged at INFO level in JSON format, so that a failed
+// operation can be correlated with its opc-request-id, and at DEBUG level otherwise.
+func LogRequest(fields map[string]interface{}) {
+	logLevel := DEBUG
+	if logger, ok := defaultTFLogger.(defaultTFProviderLogger); ok && logger.jsonFormat {
+		logLevel = INFO
+	}
+	LogFields(logLevel, "SDK request", fields)
+}
+
 // Debug logs v if debug mode is set
 func Debug(v ...interface{}) {
 	m := fmt.Sprint(v...)
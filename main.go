@@ -5,12 +5,18 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/fatih/color"
 
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/internal/fakeoci"
 	"github.com/terraform-providers/terraform-provider-oci/internal/resourcediscovery"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
@@ -29,8 +35,21 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/provider"
 )
 
+// stringSliceFlag is a flag that can be repeated, each value is appended to the list. The values are not split on
+// commas, so that they can contain regular expressions like a{1,3}.
+type stringSliceFlag []string
+
+func (f *stringSliceFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringSliceFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 func main() {
-	var command = flag.String("command", "", "Command to run. Supported commands include: 'export', 'list_export_resources' and 'list_export_services'. 'list_export_services' supports json format.")
+	var command = flag.String("command", "", "Command to run. Supported commands include: 'export', 'list_export_resources', 'list_export_services', 'replay_server', 'fake_backend', 'scenario_unused', 'scenario_prune', 'scenario_diff', 'scenario_rekey' and 'validate_config'. 'list_export_services' supports json format.")
 	var listExportServicesPath = flag.String("list_export_services_path", "", "[export] Path to output list of supported services in json format")
 	var compartmentId = flag.String("compartment_id", "", "[export] OCID of a compartment to export. If no compartment id nor name is specified, the root compartment will be used.")
 	var compartmentName = flag.String("compartment_name", "", "[export] The name of a compartment to export.")
@@ -39,11 +58,19 @@ func main() {
 	var services = flag.String("services", "", "[export] Comma-separated list of service resources to export. By default, all compartment-scope resources are exported.")
 	var excludeServices = flag.String("exclude_services", "", "[export] [experimental] Comma-separated list of service resources to exclude from export. If a service is present in both 'services' and 'exclude_services' argument, it will be excluded.")
 	var ids = flag.String("ids", "", "[export] Comma-separated list of tuples <resource Type:resource ID> for resources to export. The ID could either be an OCID or a Terraform import ID. By default, all resources are exported.")
+	var includeSubcompartments = flag.Bool("include_subcompartments", false, "[export] Set this flag to also export each subcompartment of the compartment into its own module, instantiated by the configuration of the compartment.")
+	var filters stringSliceFlag
+	flag.Var(&filters, "filter", "[export] Filter of the exported resources, repeat the flag to set several filters, only the resources matching all of the filters are exported. The supported filters are:\n * freeform_tag:<key>[=<value>]\n * defined_tag:<namespace>.<key>[=<value>]\n * <attribute>=<value>, e.g. lifecycle_state=AVAILABLE\n * <attribute>~<regex>, e.g. display_name~^billing-\nThe resources referenced by the exported resources but not matching the filters are referenced through variables.")
+	var incremental = flag.Bool("incremental", false, "[export] Set this flag to export against the previous export in `output_path`. The names of the resources in its terraform.tfstate, if any, and configuration are kept, the new resources are added to incremental.tf and the removed resources and changed attributes are reported in drift_report.json.")
+	var outputFormat = flag.String("output_format", "hcl", "[export] The format of the generated configurations. The allowed values are :\n * hcl\n * json, the Terraform JSON syntax in .tf.json files")
 	var generateStateFile = flag.Bool("generate_state", false, "[export][experimental] Set this to import the discovered resources into a state file along with the Terraform configuration")
 	var help = flag.Bool("help", false, "Prints usage options")
-	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12")
+	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12\n * 1.5, the 0.12 syntax with an import block for each resource, run `terraform plan` to import them. It is not supported with include_subcompartments")
 	var retryTimeout = flag.String("retry_timeout", "15s", "[export] The time duration for which API calls will wait and retry operation in case of API errors. By default, the retry timeout duration is 15s")
 	var parallelism = flag.Int("parallelism", 1, "The number of threads to use for resource discovery. By default the value is 1")
+	var scenario = flag.String("scenario", "", "[replay_server][scenario_*] Name of the scenario, it is loaded from record/<scenario>.yaml")
+	var otherScenario = flag.String("other_scenario", "", "[scenario_diff] Name of the scenario compared with the scenario")
+	var address = flag.String("address", "localhost:8080", "[replay_server][fake_backend] Address the server listens on. Point the provider at it with CLIENT_HOST_OVERRIDES")
 
 	flag.Parse()
 	globalvar.PrintVersion()
@@ -69,8 +96,10 @@ func main() {
 				terraformVersion = &resourcediscovery.TfHclVersion11{Value: resourcediscovery.TfVersionEnum(*tfVersion)}
 			} else if *tfVersion == "" || resourcediscovery.TfVersionEnum(*tfVersion) == resourcediscovery.TfVersion12 {
 				terraformVersion = &resourcediscovery.TfHclVersion12{Value: resourcediscovery.TfVersionEnum(*tfVersion)}
+			} else if resourcediscovery.TfVersionEnum(*tfVersion) == resourcediscovery.TfVersion15 {
+				terraformVersion = &resourcediscovery.TfHclVersion15{TfHclVersion12: resourcediscovery.TfHclVersion12{Value: resourcediscovery.TfVersionEnum(*tfVersion)}}
 			} else {
-				color.Red("[ERROR]: Invalid tf_version '%s', supported values: 0.11, 0.12\n", *tfVersion)
+				color.Red("[ERROR]: Invalid tf_version '%s', supported values: 0.11, 0.12, 1.5\n", *tfVersion)
 				os.Exit(1)
 			}
 
@@ -88,6 +117,9 @@ func main() {
 				RetryTimeout:                 retryTimeout,
 				IsExportWithRelatedResources: *includeRelatedResources,
 				Parallelism:                  *parallelism,
+				IncludeSubcompartments:       *includeSubcompartments,
+				OutputFormat:                 resourcediscovery.OutputFormatEnum(*outputFormat),
+				Incremental:                  *incremental,
 			}
 
 			if services != nil && *services != "" {
@@ -101,6 +133,8 @@ func main() {
 			if ids != nil && *ids != "" {
 				args.IDs = strings.Split(*ids, ",")
 			}
+
+			args.Filters = filters
 			err, status := resourcediscovery.RunExportCommand(args)
 			if err != nil {
 				color.Red("%v", err)
@@ -117,9 +151,60 @@ func main() {
 				color.Red("%v", err)
 				os.Exit(1)
 			}
+		case "replay_server":
+			if err := runReplayServer(*scenario, *address); err != nil {
+				color.Red("%v", err)
+				os.Exit(1)
+			}
+		case "fake_backend":
+			log.Printf("[INFO] Serving the fake OCI backend on %s, use it with auth '%s' and the %s environment variable set to true\n", *address, globalvar.AuthTest, globalvar.EnableTestAuthEnv)
+			if err := http.ListenAndServe(*address, fakeoci.NewServer()); err != nil {
+				color.Red("%v", err)
+				os.Exit(1)
+			}
+		case "scenario_unused", "scenario_prune", "scenario_diff", "scenario_rekey":
+			if err := httpreplay.RunScenarioCommand(strings.TrimPrefix(*command, "scenario_"), *scenario, *otherScenario, os.Stdout); err != nil {
+				color.Red("%v", err)
+				os.Exit(1)
+			}
+		case "validate_config":
+			passed, err := provider.RunValidateConfigCommand(os.Stdout)
+			if err != nil {
+				color.Red("%v", err)
+				os.Exit(1)
+			}
+			if !passed {
+				os.Exit(1)
+			}
 		default:
 			log.Printf("[ERROR]: No command '%s' supported\n", *command)
 			os.Exit(1)
 		}
 	}
 }
+
+// runReplayServer serves the recorded scenario until the process is interrupted, then reports the interactions that
+// were never matched and the requests that were not recorded
+func runReplayServer(scenario string, address string) error {
+	if scenario == "" {
+		return fmt.Errorf("[ERROR]: scenario is required by the replay_server command")
+	}
+	server, err := httpreplay.NewReplayServer(scenario)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-interrupt
+		server.LogReport()
+		if err := server.SaveUsage(); err != nil {
+			color.Red("%v", err)
+		}
+		os.Exit(0)
+	}()
+
+	log.Printf("[INFO] Replaying scenario '%s' on %s, report available at %s\n", scenario, address, httpreplay.ReplayReportPath)
+	return http.ListenAndServe(address, server)
+}